@@ -14,20 +14,81 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Params_22_list)(nil)
+
+type _Params_22_list struct {
+	list *[]*FeeRecipient
+}
+
+func (x *_Params_22_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_22_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_22_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeRecipient)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_22_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeRecipient)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_22_list) AppendMutable() protoreflect.Value {
+	v := new(FeeRecipient)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_22_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_22_list) NewElement() protoreflect.Value {
+	v := new(FeeRecipient)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_22_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                       protoreflect.MessageDescriptor
-	fd_Params_alpha                 protoreflect.FieldDescriptor
-	fd_Params_beta                  protoreflect.FieldDescriptor
-	fd_Params_gamma                 protoreflect.FieldDescriptor
-	fd_Params_delta                 protoreflect.FieldDescriptor
-	fd_Params_min_base_gas_price    protoreflect.FieldDescriptor
-	fd_Params_min_learning_rate     protoreflect.FieldDescriptor
-	fd_Params_max_learning_rate     protoreflect.FieldDescriptor
-	fd_Params_max_block_utilization protoreflect.FieldDescriptor
-	fd_Params_window                protoreflect.FieldDescriptor
-	fd_Params_fee_denom             protoreflect.FieldDescriptor
-	fd_Params_enabled               protoreflect.FieldDescriptor
-	fd_Params_distribute_fees       protoreflect.FieldDescriptor
+	md_Params                                protoreflect.MessageDescriptor
+	fd_Params_alpha                          protoreflect.FieldDescriptor
+	fd_Params_beta                           protoreflect.FieldDescriptor
+	fd_Params_gamma                          protoreflect.FieldDescriptor
+	fd_Params_delta                          protoreflect.FieldDescriptor
+	fd_Params_min_base_gas_price             protoreflect.FieldDescriptor
+	fd_Params_min_learning_rate              protoreflect.FieldDescriptor
+	fd_Params_max_learning_rate              protoreflect.FieldDescriptor
+	fd_Params_max_block_utilization          protoreflect.FieldDescriptor
+	fd_Params_window                         protoreflect.FieldDescriptor
+	fd_Params_fee_denom                      protoreflect.FieldDescriptor
+	fd_Params_enabled                        protoreflect.FieldDescriptor
+	fd_Params_distribute_fees                protoreflect.FieldDescriptor
+	fd_Params_pricing_algorithm              protoreflect.FieldDescriptor
+	fd_Params_target_block_utilization_ratio protoreflect.FieldDescriptor
+	fd_Params_max_base_gas_price             protoreflect.FieldDescriptor
+	fd_Params_max_change_per_block           protoreflect.FieldDescriptor
+	fd_Params_history_retention              protoreflect.FieldDescriptor
+	fd_Params_tip_destination                protoreflect.FieldDescriptor
+	fd_Params_burn_ratio                     protoreflect.FieldDescriptor
+	fd_Params_staker_ratio                   protoreflect.FieldDescriptor
+	fd_Params_community_pool_ratio           protoreflect.FieldDescriptor
+	fd_Params_extra_fee_recipients           protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -45,6 +106,16 @@ func init() {
 	fd_Params_fee_denom = md_Params.Fields().ByName("fee_denom")
 	fd_Params_enabled = md_Params.Fields().ByName("enabled")
 	fd_Params_distribute_fees = md_Params.Fields().ByName("distribute_fees")
+	fd_Params_pricing_algorithm = md_Params.Fields().ByName("pricing_algorithm")
+	fd_Params_target_block_utilization_ratio = md_Params.Fields().ByName("target_block_utilization_ratio")
+	fd_Params_max_base_gas_price = md_Params.Fields().ByName("max_base_gas_price")
+	fd_Params_max_change_per_block = md_Params.Fields().ByName("max_change_per_block")
+	fd_Params_history_retention = md_Params.Fields().ByName("history_retention")
+	fd_Params_tip_destination = md_Params.Fields().ByName("tip_destination")
+	fd_Params_burn_ratio = md_Params.Fields().ByName("burn_ratio")
+	fd_Params_staker_ratio = md_Params.Fields().ByName("staker_ratio")
+	fd_Params_community_pool_ratio = md_Params.Fields().ByName("community_pool_ratio")
+	fd_Params_extra_fee_recipients = md_Params.Fields().ByName("extra_fee_recipients")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -184,6 +255,66 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.PricingAlgorithm != "" {
+		value := protoreflect.ValueOfString(x.PricingAlgorithm)
+		if !f(fd_Params_pricing_algorithm, value) {
+			return
+		}
+	}
+	if x.TargetBlockUtilizationRatio != "" {
+		value := protoreflect.ValueOfString(x.TargetBlockUtilizationRatio)
+		if !f(fd_Params_target_block_utilization_ratio, value) {
+			return
+		}
+	}
+	if x.MaxBaseGasPrice != "" {
+		value := protoreflect.ValueOfString(x.MaxBaseGasPrice)
+		if !f(fd_Params_max_base_gas_price, value) {
+			return
+		}
+	}
+	if x.MaxChangePerBlock != "" {
+		value := protoreflect.ValueOfString(x.MaxChangePerBlock)
+		if !f(fd_Params_max_change_per_block, value) {
+			return
+		}
+	}
+	if x.HistoryRetention != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.HistoryRetention)
+		if !f(fd_Params_history_retention, value) {
+			return
+		}
+	}
+	if x.TipDestination != "" {
+		value := protoreflect.ValueOfString(x.TipDestination)
+		if !f(fd_Params_tip_destination, value) {
+			return
+		}
+	}
+	if x.BurnRatio != "" {
+		value := protoreflect.ValueOfString(x.BurnRatio)
+		if !f(fd_Params_burn_ratio, value) {
+			return
+		}
+	}
+	if x.StakerRatio != "" {
+		value := protoreflect.ValueOfString(x.StakerRatio)
+		if !f(fd_Params_staker_ratio, value) {
+			return
+		}
+	}
+	if x.CommunityPoolRatio != "" {
+		value := protoreflect.ValueOfString(x.CommunityPoolRatio)
+		if !f(fd_Params_community_pool_ratio, value) {
+			return
+		}
+	}
+	if len(x.ExtraFeeRecipients) != 0 {
+		value := protoreflect.ValueOfList(&_Params_22_list{list: &x.ExtraFeeRecipients})
+		if !f(fd_Params_extra_fee_recipients, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -223,6 +354,26 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Enabled != false
 	case "feemarket.feemarket.v1.Params.distribute_fees":
 		return x.DistributeFees != false
+	case "feemarket.feemarket.v1.Params.pricing_algorithm":
+		return x.PricingAlgorithm != ""
+	case "feemarket.feemarket.v1.Params.target_block_utilization_ratio":
+		return x.TargetBlockUtilizationRatio != ""
+	case "feemarket.feemarket.v1.Params.max_base_gas_price":
+		return x.MaxBaseGasPrice != ""
+	case "feemarket.feemarket.v1.Params.max_change_per_block":
+		return x.MaxChangePerBlock != ""
+	case "feemarket.feemarket.v1.Params.history_retention":
+		return x.HistoryRetention != uint64(0)
+	case "feemarket.feemarket.v1.Params.tip_destination":
+		return x.TipDestination != ""
+	case "feemarket.feemarket.v1.Params.burn_ratio":
+		return x.BurnRatio != ""
+	case "feemarket.feemarket.v1.Params.staker_ratio":
+		return x.StakerRatio != ""
+	case "feemarket.feemarket.v1.Params.community_pool_ratio":
+		return x.CommunityPoolRatio != ""
+	case "feemarket.feemarket.v1.Params.extra_fee_recipients":
+		return len(x.ExtraFeeRecipients) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.Params"))
@@ -263,6 +414,26 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.Enabled = false
 	case "feemarket.feemarket.v1.Params.distribute_fees":
 		x.DistributeFees = false
+	case "feemarket.feemarket.v1.Params.pricing_algorithm":
+		x.PricingAlgorithm = ""
+	case "feemarket.feemarket.v1.Params.target_block_utilization_ratio":
+		x.TargetBlockUtilizationRatio = ""
+	case "feemarket.feemarket.v1.Params.max_base_gas_price":
+		x.MaxBaseGasPrice = ""
+	case "feemarket.feemarket.v1.Params.max_change_per_block":
+		x.MaxChangePerBlock = ""
+	case "feemarket.feemarket.v1.Params.history_retention":
+		x.HistoryRetention = uint64(0)
+	case "feemarket.feemarket.v1.Params.tip_destination":
+		x.TipDestination = ""
+	case "feemarket.feemarket.v1.Params.burn_ratio":
+		x.BurnRatio = ""
+	case "feemarket.feemarket.v1.Params.staker_ratio":
+		x.StakerRatio = ""
+	case "feemarket.feemarket.v1.Params.community_pool_ratio":
+		x.CommunityPoolRatio = ""
+	case "feemarket.feemarket.v1.Params.extra_fee_recipients":
+		x.ExtraFeeRecipients = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.Params"))
@@ -315,6 +486,39 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "feemarket.feemarket.v1.Params.distribute_fees":
 		value := x.DistributeFees
 		return protoreflect.ValueOfBool(value)
+	case "feemarket.feemarket.v1.Params.pricing_algorithm":
+		value := x.PricingAlgorithm
+		return protoreflect.ValueOfString(value)
+	case "feemarket.feemarket.v1.Params.target_block_utilization_ratio":
+		value := x.TargetBlockUtilizationRatio
+		return protoreflect.ValueOfString(value)
+	case "feemarket.feemarket.v1.Params.max_base_gas_price":
+		value := x.MaxBaseGasPrice
+		return protoreflect.ValueOfString(value)
+	case "feemarket.feemarket.v1.Params.max_change_per_block":
+		value := x.MaxChangePerBlock
+		return protoreflect.ValueOfString(value)
+	case "feemarket.feemarket.v1.Params.history_retention":
+		value := x.HistoryRetention
+		return protoreflect.ValueOfUint64(value)
+	case "feemarket.feemarket.v1.Params.tip_destination":
+		value := x.TipDestination
+		return protoreflect.ValueOfString(value)
+	case "feemarket.feemarket.v1.Params.burn_ratio":
+		value := x.BurnRatio
+		return protoreflect.ValueOfString(value)
+	case "feemarket.feemarket.v1.Params.staker_ratio":
+		value := x.StakerRatio
+		return protoreflect.ValueOfString(value)
+	case "feemarket.feemarket.v1.Params.community_pool_ratio":
+		value := x.CommunityPoolRatio
+		return protoreflect.ValueOfString(value)
+	case "feemarket.feemarket.v1.Params.extra_fee_recipients":
+		if len(x.ExtraFeeRecipients) == 0 {
+			return protoreflect.ValueOfList(&_Params_22_list{})
+		}
+		listValue := &_Params_22_list{list: &x.ExtraFeeRecipients}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.Params"))
@@ -359,6 +563,28 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.Enabled = value.Bool()
 	case "feemarket.feemarket.v1.Params.distribute_fees":
 		x.DistributeFees = value.Bool()
+	case "feemarket.feemarket.v1.Params.pricing_algorithm":
+		x.PricingAlgorithm = value.Interface().(string)
+	case "feemarket.feemarket.v1.Params.target_block_utilization_ratio":
+		x.TargetBlockUtilizationRatio = value.Interface().(string)
+	case "feemarket.feemarket.v1.Params.max_base_gas_price":
+		x.MaxBaseGasPrice = value.Interface().(string)
+	case "feemarket.feemarket.v1.Params.max_change_per_block":
+		x.MaxChangePerBlock = value.Interface().(string)
+	case "feemarket.feemarket.v1.Params.history_retention":
+		x.HistoryRetention = value.Uint()
+	case "feemarket.feemarket.v1.Params.tip_destination":
+		x.TipDestination = value.Interface().(string)
+	case "feemarket.feemarket.v1.Params.burn_ratio":
+		x.BurnRatio = value.Interface().(string)
+	case "feemarket.feemarket.v1.Params.staker_ratio":
+		x.StakerRatio = value.Interface().(string)
+	case "feemarket.feemarket.v1.Params.community_pool_ratio":
+		x.CommunityPoolRatio = value.Interface().(string)
+	case "feemarket.feemarket.v1.Params.extra_fee_recipients":
+		lv := value.List()
+		clv := lv.(*_Params_22_list)
+		x.ExtraFeeRecipients = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.Params"))
@@ -379,6 +605,12 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "feemarket.feemarket.v1.Params.extra_fee_recipients":
+		if x.ExtraFeeRecipients == nil {
+			x.ExtraFeeRecipients = []*FeeRecipient{}
+		}
+		value := &_Params_22_list{list: &x.ExtraFeeRecipients}
+		return protoreflect.ValueOfList(value)
 	case "feemarket.feemarket.v1.Params.alpha":
 		panic(fmt.Errorf("field alpha of message feemarket.feemarket.v1.Params is not mutable"))
 	case "feemarket.feemarket.v1.Params.beta":
@@ -403,6 +635,24 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field enabled of message feemarket.feemarket.v1.Params is not mutable"))
 	case "feemarket.feemarket.v1.Params.distribute_fees":
 		panic(fmt.Errorf("field distribute_fees of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.pricing_algorithm":
+		panic(fmt.Errorf("field pricing_algorithm of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.target_block_utilization_ratio":
+		panic(fmt.Errorf("field target_block_utilization_ratio of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.max_base_gas_price":
+		panic(fmt.Errorf("field max_base_gas_price of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.max_change_per_block":
+		panic(fmt.Errorf("field max_change_per_block of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.history_retention":
+		panic(fmt.Errorf("field history_retention of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.tip_destination":
+		panic(fmt.Errorf("field tip_destination of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.burn_ratio":
+		panic(fmt.Errorf("field burn_ratio of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.staker_ratio":
+		panic(fmt.Errorf("field staker_ratio of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.community_pool_ratio":
+		panic(fmt.Errorf("field community_pool_ratio of message feemarket.feemarket.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.Params"))
@@ -440,6 +690,27 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfBool(false)
 	case "feemarket.feemarket.v1.Params.distribute_fees":
 		return protoreflect.ValueOfBool(false)
+	case "feemarket.feemarket.v1.Params.pricing_algorithm":
+		return protoreflect.ValueOfString("")
+	case "feemarket.feemarket.v1.Params.target_block_utilization_ratio":
+		return protoreflect.ValueOfString("")
+	case "feemarket.feemarket.v1.Params.max_base_gas_price":
+		return protoreflect.ValueOfString("")
+	case "feemarket.feemarket.v1.Params.max_change_per_block":
+		return protoreflect.ValueOfString("")
+	case "feemarket.feemarket.v1.Params.history_retention":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "feemarket.feemarket.v1.Params.tip_destination":
+		return protoreflect.ValueOfString("")
+	case "feemarket.feemarket.v1.Params.burn_ratio":
+		return protoreflect.ValueOfString("")
+	case "feemarket.feemarket.v1.Params.staker_ratio":
+		return protoreflect.ValueOfString("")
+	case "feemarket.feemarket.v1.Params.community_pool_ratio":
+		return protoreflect.ValueOfString("")
+	case "feemarket.feemarket.v1.Params.extra_fee_recipients":
+		list := []*FeeRecipient{}
+		return protoreflect.ValueOfList(&_Params_22_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.Params"))
@@ -553,6 +824,47 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.DistributeFees {
 			n += 2
 		}
+		l = len(x.PricingAlgorithm)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TargetBlockUtilizationRatio)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxBaseGasPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxChangePerBlock)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.HistoryRetention != 0 {
+			n += 2 + runtime.Sov(uint64(x.HistoryRetention))
+		}
+		l = len(x.TipDestination)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BurnRatio)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.StakerRatio)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CommunityPoolRatio)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ExtraFeeRecipients) > 0 {
+			for _, e := range x.ExtraFeeRecipients {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -582,6 +894,97 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.ExtraFeeRecipients) > 0 {
+			for iNdEx := len(x.ExtraFeeRecipients) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ExtraFeeRecipients[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xb2
+			}
+		}
+		if len(x.CommunityPoolRatio) > 0 {
+			i -= len(x.CommunityPoolRatio)
+			copy(dAtA[i:], x.CommunityPoolRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CommunityPoolRatio)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+		if len(x.StakerRatio) > 0 {
+			i -= len(x.StakerRatio)
+			copy(dAtA[i:], x.StakerRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StakerRatio)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+		if len(x.BurnRatio) > 0 {
+			i -= len(x.BurnRatio)
+			copy(dAtA[i:], x.BurnRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BurnRatio)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+		if len(x.TipDestination) > 0 {
+			i -= len(x.TipDestination)
+			copy(dAtA[i:], x.TipDestination)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TipDestination)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+		if x.HistoryRetention != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HistoryRetention))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
+		if len(x.MaxChangePerBlock) > 0 {
+			i -= len(x.MaxChangePerBlock)
+			copy(dAtA[i:], x.MaxChangePerBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxChangePerBlock)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if len(x.MaxBaseGasPrice) > 0 {
+			i -= len(x.MaxBaseGasPrice)
+			copy(dAtA[i:], x.MaxBaseGasPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxBaseGasPrice)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if len(x.TargetBlockUtilizationRatio) > 0 {
+			i -= len(x.TargetBlockUtilizationRatio)
+			copy(dAtA[i:], x.TargetBlockUtilizationRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TargetBlockUtilizationRatio)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if len(x.PricingAlgorithm) > 0 {
+			i -= len(x.PricingAlgorithm)
+			copy(dAtA[i:], x.PricingAlgorithm)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PricingAlgorithm)))
+			i--
+			dAtA[i] = 0x6a
+		}
 		if x.DistributeFees {
 			i--
 			if x.DistributeFees {
@@ -1051,123 +1454,1446 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.DistributeFees = bool(v != 0)
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PricingAlgorithm", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.PricingAlgorithm = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetBlockUtilizationRatio", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: feemarket/feemarket/v1/params.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// Params contains the required set of parameters for the EIP1559 fee market
-// plugin implementation.
-type Params struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Alpha is the amount we additively increase the learning rate
-	// when it is above or below the target +/- threshold.
-	//
-	// Must be > 0.
-	Alpha string `protobuf:"bytes,1,opt,name=alpha,proto3" json:"alpha,omitempty"`
-	// Beta is the amount we multiplicatively decrease the learning rate
-	// when it is within the target +/- threshold.
-	//
-	// Must be [0, 1].
-	Beta string `protobuf:"bytes,2,opt,name=beta,proto3" json:"beta,omitempty"`
-	// Gamma is the threshold for the learning rate. If the learning rate is
-	// above or below the target +/- threshold, we additively increase the
-	// learning rate by Alpha. Otherwise, we multiplicatively decrease the
-	// learning rate by Beta.
-	//
-	// Must be [0, 0.5].
-	Gamma string `protobuf:"bytes,3,opt,name=gamma,proto3" json:"gamma,omitempty"`
-	// Delta is the amount we additively increase/decrease the gas price when the
-	// net block utilization difference in the window is above/below the target
-	// utilization.
-	Delta string `protobuf:"bytes,4,opt,name=delta,proto3" json:"delta,omitempty"`
-	// MinBaseGasPrice determines the initial gas price of the module and the
-	// global minimum for the network.
-	MinBaseGasPrice string `protobuf:"bytes,5,opt,name=min_base_gas_price,json=minBaseGasPrice,proto3" json:"min_base_gas_price,omitempty"`
-	// MinLearningRate is the lower bound for the learning rate.
-	MinLearningRate string `protobuf:"bytes,6,opt,name=min_learning_rate,json=minLearningRate,proto3" json:"min_learning_rate,omitempty"`
-	// MaxLearningRate is the upper bound for the learning rate.
-	MaxLearningRate string `protobuf:"bytes,7,opt,name=max_learning_rate,json=maxLearningRate,proto3" json:"max_learning_rate,omitempty"`
-	// MaxBlockUtilization is the maximum block utilization.
-	MaxBlockUtilization uint64 `protobuf:"varint,8,opt,name=max_block_utilization,json=maxBlockUtilization,proto3" json:"max_block_utilization,omitempty"`
-	// Window defines the window size for calculating an adaptive learning rate
-	// over a moving window of blocks.
-	Window uint64 `protobuf:"varint,9,opt,name=window,proto3" json:"window,omitempty"`
-	// FeeDenom is the denom that will be used for all fee payments.
-	FeeDenom string `protobuf:"bytes,10,opt,name=fee_denom,json=feeDenom,proto3" json:"fee_denom,omitempty"`
-	// Enabled is a boolean that determines whether the EIP1559 fee market is
-	// enabled.
-	Enabled bool `protobuf:"varint,11,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	// DistributeFees is a boolean that determines whether the fees are burned or
-	// distributed to all stakers.
-	DistributeFees bool `protobuf:"varint,12,opt,name=distribute_fees,json=distributeFees,proto3" json:"distribute_fees,omitempty"`
-}
-
-func (x *Params) Reset() {
-	*x = Params{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_feemarket_feemarket_v1_params_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Params) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Params) ProtoMessage() {}
-
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_feemarket_feemarket_v1_params_proto_rawDescGZIP(), []int{0}
-}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TargetBlockUtilizationRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBaseGasPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxBaseGasPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxChangePerBlock", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxChangePerBlock = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HistoryRetention", wireType)
+				}
+				x.HistoryRetention = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HistoryRetention |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TipDestination", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TipDestination = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnRatio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BurnRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 20:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StakerRatio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StakerRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 21:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolRatio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CommunityPoolRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 22:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExtraFeeRecipients", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExtraFeeRecipients = append(x.ExtraFeeRecipients, &FeeRecipient{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExtraFeeRecipients[len(x.ExtraFeeRecipients)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_FeeRecipient        protoreflect.MessageDescriptor
+	fd_FeeRecipient_module protoreflect.FieldDescriptor
+	fd_FeeRecipient_ratio  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_feemarket_feemarket_v1_params_proto_init()
+	md_FeeRecipient = File_feemarket_feemarket_v1_params_proto.Messages().ByName("FeeRecipient")
+	fd_FeeRecipient_module = md_FeeRecipient.Fields().ByName("module")
+	fd_FeeRecipient_ratio = md_FeeRecipient.Fields().ByName("ratio")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeeRecipient)(nil)
+
+type fastReflection_FeeRecipient FeeRecipient
+
+func (x *FeeRecipient) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeRecipient)(x)
+}
+
+func (x *FeeRecipient) slowProtoReflect() protoreflect.Message {
+	mi := &file_feemarket_feemarket_v1_params_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeeRecipient_messageType fastReflection_FeeRecipient_messageType
+var _ protoreflect.MessageType = fastReflection_FeeRecipient_messageType{}
+
+type fastReflection_FeeRecipient_messageType struct{}
+
+func (x fastReflection_FeeRecipient_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeRecipient)(nil)
+}
+func (x fastReflection_FeeRecipient_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeRecipient)
+}
+func (x fastReflection_FeeRecipient_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeRecipient
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeRecipient) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeRecipient
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeRecipient) Type() protoreflect.MessageType {
+	return _fastReflection_FeeRecipient_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeRecipient) New() protoreflect.Message {
+	return new(fastReflection_FeeRecipient)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeRecipient) Interface() protoreflect.ProtoMessage {
+	return (*FeeRecipient)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeRecipient) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Module != "" {
+		value := protoreflect.ValueOfString(x.Module)
+		if !f(fd_FeeRecipient_module, value) {
+			return
+		}
+	}
+	if x.Ratio != "" {
+		value := protoreflect.ValueOfString(x.Ratio)
+		if !f(fd_FeeRecipient_ratio, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeeRecipient) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.FeeRecipient.module":
+		return x.Module != ""
+	case "feemarket.feemarket.v1.FeeRecipient.ratio":
+		return x.Ratio != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.FeeRecipient"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.FeeRecipient does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeRecipient) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.FeeRecipient.module":
+		x.Module = ""
+	case "feemarket.feemarket.v1.FeeRecipient.ratio":
+		x.Ratio = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.FeeRecipient"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.FeeRecipient does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeeRecipient) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "feemarket.feemarket.v1.FeeRecipient.module":
+		value := x.Module
+		return protoreflect.ValueOfString(value)
+	case "feemarket.feemarket.v1.FeeRecipient.ratio":
+		value := x.Ratio
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.FeeRecipient"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.FeeRecipient does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeRecipient) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.FeeRecipient.module":
+		x.Module = value.Interface().(string)
+	case "feemarket.feemarket.v1.FeeRecipient.ratio":
+		x.Ratio = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.FeeRecipient"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.FeeRecipient does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeRecipient) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.FeeRecipient.module":
+		panic(fmt.Errorf("field module of message feemarket.feemarket.v1.FeeRecipient is not mutable"))
+	case "feemarket.feemarket.v1.FeeRecipient.ratio":
+		panic(fmt.Errorf("field ratio of message feemarket.feemarket.v1.FeeRecipient is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.FeeRecipient"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.FeeRecipient does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeeRecipient) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.FeeRecipient.module":
+		return protoreflect.ValueOfString("")
+	case "feemarket.feemarket.v1.FeeRecipient.ratio":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.FeeRecipient"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.FeeRecipient does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeeRecipient) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in feemarket.feemarket.v1.FeeRecipient", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeeRecipient) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeRecipient) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeeRecipient) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeeRecipient) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeeRecipient)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Module)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Ratio)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeeRecipient)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Ratio) > 0 {
+			i -= len(x.Ratio)
+			copy(dAtA[i:], x.Ratio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Ratio)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Module) > 0 {
+			i -= len(x.Module)
+			copy(dAtA[i:], x.Module)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Module)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeeRecipient)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeRecipient: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Module = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Ratio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ScheduledParams        protoreflect.MessageDescriptor
+	fd_ScheduledParams_height protoreflect.FieldDescriptor
+	fd_ScheduledParams_params protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_feemarket_feemarket_v1_params_proto_init()
+	md_ScheduledParams = File_feemarket_feemarket_v1_params_proto.Messages().ByName("ScheduledParams")
+	fd_ScheduledParams_height = md_ScheduledParams.Fields().ByName("height")
+	fd_ScheduledParams_params = md_ScheduledParams.Fields().ByName("params")
+}
+
+var _ protoreflect.Message = (*fastReflection_ScheduledParams)(nil)
+
+type fastReflection_ScheduledParams ScheduledParams
+
+func (x *ScheduledParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ScheduledParams)(x)
+}
+
+func (x *ScheduledParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_feemarket_feemarket_v1_params_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ScheduledParams_messageType fastReflection_ScheduledParams_messageType
+var _ protoreflect.MessageType = fastReflection_ScheduledParams_messageType{}
+
+type fastReflection_ScheduledParams_messageType struct{}
+
+func (x fastReflection_ScheduledParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ScheduledParams)(nil)
+}
+func (x fastReflection_ScheduledParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_ScheduledParams)
+}
+func (x fastReflection_ScheduledParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ScheduledParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ScheduledParams) Type() protoreflect.MessageType {
+	return _fastReflection_ScheduledParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ScheduledParams) New() protoreflect.Message {
+	return new(fastReflection_ScheduledParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ScheduledParams) Interface() protoreflect.ProtoMessage {
+	return (*ScheduledParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ScheduledParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_ScheduledParams_height, value) {
+			return
+		}
+	}
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_ScheduledParams_params, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ScheduledParams) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.ScheduledParams.height":
+		return x.Height != int64(0)
+	case "feemarket.feemarket.v1.ScheduledParams.params":
+		return x.Params != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.ScheduledParams"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.ScheduledParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledParams) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.ScheduledParams.height":
+		x.Height = int64(0)
+	case "feemarket.feemarket.v1.ScheduledParams.params":
+		x.Params = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.ScheduledParams"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.ScheduledParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ScheduledParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "feemarket.feemarket.v1.ScheduledParams.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "feemarket.feemarket.v1.ScheduledParams.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.ScheduledParams"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.ScheduledParams does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.ScheduledParams.height":
+		x.Height = value.Int()
+	case "feemarket.feemarket.v1.ScheduledParams.params":
+		x.Params = value.Message().Interface().(*Params)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.ScheduledParams"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.ScheduledParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.ScheduledParams.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "feemarket.feemarket.v1.ScheduledParams.height":
+		panic(fmt.Errorf("field height of message feemarket.feemarket.v1.ScheduledParams is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.ScheduledParams"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.ScheduledParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ScheduledParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.ScheduledParams.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "feemarket.feemarket.v1.ScheduledParams.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.ScheduledParams"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.ScheduledParams does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ScheduledParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in feemarket.feemarket.v1.ScheduledParams", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ScheduledParams) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledParams) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ScheduledParams) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ScheduledParams) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ScheduledParams)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ScheduledParams)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ScheduledParams)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledParams: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: feemarket/feemarket/v1/params.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Params contains the required set of parameters for the EIP1559 fee market
+// plugin implementation.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Alpha is the amount we additively increase the learning rate
+	// when it is above or below the target +/- threshold.
+	//
+	// Must be > 0.
+	Alpha string `protobuf:"bytes,1,opt,name=alpha,proto3" json:"alpha,omitempty"`
+	// Beta is the amount we multiplicatively decrease the learning rate
+	// when it is within the target +/- threshold.
+	//
+	// Must be [0, 1].
+	Beta string `protobuf:"bytes,2,opt,name=beta,proto3" json:"beta,omitempty"`
+	// Gamma is the threshold for the learning rate. If the learning rate is
+	// above or below the target +/- threshold, we additively increase the
+	// learning rate by Alpha. Otherwise, we multiplicatively decrease the
+	// learning rate by Beta.
+	//
+	// Must be [0, 0.5].
+	Gamma string `protobuf:"bytes,3,opt,name=gamma,proto3" json:"gamma,omitempty"`
+	// Delta is the amount we additively increase/decrease the gas price when the
+	// net block utilization difference in the window is above/below the target
+	// utilization. Must be zero for the eip1559 pricing algorithm.
+	Delta string `protobuf:"bytes,4,opt,name=delta,proto3" json:"delta,omitempty"`
+	// MinBaseGasPrice determines the initial gas price of the module and the
+	// global minimum for the network.
+	MinBaseGasPrice string `protobuf:"bytes,5,opt,name=min_base_gas_price,json=minBaseGasPrice,proto3" json:"min_base_gas_price,omitempty"`
+	// MinLearningRate is the lower bound for the learning rate.
+	MinLearningRate string `protobuf:"bytes,6,opt,name=min_learning_rate,json=minLearningRate,proto3" json:"min_learning_rate,omitempty"`
+	// MaxLearningRate is the upper bound for the learning rate.
+	MaxLearningRate string `protobuf:"bytes,7,opt,name=max_learning_rate,json=maxLearningRate,proto3" json:"max_learning_rate,omitempty"`
+	// MaxBlockUtilization is the maximum block utilization.
+	MaxBlockUtilization uint64 `protobuf:"varint,8,opt,name=max_block_utilization,json=maxBlockUtilization,proto3" json:"max_block_utilization,omitempty"`
+	// Window defines the window size for calculating an adaptive learning rate
+	// over a moving window of blocks.
+	Window uint64 `protobuf:"varint,9,opt,name=window,proto3" json:"window,omitempty"`
+	// FeeDenom is the denom that will be used for all fee payments.
+	FeeDenom string `protobuf:"bytes,10,opt,name=fee_denom,json=feeDenom,proto3" json:"fee_denom,omitempty"`
+	// Enabled is a boolean that determines whether the EIP1559 fee market is
+	// enabled.
+	Enabled bool `protobuf:"varint,11,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// DistributeFees is a boolean that determines whether the fees are burned or
+	// distributed to all stakers.
+	DistributeFees bool `protobuf:"varint,12,opt,name=distribute_fees,json=distributeFees,proto3" json:"distribute_fees,omitempty"`
+	// PricingAlgorithm is the name of the registered pricing algorithm that is
+	// used to update the learning rate and base gas price at the end of every
+	// block. If empty, the AIMD pricing algorithm is used.
+	PricingAlgorithm string `protobuf:"bytes,13,opt,name=pricing_algorithm,json=pricingAlgorithm,proto3" json:"pricing_algorithm,omitempty"`
+	// TargetBlockUtilizationRatio is the fraction of MaxBlockUtilization that the
+	// fee market targets. Blocks above the target increase the base gas price and
+	// blocks below the target decrease it. If unset or zero, the target is 50% of
+	// MaxBlockUtilization.
+	//
+	// Must be [0, 1).
+	TargetBlockUtilizationRatio string `protobuf:"bytes,14,opt,name=target_block_utilization_ratio,json=targetBlockUtilizationRatio,proto3" json:"target_block_utilization_ratio,omitempty"`
+	// MaxBaseGasPrice is the global maximum for the base gas price. If zero, the
+	// base gas price is not capped.
+	//
+	// Must be zero or >= MinBaseGasPrice.
+	MaxBaseGasPrice string `protobuf:"bytes,15,opt,name=max_base_gas_price,json=maxBaseGasPrice,proto3" json:"max_base_gas_price,omitempty"`
+	// MaxChangePerBlock is the maximum fraction by which the base gas price can
+	// increase or decrease in a single block. If zero, the change per block is
+	// not limited.
+	//
+	// Must be [0, 1].
+	MaxChangePerBlock string `protobuf:"bytes,16,opt,name=max_change_per_block,json=maxChangePerBlock,proto3" json:"max_change_per_block,omitempty"`
+	// HistoryRetention is the number of most recent blocks for which a gas price
+	// record is kept. If zero, no gas price history is recorded.
+	HistoryRetention uint64 `protobuf:"varint,17,opt,name=history_retention,json=historyRetention,proto3" json:"history_retention,omitempty"`
+	// TipDestination determines where the priority tips are paid. If
+	// "operator", tips are sent to the account of the operator of the validator
+	// that proposed the block. If "distribution", tips are allocated to the
+	// proposing validator through x/distribution, so that its commission and
+	// delegator rewards apply. If empty, tips are sent to the operator.
+	TipDestination string `protobuf:"bytes,18,opt,name=tip_destination,json=tipDestination,proto3" json:"tip_destination,omitempty"`
+	// BurnRatio is the fraction of the base fees that is burned.
+	//
+	// If any of BurnRatio, StakerRatio, CommunityPoolRatio or ExtraFeeRecipients
+	// is set, the base fees are split between the destinations and the ratios
+	// must sum to 1. Otherwise, the base fees are handled as determined by
+	// DistributeFees.
+	BurnRatio string `protobuf:"bytes,19,opt,name=burn_ratio,json=burnRatio,proto3" json:"burn_ratio,omitempty"`
+	// StakerRatio is the fraction of the base fees that is sent to the default
+	// fee collector and distributed to all stakers.
+	StakerRatio string `protobuf:"bytes,20,opt,name=staker_ratio,json=stakerRatio,proto3" json:"staker_ratio,omitempty"`
+	// CommunityPoolRatio is the fraction of the base fees that is sent to the
+	// community pool.
+	CommunityPoolRatio string `protobuf:"bytes,21,opt,name=community_pool_ratio,json=communityPoolRatio,proto3" json:"community_pool_ratio,omitempty"`
+	// ExtraFeeRecipients are additional module accounts that receive a fraction
+	// of the base fees.
+	ExtraFeeRecipients []*FeeRecipient `protobuf:"bytes,22,rep,name=extra_fee_recipients,json=extraFeeRecipients,proto3" json:"extra_fee_recipients,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feemarket_feemarket_v1_params_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_feemarket_feemarket_v1_params_proto_rawDescGZIP(), []int{0}
+}
 
 func (x *Params) GetAlpha() string {
 	if x != nil {
@@ -1253,6 +2979,169 @@ func (x *Params) GetDistributeFees() bool {
 	return false
 }
 
+func (x *Params) GetPricingAlgorithm() string {
+	if x != nil {
+		return x.PricingAlgorithm
+	}
+	return ""
+}
+
+func (x *Params) GetTargetBlockUtilizationRatio() string {
+	if x != nil {
+		return x.TargetBlockUtilizationRatio
+	}
+	return ""
+}
+
+func (x *Params) GetMaxBaseGasPrice() string {
+	if x != nil {
+		return x.MaxBaseGasPrice
+	}
+	return ""
+}
+
+func (x *Params) GetMaxChangePerBlock() string {
+	if x != nil {
+		return x.MaxChangePerBlock
+	}
+	return ""
+}
+
+func (x *Params) GetHistoryRetention() uint64 {
+	if x != nil {
+		return x.HistoryRetention
+	}
+	return 0
+}
+
+func (x *Params) GetTipDestination() string {
+	if x != nil {
+		return x.TipDestination
+	}
+	return ""
+}
+
+func (x *Params) GetBurnRatio() string {
+	if x != nil {
+		return x.BurnRatio
+	}
+	return ""
+}
+
+func (x *Params) GetStakerRatio() string {
+	if x != nil {
+		return x.StakerRatio
+	}
+	return ""
+}
+
+func (x *Params) GetCommunityPoolRatio() string {
+	if x != nil {
+		return x.CommunityPoolRatio
+	}
+	return ""
+}
+
+func (x *Params) GetExtraFeeRecipients() []*FeeRecipient {
+	if x != nil {
+		return x.ExtraFeeRecipients
+	}
+	return nil
+}
+
+// FeeRecipient is a module account that receives a fraction of the base fees.
+type FeeRecipient struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Module is the name of the module account.
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// Ratio is the fraction of the base fees that is sent to the module account.
+	Ratio string `protobuf:"bytes,2,opt,name=ratio,proto3" json:"ratio,omitempty"`
+}
+
+func (x *FeeRecipient) Reset() {
+	*x = FeeRecipient{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feemarket_feemarket_v1_params_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeRecipient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeRecipient) ProtoMessage() {}
+
+// Deprecated: Use FeeRecipient.ProtoReflect.Descriptor instead.
+func (*FeeRecipient) Descriptor() ([]byte, []int) {
+	return file_feemarket_feemarket_v1_params_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FeeRecipient) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+func (x *FeeRecipient) GetRatio() string {
+	if x != nil {
+		return x.Ratio
+	}
+	return ""
+}
+
+// ScheduledParams is a set of parameters that is applied at the end of the
+// block with the given height.
+type ScheduledParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Height is the block height at which the parameters are applied.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// Params are the parameters that are applied.
+	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *ScheduledParams) Reset() {
+	*x = ScheduledParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feemarket_feemarket_v1_params_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledParams) ProtoMessage() {}
+
+// Deprecated: Use ScheduledParams.ProtoReflect.Descriptor instead.
+func (*ScheduledParams) Descriptor() ([]byte, []int) {
+	return file_feemarket_feemarket_v1_params_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ScheduledParams) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ScheduledParams) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
 var File_feemarket_feemarket_v1_params_proto protoreflect.FileDescriptor
 
 var file_feemarket_feemarket_v1_params_proto_rawDesc = []byte{
@@ -1262,8 +3151,8 @@ var file_feemarket_feemarket_v1_params_proto_rawDesc = []byte{
 	0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x1a, 0x19, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf4,
-	0x05, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x47, 0x0a, 0x05, 0x61, 0x6c, 0x70,
+	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9e,
+	0x0c, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x47, 0x0a, 0x05, 0x61, 0x6c, 0x70,
 	0x68, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
 	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
 	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d,
@@ -1310,21 +3199,85 @@ var file_feemarket_feemarket_v1_params_proto_rawDesc = []byte{
 	0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f,
 	0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18,
 	0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
-	0x65, 0x46, 0x65, 0x65, 0x73, 0x42, 0xd8, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x65,
+	0x65, 0x46, 0x65, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67,
+	0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
+	0x68, 0x6d, 0x12, 0x76, 0x0a, 0x1e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x1b, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x5e, 0x0a, 0x12, 0x6d, 0x61,
+	0x78, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x42, 0x61,
+	0x73, 0x65, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x62, 0x0a, 0x14, 0x6d, 0x61,
+	0x78, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x11, 0x6d, 0x61, 0x78,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2b,
+	0x0a, 0x11, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x74,
+	0x69, 0x70, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x69, 0x70, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x50, 0x0a, 0x0a, 0x62, 0x75, 0x72, 0x6e, 0x5f, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x09, 0x62, 0x75, 0x72,
+	0x6e, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x54, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x72,
+	0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
+	0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52,
+	0x0b, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x63, 0x0a, 0x14,
+	0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x12, 0x63,
+	0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x61, 0x74, 0x69,
+	0x6f, 0x12, 0x5c, 0x0a, 0x14, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x66, 0x65, 0x65, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x63, 0x69,
+	0x70, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x12, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x46, 0x65, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x22,
+	0x6f, 0x0a, 0x0c, 0x46, 0x65, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x47, 0x0a, 0x05, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x05, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x22, 0x67, 0x0a, 0x0f, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x3c, 0x0a, 0x06, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x66, 0x65,
 	0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x33, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f,
-	0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x65, 0x65,
-	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x46, 0x46, 0x58, 0xaa, 0x02,
-	0x16, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x46, 0x65, 0x65, 0x6d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x16, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x5c, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31,
-	0xe2, 0x02, 0x22, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x46, 0x65, 0x65,
-	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x3a, 0x3a, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x56, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xd8, 0x01, 0x0a, 0x1a, 0x63, 0x6f,
+	0x6d, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x66, 0x65, 0x65, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x2f, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31,
+	0x3b, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x46,
+	0x46, 0x58, 0xaa, 0x02, 0x16, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x46,
+	0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x16, 0x46, 0x65,
+	0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x22, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x5c, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x46, 0x65, 0x65, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1339,16 +3292,20 @@ func file_feemarket_feemarket_v1_params_proto_rawDescGZIP() []byte {
 	return file_feemarket_feemarket_v1_params_proto_rawDescData
 }
 
-var file_feemarket_feemarket_v1_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_feemarket_feemarket_v1_params_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_feemarket_feemarket_v1_params_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: feemarket.feemarket.v1.Params
+	(*Params)(nil),          // 0: feemarket.feemarket.v1.Params
+	(*FeeRecipient)(nil),    // 1: feemarket.feemarket.v1.FeeRecipient
+	(*ScheduledParams)(nil), // 2: feemarket.feemarket.v1.ScheduledParams
 }
 var file_feemarket_feemarket_v1_params_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: feemarket.feemarket.v1.Params.extra_fee_recipients:type_name -> feemarket.feemarket.v1.FeeRecipient
+	0, // 1: feemarket.feemarket.v1.ScheduledParams.params:type_name -> feemarket.feemarket.v1.Params
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_feemarket_feemarket_v1_params_proto_init() }
@@ -1369,6 +3326,30 @@ func file_feemarket_feemarket_v1_params_proto_init() {
 				return nil
 			}
 		}
+		file_feemarket_feemarket_v1_params_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeRecipient); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_feemarket_feemarket_v1_params_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1376,7 +3357,7 @@ func file_feemarket_feemarket_v1_params_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_feemarket_feemarket_v1_params_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -19,10 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	Query_Params_FullMethodName    = "/feemarket.feemarket.v1.Query/Params"
-	Query_State_FullMethodName     = "/feemarket.feemarket.v1.Query/State"
-	Query_GasPrice_FullMethodName  = "/feemarket.feemarket.v1.Query/GasPrice"
-	Query_GasPrices_FullMethodName = "/feemarket.feemarket.v1.Query/GasPrices"
+	Query_Params_FullMethodName          = "/feemarket.feemarket.v1.Query/Params"
+	Query_State_FullMethodName           = "/feemarket.feemarket.v1.Query/State"
+	Query_GasPrice_FullMethodName        = "/feemarket.feemarket.v1.Query/GasPrice"
+	Query_GasPrices_FullMethodName       = "/feemarket.feemarket.v1.Query/GasPrices"
+	Query_ScheduledParams_FullMethodName = "/feemarket.feemarket.v1.Query/ScheduledParams"
+	Query_GasPriceHistory_FullMethodName = "/feemarket.feemarket.v1.Query/GasPriceHistory"
+	Query_GasPriceRecord_FullMethodName  = "/feemarket.feemarket.v1.Query/GasPriceRecord"
+	Query_FeeHistory_FullMethodName      = "/feemarket.feemarket.v1.Query/FeeHistory"
+	Query_ProjectGasPrice_FullMethodName = "/feemarket.feemarket.v1.Query/ProjectGasPrice"
+	Query_FeeCollector_FullMethodName    = "/feemarket.feemarket.v1.Query/FeeCollector"
+	Query_DenomRates_FullMethodName      = "/feemarket.feemarket.v1.Query/DenomRates"
 )
 
 // QueryClient is the client API for Query service.
@@ -41,6 +48,26 @@ type QueryClient interface {
 	// GasPrices returns the current feemarket module list of gas prices
 	// in all available denoms.
 	GasPrices(ctx context.Context, in *GasPricesRequest, opts ...grpc.CallOption) (*GasPricesResponse, error)
+	// ScheduledParams returns the pending parameter changes ordered by the
+	// height at which they are applied.
+	ScheduledParams(ctx context.Context, in *ScheduledParamsRequest, opts ...grpc.CallOption) (*ScheduledParamsResponse, error)
+	// GasPriceHistory returns the recorded gas price history ordered by height.
+	GasPriceHistory(ctx context.Context, in *GasPriceHistoryRequest, opts ...grpc.CallOption) (*GasPriceHistoryResponse, error)
+	// GasPriceRecord returns the recorded gas price at the given height.
+	GasPriceRecord(ctx context.Context, in *GasPriceRecordRequest, opts ...grpc.CallOption) (*GasPriceRecordResponse, error)
+	// FeeHistory returns the base gas prices, gas used ratios and tip
+	// percentiles of a range of recorded blocks, modeled after Ethereum's
+	// eth_feeHistory.
+	FeeHistory(ctx context.Context, in *FeeHistoryRequest, opts ...grpc.CallOption) (*FeeHistoryResponse, error)
+	// ProjectGasPrice returns the projected base gas price and learning rate of
+	// the next blocks under an assumed block utilization.
+	ProjectGasPrice(ctx context.Context, in *ProjectGasPriceRequest, opts ...grpc.CallOption) (*ProjectGasPriceResponse, error)
+	// FeeCollector returns the balance of the feemarket fee collector and the
+	// cumulative amount of fees swept from it.
+	FeeCollector(ctx context.Context, in *FeeCollectorRequest, opts ...grpc.CallOption) (*FeeCollectorResponse, error)
+	// DenomRates returns the static conversion rates of the denoms that fees can
+	// be paid in.
+	DenomRates(ctx context.Context, in *DenomRatesRequest, opts ...grpc.CallOption) (*DenomRatesResponse, error)
 }
 
 type queryClient struct {
@@ -91,6 +118,76 @@ func (c *queryClient) GasPrices(ctx context.Context, in *GasPricesRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) ScheduledParams(ctx context.Context, in *ScheduledParamsRequest, opts ...grpc.CallOption) (*ScheduledParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduledParamsResponse)
+	err := c.cc.Invoke(ctx, Query_ScheduledParams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GasPriceHistory(ctx context.Context, in *GasPriceHistoryRequest, opts ...grpc.CallOption) (*GasPriceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GasPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_GasPriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GasPriceRecord(ctx context.Context, in *GasPriceRecordRequest, opts ...grpc.CallOption) (*GasPriceRecordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GasPriceRecordResponse)
+	err := c.cc.Invoke(ctx, Query_GasPriceRecord_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeHistory(ctx context.Context, in *FeeHistoryRequest, opts ...grpc.CallOption) (*FeeHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeeHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_FeeHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProjectGasPrice(ctx context.Context, in *ProjectGasPriceRequest, opts ...grpc.CallOption) (*ProjectGasPriceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProjectGasPriceResponse)
+	err := c.cc.Invoke(ctx, Query_ProjectGasPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeCollector(ctx context.Context, in *FeeCollectorRequest, opts ...grpc.CallOption) (*FeeCollectorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeeCollectorResponse)
+	err := c.cc.Invoke(ctx, Query_FeeCollector_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomRates(ctx context.Context, in *DenomRatesRequest, opts ...grpc.CallOption) (*DenomRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DenomRatesResponse)
+	err := c.cc.Invoke(ctx, Query_DenomRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -107,6 +204,26 @@ type QueryServer interface {
 	// GasPrices returns the current feemarket module list of gas prices
 	// in all available denoms.
 	GasPrices(context.Context, *GasPricesRequest) (*GasPricesResponse, error)
+	// ScheduledParams returns the pending parameter changes ordered by the
+	// height at which they are applied.
+	ScheduledParams(context.Context, *ScheduledParamsRequest) (*ScheduledParamsResponse, error)
+	// GasPriceHistory returns the recorded gas price history ordered by height.
+	GasPriceHistory(context.Context, *GasPriceHistoryRequest) (*GasPriceHistoryResponse, error)
+	// GasPriceRecord returns the recorded gas price at the given height.
+	GasPriceRecord(context.Context, *GasPriceRecordRequest) (*GasPriceRecordResponse, error)
+	// FeeHistory returns the base gas prices, gas used ratios and tip
+	// percentiles of a range of recorded blocks, modeled after Ethereum's
+	// eth_feeHistory.
+	FeeHistory(context.Context, *FeeHistoryRequest) (*FeeHistoryResponse, error)
+	// ProjectGasPrice returns the projected base gas price and learning rate of
+	// the next blocks under an assumed block utilization.
+	ProjectGasPrice(context.Context, *ProjectGasPriceRequest) (*ProjectGasPriceResponse, error)
+	// FeeCollector returns the balance of the feemarket fee collector and the
+	// cumulative amount of fees swept from it.
+	FeeCollector(context.Context, *FeeCollectorRequest) (*FeeCollectorResponse, error)
+	// DenomRates returns the static conversion rates of the denoms that fees can
+	// be paid in.
+	DenomRates(context.Context, *DenomRatesRequest) (*DenomRatesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -126,6 +243,27 @@ func (UnimplementedQueryServer) GasPrice(context.Context, *GasPriceRequest) (*Ga
 func (UnimplementedQueryServer) GasPrices(context.Context, *GasPricesRequest) (*GasPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GasPrices not implemented")
 }
+func (UnimplementedQueryServer) ScheduledParams(context.Context, *ScheduledParamsRequest) (*ScheduledParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledParams not implemented")
+}
+func (UnimplementedQueryServer) GasPriceHistory(context.Context, *GasPriceHistoryRequest) (*GasPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasPriceHistory not implemented")
+}
+func (UnimplementedQueryServer) GasPriceRecord(context.Context, *GasPriceRecordRequest) (*GasPriceRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasPriceRecord not implemented")
+}
+func (UnimplementedQueryServer) FeeHistory(context.Context, *FeeHistoryRequest) (*FeeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeHistory not implemented")
+}
+func (UnimplementedQueryServer) ProjectGasPrice(context.Context, *ProjectGasPriceRequest) (*ProjectGasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectGasPrice not implemented")
+}
+func (UnimplementedQueryServer) FeeCollector(context.Context, *FeeCollectorRequest) (*FeeCollectorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeCollector not implemented")
+}
+func (UnimplementedQueryServer) DenomRates(context.Context, *DenomRatesRequest) (*DenomRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomRates not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -211,6 +349,132 @@ func _Query_GasPrices_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ScheduledParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduledParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ScheduledParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledParams(ctx, req.(*ScheduledParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GasPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GasPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GasPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GasPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasPriceHistory(ctx, req.(*GasPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GasPriceRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GasPriceRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GasPriceRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GasPriceRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasPriceRecord(ctx, req.(*GasPriceRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeeHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeHistory(ctx, req.(*FeeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectGasPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectGasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProjectGasPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectGasPrice(ctx, req.(*ProjectGasPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeCollector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeeCollectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeCollector(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeeCollector_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeCollector(ctx, req.(*FeeCollectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenomRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DenomRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomRates(ctx, req.(*DenomRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -234,6 +498,34 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GasPrices",
 			Handler:    _Query_GasPrices_Handler,
 		},
+		{
+			MethodName: "ScheduledParams",
+			Handler:    _Query_ScheduledParams_Handler,
+		},
+		{
+			MethodName: "GasPriceHistory",
+			Handler:    _Query_GasPriceHistory_Handler,
+		},
+		{
+			MethodName: "GasPriceRecord",
+			Handler:    _Query_GasPriceRecord_Handler,
+		},
+		{
+			MethodName: "FeeHistory",
+			Handler:    _Query_FeeHistory_Handler,
+		},
+		{
+			MethodName: "ProjectGasPrice",
+			Handler:    _Query_ProjectGasPrice_Handler,
+		},
+		{
+			MethodName: "FeeCollector",
+			Handler:    _Query_FeeCollector_Handler,
+		},
+		{
+			MethodName: "DenomRates",
+			Handler:    _Query_DenomRates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "feemarket/feemarket/v1/query.proto",
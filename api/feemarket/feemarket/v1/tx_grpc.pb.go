@@ -19,7 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	Msg_Params_FullMethodName = "/feemarket.feemarket.v1.Msg/Params"
+	Msg_Params_FullMethodName                = "/feemarket.feemarket.v1.Msg/Params"
+	Msg_UpdateParamsPartial_FullMethodName   = "/feemarket.feemarket.v1.Msg/UpdateParamsPartial"
+	Msg_ScheduleParams_FullMethodName        = "/feemarket.feemarket.v1.Msg/ScheduleParams"
+	Msg_CancelScheduledParams_FullMethodName = "/feemarket.feemarket.v1.Msg/CancelScheduledParams"
+	Msg_SetBaseGasPrice_FullMethodName       = "/feemarket.feemarket.v1.Msg/SetBaseGasPrice"
+	Msg_FreezeFeeMarket_FullMethodName       = "/feemarket.feemarket.v1.Msg/FreezeFeeMarket"
+	Msg_UnfreezeFeeMarket_FullMethodName     = "/feemarket.feemarket.v1.Msg/UnfreezeFeeMarket"
+	Msg_SweepFeeCollector_FullMethodName     = "/feemarket.feemarket.v1.Msg/SweepFeeCollector"
+	Msg_SetDenomRate_FullMethodName          = "/feemarket.feemarket.v1.Msg/SetDenomRate"
+	Msg_RemoveDenom_FullMethodName           = "/feemarket.feemarket.v1.Msg/RemoveDenom"
 )
 
 // MsgClient is the client API for Msg service.
@@ -31,6 +40,34 @@ const (
 type MsgClient interface {
 	// Params defines a method for updating the feemarket module parameters.
 	Params(ctx context.Context, in *MsgParams, opts ...grpc.CallOption) (*MsgParamsResponse, error)
+	// UpdateParamsPartial defines a method for updating a subset of the
+	// feemarket module parameters.
+	UpdateParamsPartial(ctx context.Context, in *MsgUpdateParamsPartial, opts ...grpc.CallOption) (*MsgUpdateParamsPartialResponse, error)
+	// ScheduleParams defines a method for scheduling a change of the feemarket
+	// module parameters at a future block height.
+	ScheduleParams(ctx context.Context, in *MsgScheduleParams, opts ...grpc.CallOption) (*MsgScheduleParamsResponse, error)
+	// CancelScheduledParams defines a method for cancelling a change of the
+	// feemarket module parameters that is scheduled at a future block height.
+	CancelScheduledParams(ctx context.Context, in *MsgCancelScheduledParams, opts ...grpc.CallOption) (*MsgCancelScheduledParamsResponse, error)
+	// SetBaseGasPrice defines a method for overriding the current base gas
+	// price of the fee market.
+	SetBaseGasPrice(ctx context.Context, in *MsgSetBaseGasPrice, opts ...grpc.CallOption) (*MsgSetBaseGasPriceResponse, error)
+	// FreezeFeeMarket defines a method for freezing the learning rate and base
+	// gas price adjustments of the fee market.
+	FreezeFeeMarket(ctx context.Context, in *MsgFreezeFeeMarket, opts ...grpc.CallOption) (*MsgFreezeFeeMarketResponse, error)
+	// UnfreezeFeeMarket defines a method for resuming the learning rate and base
+	// gas price adjustments of a frozen fee market.
+	UnfreezeFeeMarket(ctx context.Context, in *MsgUnfreezeFeeMarket, opts ...grpc.CallOption) (*MsgUnfreezeFeeMarketResponse, error)
+	// SweepFeeCollector defines a method for burning the fees accumulated in the
+	// feemarket fee collector or sending them to the community pool or a module
+	// account.
+	SweepFeeCollector(ctx context.Context, in *MsgSweepFeeCollector, opts ...grpc.CallOption) (*MsgSweepFeeCollectorResponse, error)
+	// SetDenomRate defines a method for setting the static conversion rate of a
+	// denom that fees can be paid in.
+	SetDenomRate(ctx context.Context, in *MsgSetDenomRate, opts ...grpc.CallOption) (*MsgSetDenomRateResponse, error)
+	// RemoveDenom defines a method for removing a denom and its static
+	// conversion rate, so that fees can no longer be paid in it.
+	RemoveDenom(ctx context.Context, in *MsgRemoveDenom, opts ...grpc.CallOption) (*MsgRemoveDenomResponse, error)
 }
 
 type msgClient struct {
@@ -51,6 +88,96 @@ func (c *msgClient) Params(ctx context.Context, in *MsgParams, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) UpdateParamsPartial(ctx context.Context, in *MsgUpdateParamsPartial, opts ...grpc.CallOption) (*MsgUpdateParamsPartialResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateParamsPartialResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParamsPartial_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleParams(ctx context.Context, in *MsgScheduleParams, opts ...grpc.CallOption) (*MsgScheduleParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgScheduleParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_ScheduleParams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledParams(ctx context.Context, in *MsgCancelScheduledParams, opts ...grpc.CallOption) (*MsgCancelScheduledParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCancelScheduledParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelScheduledParams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetBaseGasPrice(ctx context.Context, in *MsgSetBaseGasPrice, opts ...grpc.CallOption) (*MsgSetBaseGasPriceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetBaseGasPriceResponse)
+	err := c.cc.Invoke(ctx, Msg_SetBaseGasPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FreezeFeeMarket(ctx context.Context, in *MsgFreezeFeeMarket, opts ...grpc.CallOption) (*MsgFreezeFeeMarketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgFreezeFeeMarketResponse)
+	err := c.cc.Invoke(ctx, Msg_FreezeFeeMarket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnfreezeFeeMarket(ctx context.Context, in *MsgUnfreezeFeeMarket, opts ...grpc.CallOption) (*MsgUnfreezeFeeMarketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUnfreezeFeeMarketResponse)
+	err := c.cc.Invoke(ctx, Msg_UnfreezeFeeMarket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SweepFeeCollector(ctx context.Context, in *MsgSweepFeeCollector, opts ...grpc.CallOption) (*MsgSweepFeeCollectorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSweepFeeCollectorResponse)
+	err := c.cc.Invoke(ctx, Msg_SweepFeeCollector_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomRate(ctx context.Context, in *MsgSetDenomRate, opts ...grpc.CallOption) (*MsgSetDenomRateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetDenomRateResponse)
+	err := c.cc.Invoke(ctx, Msg_SetDenomRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveDenom(ctx context.Context, in *MsgRemoveDenom, opts ...grpc.CallOption) (*MsgRemoveDenomResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRemoveDenomResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveDenom_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -60,6 +187,34 @@ func (c *msgClient) Params(ctx context.Context, in *MsgParams, opts ...grpc.Call
 type MsgServer interface {
 	// Params defines a method for updating the feemarket module parameters.
 	Params(context.Context, *MsgParams) (*MsgParamsResponse, error)
+	// UpdateParamsPartial defines a method for updating a subset of the
+	// feemarket module parameters.
+	UpdateParamsPartial(context.Context, *MsgUpdateParamsPartial) (*MsgUpdateParamsPartialResponse, error)
+	// ScheduleParams defines a method for scheduling a change of the feemarket
+	// module parameters at a future block height.
+	ScheduleParams(context.Context, *MsgScheduleParams) (*MsgScheduleParamsResponse, error)
+	// CancelScheduledParams defines a method for cancelling a change of the
+	// feemarket module parameters that is scheduled at a future block height.
+	CancelScheduledParams(context.Context, *MsgCancelScheduledParams) (*MsgCancelScheduledParamsResponse, error)
+	// SetBaseGasPrice defines a method for overriding the current base gas
+	// price of the fee market.
+	SetBaseGasPrice(context.Context, *MsgSetBaseGasPrice) (*MsgSetBaseGasPriceResponse, error)
+	// FreezeFeeMarket defines a method for freezing the learning rate and base
+	// gas price adjustments of the fee market.
+	FreezeFeeMarket(context.Context, *MsgFreezeFeeMarket) (*MsgFreezeFeeMarketResponse, error)
+	// UnfreezeFeeMarket defines a method for resuming the learning rate and base
+	// gas price adjustments of a frozen fee market.
+	UnfreezeFeeMarket(context.Context, *MsgUnfreezeFeeMarket) (*MsgUnfreezeFeeMarketResponse, error)
+	// SweepFeeCollector defines a method for burning the fees accumulated in the
+	// feemarket fee collector or sending them to the community pool or a module
+	// account.
+	SweepFeeCollector(context.Context, *MsgSweepFeeCollector) (*MsgSweepFeeCollectorResponse, error)
+	// SetDenomRate defines a method for setting the static conversion rate of a
+	// denom that fees can be paid in.
+	SetDenomRate(context.Context, *MsgSetDenomRate) (*MsgSetDenomRateResponse, error)
+	// RemoveDenom defines a method for removing a denom and its static
+	// conversion rate, so that fees can no longer be paid in it.
+	RemoveDenom(context.Context, *MsgRemoveDenom) (*MsgRemoveDenomResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -70,6 +225,33 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) Params(context.Context, *MsgParams) (*MsgParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedMsgServer) UpdateParamsPartial(context.Context, *MsgUpdateParamsPartial) (*MsgUpdateParamsPartialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParamsPartial not implemented")
+}
+func (UnimplementedMsgServer) ScheduleParams(context.Context, *MsgScheduleParams) (*MsgScheduleParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleParams not implemented")
+}
+func (UnimplementedMsgServer) CancelScheduledParams(context.Context, *MsgCancelScheduledParams) (*MsgCancelScheduledParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledParams not implemented")
+}
+func (UnimplementedMsgServer) SetBaseGasPrice(context.Context, *MsgSetBaseGasPrice) (*MsgSetBaseGasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBaseGasPrice not implemented")
+}
+func (UnimplementedMsgServer) FreezeFeeMarket(context.Context, *MsgFreezeFeeMarket) (*MsgFreezeFeeMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeFeeMarket not implemented")
+}
+func (UnimplementedMsgServer) UnfreezeFeeMarket(context.Context, *MsgUnfreezeFeeMarket) (*MsgUnfreezeFeeMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeFeeMarket not implemented")
+}
+func (UnimplementedMsgServer) SweepFeeCollector(context.Context, *MsgSweepFeeCollector) (*MsgSweepFeeCollectorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SweepFeeCollector not implemented")
+}
+func (UnimplementedMsgServer) SetDenomRate(context.Context, *MsgSetDenomRate) (*MsgSetDenomRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomRate not implemented")
+}
+func (UnimplementedMsgServer) RemoveDenom(context.Context, *MsgRemoveDenom) (*MsgRemoveDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDenom not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -101,6 +283,168 @@ func _Msg_Params_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParamsPartial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParamsPartial)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParamsPartial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParamsPartial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParamsPartial(ctx, req.(*MsgUpdateParamsPartial))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ScheduleParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleParams(ctx, req.(*MsgScheduleParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelScheduledParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledParams(ctx, req.(*MsgCancelScheduledParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetBaseGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBaseGasPrice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBaseGasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetBaseGasPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBaseGasPrice(ctx, req.(*MsgSetBaseGasPrice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FreezeFeeMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezeFeeMarket)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FreezeFeeMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FreezeFeeMarket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezeFeeMarket(ctx, req.(*MsgFreezeFeeMarket))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnfreezeFeeMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnfreezeFeeMarket)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnfreezeFeeMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnfreezeFeeMarket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnfreezeFeeMarket(ctx, req.(*MsgUnfreezeFeeMarket))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SweepFeeCollector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSweepFeeCollector)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SweepFeeCollector(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SweepFeeCollector_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SweepFeeCollector(ctx, req.(*MsgSweepFeeCollector))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomRate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetDenomRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomRate(ctx, req.(*MsgSetDenomRate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveDenom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveDenom(ctx, req.(*MsgRemoveDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -112,6 +456,42 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Msg_Params_Handler,
 		},
+		{
+			MethodName: "UpdateParamsPartial",
+			Handler:    _Msg_UpdateParamsPartial_Handler,
+		},
+		{
+			MethodName: "ScheduleParams",
+			Handler:    _Msg_ScheduleParams_Handler,
+		},
+		{
+			MethodName: "CancelScheduledParams",
+			Handler:    _Msg_CancelScheduledParams_Handler,
+		},
+		{
+			MethodName: "SetBaseGasPrice",
+			Handler:    _Msg_SetBaseGasPrice_Handler,
+		},
+		{
+			MethodName: "FreezeFeeMarket",
+			Handler:    _Msg_FreezeFeeMarket_Handler,
+		},
+		{
+			MethodName: "UnfreezeFeeMarket",
+			Handler:    _Msg_UnfreezeFeeMarket_Handler,
+		},
+		{
+			MethodName: "SweepFeeCollector",
+			Handler:    _Msg_SweepFeeCollector_Handler,
+		},
+		{
+			MethodName: "SetDenomRate",
+			Handler:    _Msg_SetDenomRate_Handler,
+		},
+		{
+			MethodName: "RemoveDenom",
+			Handler:    _Msg_RemoveDenom_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "feemarket/feemarket/v1/tx.proto",
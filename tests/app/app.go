@@ -102,6 +102,7 @@ import (
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
 	"github.com/skip-mev/feemarket/x/feemarket"
+	feemarketante "github.com/skip-mev/feemarket/x/feemarket/ante"
 	feemarketkeeper "github.com/skip-mev/feemarket/x/feemarket/keeper"
 	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
 )
@@ -371,7 +372,7 @@ func NewSimApp(
 		),
 	)
 
-	app.FeeMarketKeeper = feemarketkeeper.NewKeeper(appCodec, keys[feemarkettypes.StoreKey], app.AccountKeeper, &feemarkettypes.TestDenomResolver{}, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	app.FeeMarketKeeper = feemarketkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[feemarkettypes.StoreKey]), app.AccountKeeper, app.BankKeeper, app.DistrKeeper, &feemarkettypes.TestDenomResolver{}, nil, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	/****  Module Options ****/
 
@@ -517,6 +518,8 @@ func NewSimApp(
 		FeegrantKeeper:  app.FeeGrantKeeper,
 		SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 		SignModeHandler: app.txConfig.SignModeHandler(),
+		// allow the fee market extension option in txs
+		ExtensionOptionChecker: feemarketante.FeeMarketExtensionOptionChecker,
 	}
 
 	anteOptions := AnteHandlerOptions{
@@ -531,9 +534,11 @@ func NewSimApp(
 	}
 
 	postHandlerOptions := PostHandlerOptions{
-		AccountKeeper:   app.AccountKeeper,
-		BankKeeper:      app.BankKeeper,
-		FeeMarketKeeper: app.FeeMarketKeeper,
+		AccountKeeper:      app.AccountKeeper,
+		BankKeeper:         app.BankKeeper,
+		FeeMarketKeeper:    app.FeeMarketKeeper,
+		StakingKeeper:      app.StakingKeeper,
+		DistributionKeeper: app.DistrKeeper,
 	}
 	postHandler, err := NewPostHandler(postHandlerOptions)
 	if err != nil {
@@ -10,9 +10,11 @@ import (
 
 // PostHandlerOptions are the options required for constructing a FeeMarket PostHandler.
 type PostHandlerOptions struct {
-	AccountKeeper   feemarketpost.AccountKeeper
-	BankKeeper      feemarketpost.BankKeeper
-	FeeMarketKeeper feemarketpost.FeeMarketKeeper
+	AccountKeeper      feemarketpost.AccountKeeper
+	BankKeeper         feemarketpost.BankKeeper
+	FeeMarketKeeper    feemarketpost.FeeMarketKeeper
+	StakingKeeper      feemarketpost.StakingKeeper
+	DistributionKeeper feemarketpost.DistributionKeeper
 }
 
 // NewPostHandler returns a PostHandler chain with the fee deduct decorator.
@@ -29,11 +31,21 @@ func NewPostHandler(options PostHandlerOptions) (sdk.PostHandler, error) {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "feemarket keeper is required for post builder")
 	}
 
+	if options.StakingKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "staking keeper is required for post builder")
+	}
+
+	if options.DistributionKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "distribution keeper is required for post builder")
+	}
+
 	postDecorators := []sdk.PostDecorator{
 		feemarketpost.NewFeeMarketDeductDecorator(
 			options.AccountKeeper,
 			options.BankKeeper,
 			options.FeeMarketKeeper,
+			options.StakingKeeper,
+			options.DistributionKeeper,
 		),
 	}
 
@@ -81,16 +81,22 @@ func (s *IntegrationTestSuite) TestParams() {
 
 	s.Run("set and get custom params", func() {
 		params := types.Params{
-			Alpha:               math.LegacyMustNewDecFromStr("0.1"),
-			Beta:                math.LegacyMustNewDecFromStr("0.1"),
-			Gamma:               math.LegacyMustNewDecFromStr("0.1"),
-			Delta:               math.LegacyMustNewDecFromStr("0.1"),
-			MinBaseGasPrice:     math.LegacyNewDec(10),
-			MinLearningRate:     math.LegacyMustNewDecFromStr("0.1"),
-			MaxLearningRate:     math.LegacyMustNewDecFromStr("0.1"),
-			MaxBlockUtilization: 10,
-			Window:              1,
-			Enabled:             true,
+			Alpha:                       math.LegacyMustNewDecFromStr("0.1"),
+			Beta:                        math.LegacyMustNewDecFromStr("0.1"),
+			Gamma:                       math.LegacyMustNewDecFromStr("0.1"),
+			Delta:                       math.LegacyMustNewDecFromStr("0.1"),
+			MinBaseGasPrice:             math.LegacyNewDec(10),
+			MinLearningRate:             math.LegacyMustNewDecFromStr("0.1"),
+			MaxLearningRate:             math.LegacyMustNewDecFromStr("0.1"),
+			MaxBlockUtilization:         10,
+			Window:                      1,
+			Enabled:                     true,
+			TargetBlockUtilizationRatio: math.LegacyMustNewDecFromStr("0.5"),
+			MaxBaseGasPrice:             math.LegacyZeroDec(),
+			MaxChangePerBlock:           math.LegacyZeroDec(),
+			BurnRatio:                   math.LegacyZeroDec(),
+			StakerRatio:                 math.LegacyZeroDec(),
+			CommunityPoolRatio:          math.LegacyZeroDec(),
 		}
 
 		err := s.FeeMarketKeeper.SetParams(s.ctx, params)
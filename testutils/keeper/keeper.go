@@ -7,9 +7,12 @@ import (
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	testkeeper "github.com/skip-mev/chaintestutil/keeper"
 	"github.com/stretchr/testify/require"
@@ -42,7 +45,7 @@ func NewTestSetup(t testing.TB, options ...testkeeper.SetupOption) (sdk.Context,
 	_, tk, tms := testkeeper.NewTestSetup(t, options...)
 
 	// initialize extra keeper
-	feeMarketKeeper := FeeMarket(tk.Initializer, tk.AccountKeeper)
+	feeMarketKeeper := FeeMarket(tk.Initializer, tk.AccountKeeper, tk.BankKeeper, tk.DistrKeeper)
 	require.NoError(t, tk.Initializer.LoadLatest())
 
 	// initialize msg servers
@@ -75,15 +78,20 @@ func NewTestSetup(t testing.TB, options ...testkeeper.SetupOption) (sdk.Context,
 func FeeMarket(
 	initializer *testkeeper.Initializer,
 	authKeeper authkeeper.AccountKeeper,
+	bankKeeper bankkeeper.Keeper,
+	distrKeeper distrkeeper.Keeper,
 ) *feemarketkeeper.Keeper {
 	storeKey := storetypes.NewKVStoreKey(feemarkettypes.StoreKey)
 	initializer.StateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, initializer.DB)
 
 	return feemarketkeeper.NewKeeper(
 		initializer.Codec,
-		storeKey,
+		runtime.NewKVStoreService(storeKey),
 		authKeeper,
+		bankKeeper,
+		distrKeeper,
 		&feemarkettypes.TestDenomResolver{},
+		nil,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 }
@@ -43,10 +43,10 @@ type BankKeeper interface {
 //
 //go:generate mockery --name FeeMarketKeeper --filename mock_feemarket_keeper.go
 type FeeMarketKeeper interface {
-	GetState(ctx sdk.Context) (feemarkettypes.State, error)
-	GetMinGasPrice(ctx sdk.Context, denom string) (sdk.DecCoin, error)
-	GetParams(ctx sdk.Context) (feemarkettypes.Params, error)
-	SetState(ctx sdk.Context, state feemarkettypes.State) error
-	SetParams(ctx sdk.Context, params feemarkettypes.Params) error
-	ResolveToDenom(ctx sdk.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error)
+	GetState(ctx context.Context) (feemarkettypes.State, error)
+	GetMinGasPrice(ctx context.Context, denom string) (sdk.DecCoin, error)
+	GetParams(ctx context.Context) (feemarkettypes.Params, error)
+	SetState(ctx context.Context, state feemarkettypes.State) error
+	SetParams(ctx context.Context, params feemarkettypes.Params) error
+	ResolveToDenom(ctx context.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error)
 }
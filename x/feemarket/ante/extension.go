@@ -0,0 +1,82 @@
+package ante
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// FeeMarketExtensionOptionChecker is an ExtensionOptionChecker for the SDK's
+// ExtensionOptionsDecorator that accepts the x/feemarket FeeMarketExtensionOption.
+// Chains that accept other extension options must combine it with their own checker.
+func FeeMarketExtensionOptionChecker(option *codectypes.Any) bool {
+	return option.GetTypeUrl() == sdk.MsgTypeURL(&feemarkettypes.FeeMarketExtensionOption{})
+}
+
+// GetFeeMarketExtensionOption returns the FeeMarketExtensionOption of the tx, or nil if
+// the tx does not carry one. An error is returned if the tx carries more than one option
+// or if the option is invalid.
+func GetFeeMarketExtensionOption(tx sdk.Tx) (*feemarkettypes.FeeMarketExtensionOption, error) {
+	extTx, ok := tx.(authante.HasExtensionOptionsTx)
+	if !ok {
+		return nil, nil
+	}
+
+	var option *feemarkettypes.FeeMarketExtensionOption
+	for _, anyOption := range extTx.GetExtensionOptions() {
+		if !FeeMarketExtensionOptionChecker(anyOption) {
+			continue
+		}
+
+		if option != nil {
+			return nil, feemarkettypes.ErrInvalidExtensionOption.Wrap("only one fee market extension option may be provided")
+		}
+
+		option = &feemarkettypes.FeeMarketExtensionOption{}
+		if err := option.Unmarshal(anyOption.GetValue()); err != nil {
+			return nil, sdkerrors.ErrTxDecode.Wrapf("unable to decode fee market extension option: %s", err)
+		}
+
+		if err := option.ValidateBasic(); err != nil {
+			return nil, err
+		}
+	}
+
+	return option, nil
+}
+
+// CheckExtensionOption checks that the gas price cap of the given extension option
+// covers the given gas price. A nil option always passes.
+func CheckExtensionOption(option *feemarkettypes.FeeMarketExtensionOption, gasPrice sdk.DecCoin) error {
+	if option == nil {
+		return nil
+	}
+
+	if option.MaxFeePerGas.LT(gasPrice.Amount) {
+		return sdkerrors.ErrInsufficientFee.Wrapf(
+			"max fee per gas %s is lower than the min gas price %s",
+			option.MaxFeePerGas,
+			gasPrice,
+		)
+	}
+
+	return nil
+}
+
+// CapFee caps the given fee at the highest gas price that the tx pays for the given gas
+// limit according to its extension option. A nil option does not cap the fee.
+func CapFee(option *feemarkettypes.FeeMarketExtensionOption, fee sdk.Coin, gasPrice sdk.DecCoin, gasLimit int64) sdk.Coin {
+	if option == nil {
+		return fee
+	}
+
+	maxFee := gasPrice.Amount.Add(option.MaxTipPerGas(gasPrice.Amount)).MulInt64(gasLimit).TruncateInt()
+	if maxFee.LT(fee.Amount) {
+		return sdk.NewCoin(fee.Denom, maxFee)
+	}
+
+	return fee
+}
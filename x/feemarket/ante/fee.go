@@ -2,6 +2,7 @@ package ante
 
 import (
 	"bytes"
+	"context"
 	"math"
 
 	errorsmod "cosmossdk.io/errors"
@@ -105,8 +106,12 @@ func (dfd feeMarketCheckDecorator) anteHandle(ctx sdk.Context, tx sdk.Tx, simula
 	if len(feeCoins) == 0 && !simulate {
 		return ctx, errorsmod.Wrapf(feemarkettypes.ErrNoFeeCoins, "got length %d", len(feeCoins))
 	}
+
+	feeGas := int64(feeTx.GetGas())
+
+	// fees paid with a combination of coins are checked by their value in the base denom
 	if len(feeCoins) > 1 {
-		return ctx, errorsmod.Wrapf(feemarkettypes.ErrTooManyFeeCoins, "got length %d", len(feeCoins))
+		return dfd.anteHandleFeeCoins(ctx, tx, simulate, next, params, feeCoins, feeGas)
 	}
 
 	// if simulating - create a dummy zero value for the user
@@ -115,8 +120,6 @@ func (dfd feeMarketCheckDecorator) anteHandle(ctx sdk.Context, tx sdk.Tx, simula
 		payCoin = feeCoins[0]
 	}
 
-	feeGas := int64(feeTx.GetGas())
-
 	minGasPrice, err := dfd.feemarketKeeper.GetMinGasPrice(ctx, payCoin.GetDenom())
 	if err != nil {
 		return ctx, errorsmod.Wrapf(err, "unable to get min gas price for denom %s", payCoin.GetDenom())
@@ -130,20 +133,31 @@ func (dfd feeMarketCheckDecorator) anteHandle(ctx sdk.Context, tx sdk.Tx, simula
 
 	ctx = ctx.WithMinGasPrices(sdk.NewDecCoins(minGasPrice))
 
+	extOption, err := GetFeeMarketExtensionOption(tx)
+	if err != nil {
+		return ctx, err
+	}
+
 	if !simulate {
 		_, _, err := CheckTxFee(ctx, minGasPrice, payCoin, feeGas, true)
 		if err != nil {
 			return ctx, errorsmod.Wrapf(err, "error checking fee")
 		}
+
+		if err := CheckExtensionOption(extOption, minGasPrice); err != nil {
+			return ctx, errorsmod.Wrapf(err, "error checking fee")
+		}
 	}
 
 	// escrow the entire amount that the account provided as fee (feeCoin)
-	err = dfd.EscrowFunds(ctx, tx, payCoin)
+	err = dfd.EscrowFunds(ctx, tx, sdk.NewCoins(payCoin))
 	if err != nil {
 		return ctx, errorsmod.Wrapf(err, "error escrowing funds")
 	}
+	ctx = feemarkettypes.ContextWithEscrowedFees(ctx, sdk.NewCoins(payCoin))
 
-	priorityFee, err := dfd.resolveTxPriorityCoins(ctx, payCoin, params.FeeDenom)
+	// the priority only accounts for the part of the fee the tx is willing to pay
+	priorityFee, err := dfd.resolveTxPriorityCoins(ctx, CapFee(extOption, payCoin, minGasPrice, feeGas), params.FeeDenom)
 	if err != nil {
 		return ctx, errorsmod.Wrapf(err, "error resolving fee priority")
 	}
@@ -158,6 +172,65 @@ func (dfd feeMarketCheckDecorator) anteHandle(ctx sdk.Context, tx sdk.Tx, simula
 	return next(ctx, tx, simulate)
 }
 
+// anteHandleFeeCoins checks if the combined value of the fee coins of a tx, resolved to the base denom,
+// provides sufficient fee to cover the required fee from the fee market.
+func (dfd feeMarketCheckDecorator) anteHandleFeeCoins(
+	ctx sdk.Context,
+	tx sdk.Tx,
+	simulate bool,
+	next sdk.AnteHandler,
+	params feemarkettypes.Params,
+	feeCoins sdk.Coins,
+	feeGas int64,
+) (sdk.Context, error) {
+	baseGasPrice, err := dfd.feemarketKeeper.GetMinGasPrice(ctx, params.FeeDenom)
+	if err != nil {
+		return ctx, errorsmod.Wrapf(err, "unable to get min gas price for denom %s", params.FeeDenom)
+	}
+
+	_, feeValue, err := ResolveFeeCoins(ctx, dfd.feemarketKeeper, feeCoins, params.FeeDenom)
+	if err != nil {
+		return ctx, errorsmod.Wrapf(err, "unable to resolve fee coins")
+	}
+
+	ctx.Logger().Debug("fee deduct ante handle",
+		"min gas prices", baseGasPrice,
+		"fee", feeCoins,
+		"fee value", feeValue,
+		"gas limit", feeGas,
+	)
+
+	ctx = ctx.WithMinGasPrices(sdk.NewDecCoins(baseGasPrice))
+
+	extOption, err := GetFeeMarketExtensionOption(tx)
+	if err != nil {
+		return ctx, err
+	}
+
+	if !simulate {
+		if err := CheckTxFees(baseGasPrice, feeCoins, feeValue, feeGas); err != nil {
+			return ctx, errorsmod.Wrapf(err, "error checking fee")
+		}
+
+		if err := CheckExtensionOption(extOption, baseGasPrice); err != nil {
+			return ctx, errorsmod.Wrapf(err, "error checking fee")
+		}
+	}
+
+	// escrow all the coins that the account provided as fee
+	err = dfd.EscrowFunds(ctx, tx, feeCoins)
+	if err != nil {
+		return ctx, errorsmod.Wrapf(err, "error escrowing funds")
+	}
+	ctx = feemarkettypes.ContextWithEscrowedFees(ctx, feeCoins)
+
+	// the priority only accounts for the part of the fee the tx is willing to pay
+	priorityFee := CapFee(extOption, sdk.NewCoin(params.FeeDenom, feeValue.TruncateInt()), baseGasPrice, feeGas)
+	ctx = ctx.WithPriority(GetTxPriority(priorityFee, feeGas, baseGasPrice))
+
+	return next(ctx, tx, simulate)
+}
+
 // resolveTxPriorityCoins converts the coins to the proper denom used for tx prioritization calculation.
 func (dfd feeMarketCheckDecorator) resolveTxPriorityCoins(ctx sdk.Context, fee sdk.Coin, baseDenom string) (sdk.Coin, error) {
 	if fee.Denom == baseDenom {
@@ -176,7 +249,7 @@ func (dfd feeMarketCheckDecorator) resolveTxPriorityCoins(ctx sdk.Context, fee s
 
 // EscrowFunds escrows the fully provided fee from the payer account during tx execution.
 // The actual fee is deducted in the post handler along with the tip.
-func (dfd feeMarketCheckDecorator) EscrowFunds(ctx sdk.Context, sdkTx sdk.Tx, providedFee sdk.Coin) error {
+func (dfd feeMarketCheckDecorator) EscrowFunds(ctx sdk.Context, sdkTx sdk.Tx, providedFee sdk.Coins) error {
 	feeTx, ok := sdkTx.(sdk.FeeTx)
 	if !ok {
 		return errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
@@ -192,8 +265,8 @@ func (dfd feeMarketCheckDecorator) EscrowFunds(ctx sdk.Context, sdkTx sdk.Tx, pr
 		if dfd.feegrantKeeper == nil {
 			return sdkerrors.ErrInvalidRequest.Wrap("fee grants are not enabled")
 		} else if !bytes.Equal(feeGranter, feePayer) {
-			if !providedFee.IsNil() {
-				err := dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranter, feePayer, sdk.NewCoins(providedFee),
+			if providedFee != nil {
+				err := dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranter, feePayer, providedFee,
 					sdkTx.GetMsgs())
 				if err != nil {
 					return errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feeGranter, feePayer)
@@ -209,7 +282,7 @@ func (dfd feeMarketCheckDecorator) EscrowFunds(ctx sdk.Context, sdkTx sdk.Tx, pr
 		return sdkerrors.ErrUnknownAddress.Wrapf("fee payer address: %s does not exist", deductFeesFrom)
 	}
 
-	return escrow(dfd.bankKeeper, ctx, deductFeesFromAcc, sdk.NewCoins(providedFee))
+	return escrow(dfd.bankKeeper, ctx, deductFeesFromAcc, providedFee)
 }
 
 // escrow deducts coins to the escrow.
@@ -269,6 +342,55 @@ func CheckTxFee(ctx sdk.Context, gasPrice sdk.DecCoin, feeCoin sdk.Coin, feeGas
 	return payCoin, tip, nil
 }
 
+// FeeCoinResolver resolves coins to a given denom, e.g. the x/feemarket keeper.
+type FeeCoinResolver interface {
+	ResolveToDenom(ctx context.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error)
+}
+
+// ResolveFeeCoins returns the value of each of the given fee coins in the given denom, as well as
+// their total value.
+func ResolveFeeCoins(ctx sdk.Context, resolver FeeCoinResolver, feeCoins sdk.Coins, denom string) ([]sdkmath.LegacyDec, sdkmath.LegacyDec, error) {
+	values := make([]sdkmath.LegacyDec, len(feeCoins))
+	total := sdkmath.LegacyZeroDec()
+
+	for i, coin := range feeCoins {
+		value := sdk.NewDecCoinFromCoin(coin)
+		if coin.Denom != denom {
+			var err error
+			value, err = resolver.ResolveToDenom(ctx, value, denom)
+			if err != nil {
+				return nil, sdkmath.LegacyDec{}, errorsmod.Wrapf(err, "unable to resolve fee coin %s", coin)
+			}
+		}
+
+		values[i] = value.Amount
+		total = total.Add(value.Amount)
+	}
+
+	return values, total, nil
+}
+
+// CheckTxFees implements the logic for the fee market to check if a Tx that pays its fee with multiple
+// coins has provided sufficient fees. The total value of the fee coins in the base denom must cover
+// ceil(baseGasPrice * gasLimit). Returns an error if insufficient fees.
+func CheckTxFees(baseGasPrice sdk.DecCoin, feeCoins sdk.Coins, feeValue sdkmath.LegacyDec, feeGas int64) error {
+	requiredFee := baseGasPrice.Amount.MulInt64(feeGas).Ceil()
+	if feeValue.LT(requiredFee) {
+		return sdkerrors.ErrInsufficientFee.Wrapf(
+			"got: %s worth %s%s required: %s%s, minGasPrice: %s, gas: %d",
+			feeCoins,
+			feeValue,
+			baseGasPrice.Denom,
+			requiredFee,
+			baseGasPrice.Denom,
+			baseGasPrice,
+			feeGas,
+		)
+	}
+
+	return nil
+}
+
 const (
 	// gasPricePrecision is the amount of digit precision to scale the gas prices to.
 	gasPricePrecision = 6
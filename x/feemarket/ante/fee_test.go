@@ -3,6 +3,7 @@ package ante_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 
@@ -14,6 +15,7 @@ import (
 
 	antesuite "github.com/skip-mev/feemarket/x/feemarket/ante/suite"
 	"github.com/skip-mev/feemarket/x/feemarket/types"
+	"github.com/skip-mev/feemarket/x/feemarket/types/mocks"
 )
 
 func TestAnteHandleMock(t *testing.T) {
@@ -213,6 +215,9 @@ func TestAnteHandle(t *testing.T) {
 	validFeeAmount := types.DefaultMinBaseGasPrice.MulInt64(int64(gasLimit))
 	validFee := sdk.NewCoins(sdk.NewCoin("stake", validFeeAmount.TruncateInt()))
 	validFeeDifferentDenom := sdk.NewCoins(sdk.NewCoin("atom", math.Int(validFeeAmount)))
+	halfFeeAmount := validFeeAmount.QuoInt64(2).Ceil().TruncateInt()
+	validFeeMultipleDenoms := sdk.NewCoins(sdk.NewCoin("stake", halfFeeAmount), sdk.NewCoin("atom", halfFeeAmount))
+	insufficientFeeMultipleDenoms := sdk.NewCoins(sdk.NewCoin("stake", halfFeeAmount), sdk.NewCoin("atom", halfFeeAmount.QuoRaw(2)))
 
 	testCases := []antesuite.TestCase{
 		{
@@ -378,6 +383,178 @@ func TestAnteHandle(t *testing.T) {
 			ExpErr:   nil,
 			Mock:     false,
 		},
+		{
+			Name: "signer has enough funds in multiple denoms, should pass",
+			Malleate: func(s *antesuite.TestSuite) antesuite.TestCaseArgs {
+				accs := s.CreateTestAccounts(1)
+
+				balance := antesuite.TestAccountBalance{
+					TestAccount: accs[0],
+					Coins:       validFeeMultipleDenoms,
+				}
+				s.SetAccountBalances([]antesuite.TestAccountBalance{balance})
+
+				return antesuite.TestCaseArgs{
+					Msgs:      []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
+					GasLimit:  gasLimit,
+					FeeAmount: validFeeMultipleDenoms,
+				}
+			},
+			RunAnte:  true,
+			RunPost:  false,
+			Simulate: false,
+			ExpPass:  true,
+			ExpErr:   nil,
+			Mock:     false,
+		},
+		{
+			Name: "multiple denoms with insufficient summed value, should fail",
+			Malleate: func(s *antesuite.TestSuite) antesuite.TestCaseArgs {
+				accs := s.CreateTestAccounts(1)
+
+				balance := antesuite.TestAccountBalance{
+					TestAccount: accs[0],
+					Coins:       validFeeMultipleDenoms,
+				}
+				s.SetAccountBalances([]antesuite.TestAccountBalance{balance})
+
+				return antesuite.TestCaseArgs{
+					Msgs:      []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
+					GasLimit:  gasLimit,
+					FeeAmount: insufficientFeeMultipleDenoms,
+				}
+			},
+			RunAnte:  true,
+			RunPost:  false,
+			Simulate: false,
+			ExpPass:  false,
+			ExpErr:   sdkerrors.ErrInsufficientFee,
+			Mock:     false,
+		},
+		{
+			Name: "twap resolved denom with a pumped spot price, should fail",
+			Malleate: func(s *antesuite.TestSuite) antesuite.TestCaseArgs {
+				accs := s.CreateTestAccounts(1)
+
+				// atom trades at 1 stake on average, but its spot price was pumped to 100 stake
+				pools := mocks.NewPoolKeeper(t)
+				pools.On("GetArithmeticTwapToNow", mock.Anything, uint64(1), "atom", "stake", mock.Anything).Return(math.LegacyOneDec(), nil)
+				pools.On("GetSpotPrice", mock.Anything, uint64(1), "atom", "stake").Return(math.LegacyNewDec(100), nil)
+
+				resolver, err := types.NewTwapDenomResolver(pools, types.TwapDenomResolverConfig{
+					FeeDenom: "stake",
+					Pools:    []types.TwapPool{{Denom: "atom", PoolID: 1}},
+					Window:   time.Hour,
+				})
+				if err != nil {
+					panic(err)
+				}
+				s.FeeMarketKeeper.SetDenomResolver(resolver)
+
+				fee := sdk.NewCoins(sdk.NewCoin("atom", validFeeAmount.QuoInt64(10).TruncateInt()))
+				balance := antesuite.TestAccountBalance{
+					TestAccount: accs[0],
+					Coins:       fee,
+				}
+				s.SetAccountBalances([]antesuite.TestAccountBalance{balance})
+
+				return antesuite.TestCaseArgs{
+					Msgs:      []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
+					GasLimit:  gasLimit,
+					FeeAmount: fee,
+				}
+			},
+			RunAnte:  true,
+			RunPost:  false,
+			Simulate: false,
+			ExpPass:  false,
+			ExpErr:   sdkerrors.ErrInsufficientFee,
+			Mock:     false,
+		},
+		{
+			Name: "signer has enough funds with extension option, should pass",
+			Malleate: func(s *antesuite.TestSuite) antesuite.TestCaseArgs {
+				accs := s.CreateTestAccounts(1)
+
+				balance := antesuite.TestAccountBalance{
+					TestAccount: accs[0],
+					Coins:       validFee,
+				}
+				s.SetAccountBalances([]antesuite.TestAccountBalance{balance})
+
+				return antesuite.TestCaseArgs{
+					Msgs:      []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
+					GasLimit:  gasLimit,
+					FeeAmount: validFee,
+					ExtensionOptions: antesuite.NewTestExtensionOption(
+						types.DefaultMinBaseGasPrice.MulInt64(2),
+						types.DefaultMinBaseGasPrice,
+					),
+				}
+			},
+			RunAnte:  true,
+			RunPost:  false,
+			Simulate: false,
+			ExpPass:  true,
+			ExpErr:   nil,
+			Mock:     false,
+		},
+		{
+			Name: "extension option max fee per gas below min gas price, should fail",
+			Malleate: func(s *antesuite.TestSuite) antesuite.TestCaseArgs {
+				accs := s.CreateTestAccounts(1)
+
+				balance := antesuite.TestAccountBalance{
+					TestAccount: accs[0],
+					Coins:       validFee,
+				}
+				s.SetAccountBalances([]antesuite.TestAccountBalance{balance})
+
+				return antesuite.TestCaseArgs{
+					Msgs:      []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
+					GasLimit:  gasLimit,
+					FeeAmount: validFee,
+					ExtensionOptions: antesuite.NewTestExtensionOption(
+						types.DefaultMinBaseGasPrice.QuoInt64(2),
+						math.LegacyZeroDec(),
+					),
+				}
+			},
+			RunAnte:  true,
+			RunPost:  false,
+			Simulate: false,
+			ExpPass:  false,
+			ExpErr:   sdkerrors.ErrInsufficientFee,
+			Mock:     false,
+		},
+		{
+			Name: "extension option max priority fee per gas above max fee per gas, should fail",
+			Malleate: func(s *antesuite.TestSuite) antesuite.TestCaseArgs {
+				accs := s.CreateTestAccounts(1)
+
+				balance := antesuite.TestAccountBalance{
+					TestAccount: accs[0],
+					Coins:       validFee,
+				}
+				s.SetAccountBalances([]antesuite.TestAccountBalance{balance})
+
+				return antesuite.TestCaseArgs{
+					Msgs:      []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
+					GasLimit:  gasLimit,
+					FeeAmount: validFee,
+					ExtensionOptions: antesuite.NewTestExtensionOption(
+						types.DefaultMinBaseGasPrice,
+						types.DefaultMinBaseGasPrice.MulInt64(2),
+					),
+				}
+			},
+			RunAnte:  true,
+			RunPost:  false,
+			Simulate: false,
+			ExpPass:  false,
+			ExpErr:   types.ErrInvalidExtensionOption,
+			Mock:     false,
+		},
 		{
 			Name: "no fee - fail",
 			Malleate: func(s *antesuite.TestSuite) antesuite.TestCaseArgs {
@@ -3,6 +3,7 @@ package suite
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -14,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/address"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/std"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
@@ -24,6 +26,9 @@ import (
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -50,6 +55,11 @@ type TestSuite struct {
 	FeeMarketKeeper *feemarketkeeper.Keeper
 	BankKeeper      bankkeeper.Keeper
 	FeeGrantKeeper  feemarketante.FeeGrantKeeper
+	StakingKeeper   *stakingkeeper.Keeper
+	DistrKeeper     distrkeeper.Keeper
+
+	// Proposer is the validator that proposes the blocks of the test context.
+	Proposer stakingtypes.Validator
 
 	MockBankKeeper     *mocks.BankKeeper
 	MockFeeGrantKeeper *mocks.FeeGrantKeeper
@@ -117,6 +127,8 @@ func SetupTestSuite(t *testing.T, mock bool) *TestSuite {
 	s.FeeMarketKeeper = testKeepers.FeeMarketKeeper
 	s.BankKeeper = testKeepers.BankKeeper
 	s.FeeGrantKeeper = testKeepers.FeeGrantKeeper
+	s.StakingKeeper = testKeepers.StakingKeeper
+	s.DistrKeeper = testKeepers.DistrKeeper
 
 	s.MockBankKeeper = mocks.NewBankKeeper(t)
 	s.MockFeeGrantKeeper = mocks.NewFeeGrantKeeper(t)
@@ -127,6 +139,8 @@ func SetupTestSuite(t *testing.T, mock bool) *TestSuite {
 	s.FeeMarketKeeper.SetEnabledHeight(s.Ctx, -1)
 	s.MsgServer = feemarketkeeper.NewMsgServer(s.FeeMarketKeeper)
 
+	s.SetupProposer(t)
+
 	s.SetupHandlers(mock)
 	s.SetT(t)
 
@@ -170,12 +184,35 @@ func (s *TestSuite) SetupHandlers(mock bool) {
 			s.AccountKeeper,
 			bankKeeper,
 			s.FeeMarketKeeper,
+			s.StakingKeeper,
+			s.DistrKeeper,
 		),
 	}
 
 	s.PostHandler = sdk.ChainPostDecorators(postDecorators...)
 }
 
+// SetupProposer creates a validator and sets it as the proposer of the test context.
+func (s *TestSuite) SetupProposer(t *testing.T) {
+	t.Helper()
+
+	pubKey := ed25519.GenPrivKey().PubKey()
+	operator := sdk.ValAddress(pubKey.Address())
+
+	validator, err := stakingtypes.NewValidator(operator.String(), pubKey, stakingtypes.Description{})
+	require.NoError(t, err)
+
+	require.NoError(t, s.StakingKeeper.SetValidator(s.Ctx, validator))
+	require.NoError(t, s.StakingKeeper.SetValidatorByConsAddr(s.Ctx, validator))
+	require.NoError(t, s.DistrKeeper.Hooks().AfterValidatorCreated(s.Ctx, operator))
+
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+
+	s.Ctx = s.Ctx.WithProposer(consAddr)
+	s.Proposer = validator
+}
+
 // TestCase represents a test case used in test tables.
 type TestCase struct {
 	Name              string
@@ -198,6 +235,8 @@ type TestCaseArgs struct {
 	GasLimit  uint64
 	Msgs      []sdk.Msg
 	Privs     []cryptotypes.PrivKey
+
+	ExtensionOptions []*codectypes.Any
 }
 
 // DeliverMsgs constructs a tx and runs it through the ante handler. This is used to set the context for a test case, for
@@ -217,6 +256,10 @@ func (s *TestSuite) RunTestCase(t *testing.T, tc TestCase, args TestCaseArgs) {
 	s.TxBuilder.SetFeeAmount(args.FeeAmount)
 	s.TxBuilder.SetGasLimit(args.GasLimit)
 
+	extBuilder, ok := s.TxBuilder.(authtx.ExtensionOptionsTxBuilder)
+	require.True(t, ok)
+	extBuilder.SetExtensionOptions(args.ExtensionOptions...)
+
 	// Theoretically speaking, ante handler unit tests should only test
 	// ante handlers, but here we sometimes also test the tx creation
 	// process.
@@ -241,7 +284,13 @@ func (s *TestSuite) RunTestCase(t *testing.T, tc TestCase, args TestCaseArgs) {
 	}
 
 	if tc.RunPost && anteErr == nil {
-		newCtx, postErr = s.PostHandler(s.Ctx, tx, tc.Simulate, true)
+		postCtx := s.Ctx
+		if tc.RunAnte {
+			// as in baseapp, the post handler sees the values the ante handler set on the context
+			postCtx = postCtx.WithContext(newCtx.Context())
+		}
+
+		newCtx, postErr = s.PostHandler(postCtx, tx, tc.Simulate, true)
 	}
 
 	if tc.ExpPass {
@@ -326,6 +375,18 @@ func (s *TestSuite) CreateTestTx(privs []cryptotypes.PrivKey, accNums []uint64,
 	return s.TxBuilder.GetTx(), nil
 }
 
+// NewTestExtensionOption returns a fee market extension option with the given caps.
+func NewTestExtensionOption(maxFeePerGas, maxPriorityFeePerGas math.LegacyDec) []*codectypes.Any {
+	option := feemarkettypes.NewFeeMarketExtensionOption(maxFeePerGas, maxPriorityFeePerGas)
+
+	anyOption, err := codectypes.NewAnyWithValue(&option)
+	if err != nil {
+		panic(err)
+	}
+
+	return []*codectypes.Any{anyOption}
+}
+
 // NewTestFeeAmount is a test fee amount.
 func NewTestFeeAmount() sdk.Coins {
 	return sdk.NewCoins(sdk.NewInt64Coin("stake", 150))
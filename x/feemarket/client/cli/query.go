@@ -2,7 +2,10 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cobra"
@@ -27,6 +30,13 @@ func GetQueryCmd() *cobra.Command {
 		GetStateCmd(),
 		GetGasPriceCmd(),
 		GetGasPricesCmd(),
+		GetScheduledParamsCmd(),
+		GetGasPriceHistoryCmd(),
+		GetGasPriceRecordCmd(),
+		GetFeeHistoryCmd(),
+		GetProjectGasPriceCmd(),
+		GetFeeCollectorCmd(),
+		GetDenomRatesCmd(),
 	)
 
 	return cmd
@@ -141,3 +151,260 @@ func GetGasPricesCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetScheduledParamsCmd returns the cli-command that queries the pending feemarket parameter changes.
+func GetScheduledParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-params",
+		Short: "Query for the pending feemarket parameter changes",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.ScheduledParams(cmd.Context(), &types.ScheduledParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetGasPriceHistoryCmd returns the cli-command that queries the recorded feemarket gas price history.
+func GetGasPriceHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gas-price-history",
+		Short: "Query for the recorded feemarket gas price history",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.GasPriceHistory(cmd.Context(), &types.GasPriceHistoryRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "gas-price-history")
+
+	return cmd
+}
+
+// GetGasPriceRecordCmd returns the cli-command that queries the feemarket gas price record of a given height.
+func GetGasPriceRecordCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gas-price-record [height]",
+		Short: "Query for the feemarket gas price record of a given height",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.GasPriceRecord(cmd.Context(), &types.GasPriceRecordRequest{
+				Height: height,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&resp.Record)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+const (
+	flagNewestBlock       = "newest-block"
+	flagRewardPercentiles = "reward-percentiles"
+)
+
+// GetFeeHistoryCmd returns the cli-command that queries the feemarket fee history of a range of blocks.
+func GetFeeHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-history [block-count]",
+		Short: "Query for the base gas prices, gas used ratios and tip percentiles of the most recent blocks",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			blockCount, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			newestBlock, err := cmd.Flags().GetInt64(flagNewestBlock)
+			if err != nil {
+				return err
+			}
+
+			rawPercentiles, err := cmd.Flags().GetStringSlice(flagRewardPercentiles)
+			if err != nil {
+				return err
+			}
+
+			percentiles := make([]math.LegacyDec, len(rawPercentiles))
+			for i, raw := range rawPercentiles {
+				percentiles[i], err = math.LegacyNewDecFromStr(raw)
+				if err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.FeeHistory(cmd.Context(), &types.FeeHistoryRequest{
+				BlockCount:        blockCount,
+				NewestBlock:       newestBlock,
+				RewardPercentiles: percentiles,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	cmd.Flags().Int64(flagNewestBlock, 0, "Height of the newest block to return, defaults to the latest recorded block")
+	cmd.Flags().StringSlice(flagRewardPercentiles, nil, "Comma separated, increasing list of tip percentiles in [0, 100] to return for each block")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetProjectGasPriceCmd returns the cli-command that queries the projected feemarket base gas price.
+func GetProjectGasPriceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "project-gas-price [block-count] [utilization-ratios]",
+		Short: "Query for the projected base gas price of the next blocks under a comma separated list of assumed utilization ratios",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			blockCount, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			rawRatios := strings.Split(args[1], ",")
+			ratios := make([]math.LegacyDec, len(rawRatios))
+			for i, raw := range rawRatios {
+				ratios[i], err = math.LegacyNewDecFromStr(strings.TrimSpace(raw))
+				if err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.ProjectGasPrice(cmd.Context(), &types.ProjectGasPriceRequest{
+				BlockCount:        blockCount,
+				UtilizationRatios: ratios,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetFeeCollectorCmd returns the cli-command that queries the balance of the feemarket fee
+// collector and the fees swept from it.
+func GetFeeCollectorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-collector",
+		Short: "Query for the balance of the feemarket fee collector and the fees swept from it",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.FeeCollector(cmd.Context(), &types.FeeCollectorRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetDenomRatesCmd returns the cli-command that queries the static conversion rates of the
+// denoms that fees can be paid in.
+func GetDenomRatesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-rates",
+		Short: "Query for the static conversion rates of the denoms that fees can be paid in",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.DenomRates(cmd.Context(), &types.DenomRatesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
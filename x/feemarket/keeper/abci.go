@@ -1,12 +1,34 @@
 package keeper
 
 import (
-	sdk "github.com/cosmos/cosmos-sdk/types"
+	"context"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
 )
 
 // EndBlock returns an endblocker for the x/feemarket module. The endblocker
 // is responsible for updating the state of the fee market based on the
-// AIMD learning rate adjustment algorithm.
-func (k *Keeper) EndBlock(ctx sdk.Context) error {
-	return k.UpdateFeeMarket(ctx)
+// AIMD learning rate adjustment algorithm. Parameters that are scheduled
+// for the current height are applied after the fee market is updated, so
+// they take effect from the next block on. The gas prices of the next block
+// are then recorded for the extra denoms of the denom resolver, and the
+// conversions cached by the denom resolver during the block are dropped.
+func (k *Keeper) EndBlock(ctx context.Context) error {
+	if err := k.UpdateFeeMarket(ctx); err != nil {
+		return err
+	}
+
+	if err := k.ApplyScheduledParams(ctx); err != nil {
+		return err
+	}
+
+	if err := k.RecordDenomGasPrices(ctx); err != nil {
+		return err
+	}
+
+	if cached, ok := k.resolver.(types.CachedDenomResolver); ok {
+		cached.ResetCache()
+	}
+
+	return nil
 }
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// SetLastDenomGasPrice sets the last gas price that was resolved for the denom of the
+// given gas price.
+func (k *Keeper) SetLastDenomGasPrice(ctx context.Context, price types.DenomGasPrice) error {
+	return k.LastDenomGasPrices.Set(ctx, price.Denom, price)
+}
+
+// GetAllLastDenomGasPrices returns the last gas prices that were resolved for the extra
+// denoms of the denom resolver, ordered by denom.
+func (k *Keeper) GetAllLastDenomGasPrices(ctx context.Context) ([]types.DenomGasPrice, error) {
+	iterator, err := k.LastDenomGasPrices.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return iterator.Values()
+}
+
+// RecordDenomGasPrices records the gas price of each extra denom of the denom resolver that
+// can be resolved at the end of the current block, together with the height of the next
+// block that the gas price applies to, and removes the last gas prices of the denoms that
+// are no longer extra denoms. The last gas price of a denom is only written when it changes,
+// and is kept when the denom cannot be resolved. Failures of the denom resolver are logged
+// and do not fail the block.
+func (k *Keeper) RecordDenomGasPrices(ctx context.Context) error {
+	if k.resolver == nil {
+		return nil
+	}
+
+	_, extraGasPrices, err := k.resolveExtraGasPrices(ctx)
+	if err != nil {
+		k.Logger(ctx).Info("failed to resolve the extra denom gas prices", "err", err)
+		return nil
+	}
+
+	// the gas prices are resolved from the base gas price of the next block
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight() + 1
+	extraDenoms := make(map[string]struct{}, len(extraGasPrices))
+
+	for _, extra := range extraGasPrices {
+		extraDenoms[extra.denom] = struct{}{}
+		if extra.err != nil {
+			continue
+		}
+
+		last, err := k.LastDenomGasPrices.Get(ctx, extra.denom)
+		switch {
+		case err == nil && last.Price.Equal(extra.gasPrice.Amount):
+			continue
+		case err != nil && !errors.Is(err, collections.ErrNotFound):
+			return err
+		}
+
+		price := types.DenomGasPrice{
+			Denom:  extra.denom,
+			Price:  extra.gasPrice.Amount,
+			Height: height,
+		}
+		if err := k.SetLastDenomGasPrice(ctx, price); err != nil {
+			return err
+		}
+	}
+
+	var removed []string
+	err = k.LastDenomGasPrices.Walk(ctx, nil, func(denom string, _ types.DenomGasPrice) (bool, error) {
+		if _, ok := extraDenoms[denom]; !ok {
+			removed = append(removed, denom)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, denom := range removed {
+		if err := k.LastDenomGasPrices.Remove(ctx, denom); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+	"github.com/skip-mev/feemarket/x/feemarket/types/mocks"
+)
+
+func (s *KeeperTestSuite) TestDenomGasPriceStatus() {
+	gs := types.DefaultAIMDGenesisState()
+	s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+	oracle := mocks.NewOracleKeeper(s.T())
+	resolver, err := types.NewOracleDenomResolver(oracle, types.OracleDenomResolverConfig{
+		CurrencyPairs: []types.OracleCurrencyPair{
+			{Denom: sdk.DefaultBondDenom, CurrencyPair: "STAKE/USD"},
+			{Denom: "atom", CurrencyPair: "ATOM/USD"},
+		},
+	})
+	s.Require().NoError(err)
+
+	s.feeMarketKeeper.SetDenomResolver(resolver)
+	defer s.feeMarketKeeper.SetDenomResolver(&types.TestDenomResolver{})
+
+	s.Run("reports a resolvable denom without a last gas price", func() {
+		stake := oracle.On("GetPrice", mock.Anything, "STAKE/USD").Return(types.OraclePrice{Price: math.LegacyNewDec(2)}, nil)
+		atom := oracle.On("GetPrice", mock.Anything, "ATOM/USD").Return(types.OraclePrice{Price: math.LegacyNewDec(10)}, nil)
+		defer stake.Unset()
+		defer atom.Unset()
+
+		mgp, statuses, err := s.feeMarketKeeper.GetMinGasPricesWithStatus(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Len(mgp, 2)
+		s.Require().Equal([]types.DenomGasPriceStatus{{Denom: "atom", Available: true}}, statuses)
+	})
+
+	height := s.ctx.BlockHeight()
+	lastPrice := types.DenomGasPrice{Denom: "atom", Price: gs.State.BaseGasPrice.QuoInt64(5), Height: height + 1}
+
+	s.Run("records the gas price at the end of a block", func() {
+		stake := oracle.On("GetPrice", mock.Anything, "STAKE/USD").Return(types.OraclePrice{Price: math.LegacyNewDec(2)}, nil)
+		atom := oracle.On("GetPrice", mock.Anything, "ATOM/USD").Return(types.OraclePrice{Price: math.LegacyNewDec(10)}, nil)
+		defer stake.Unset()
+		defer atom.Unset()
+
+		s.Require().NoError(s.feeMarketKeeper.RecordDenomGasPrices(s.ctx))
+
+		prices, err := s.feeMarketKeeper.GetAllLastDenomGasPrices(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal([]types.DenomGasPrice{lastPrice}, prices)
+	})
+
+	s.Run("keeps the height of an unchanged gas price", func() {
+		stake := oracle.On("GetPrice", mock.Anything, "STAKE/USD").Return(types.OraclePrice{Price: math.LegacyNewDec(2)}, nil)
+		atom := oracle.On("GetPrice", mock.Anything, "ATOM/USD").Return(types.OraclePrice{Price: math.LegacyNewDec(10)}, nil)
+		defer stake.Unset()
+		defer atom.Unset()
+
+		s.Require().NoError(s.feeMarketKeeper.RecordDenomGasPrices(s.ctx.WithBlockHeight(height + 1)))
+
+		prices, err := s.feeMarketKeeper.GetAllLastDenomGasPrices(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal([]types.DenomGasPrice{lastPrice}, prices)
+	})
+
+	s.Run("reports the error and the last gas price of an unavailable denom", func() {
+		stake := oracle.On("GetPrice", mock.Anything, "STAKE/USD").Return(types.OraclePrice{Price: math.LegacyNewDec(2)}, nil)
+		atom := oracle.On("GetPrice", mock.Anything, "ATOM/USD").Return(types.OraclePrice{}, fmt.Errorf("no price"))
+		defer stake.Unset()
+		defer atom.Unset()
+
+		ctx := s.ctx.WithBlockHeight(height + 1)
+		s.Require().NoError(s.feeMarketKeeper.RecordDenomGasPrices(ctx))
+
+		mgp, statuses, err := s.feeMarketKeeper.GetMinGasPricesWithStatus(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, gs.State.BaseGasPrice)), mgp)
+		s.Require().Len(statuses, 1)
+		s.Require().False(statuses[0].Available)
+		s.Require().Contains(statuses[0].Error, types.ErrPriceUnavailable.Error())
+		s.Require().Equal(&lastPrice, statuses[0].LastPrice)
+	})
+
+	s.Run("reports an unsupported denom", func() {
+		s.feeMarketKeeper.SetDenomResolver(&extraDenomResolver{denoms: []string{"atom", "unsupported"}})
+
+		_, statuses, err := s.feeMarketKeeper.GetMinGasPricesWithStatus(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Len(statuses, 2)
+		s.Require().True(statuses[0].Available)
+		s.Require().False(statuses[1].Available)
+		s.Require().Contains(statuses[1].Error, types.ErrUnknownResolverDenom.Error())
+		s.Require().Nil(statuses[1].LastPrice)
+	})
+
+	s.Run("removes the last gas prices of denoms that are no longer extra denoms", func() {
+		s.feeMarketKeeper.SetDenomResolver(&types.TestDenomResolver{})
+		s.Require().NoError(s.feeMarketKeeper.RecordDenomGasPrices(s.ctx))
+
+		prices, err := s.feeMarketKeeper.GetAllLastDenomGasPrices(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Empty(prices)
+	})
+}
+
+// extraDenomResolver converts coins to its extra denoms at a rate of one, and fails for
+// any other denom.
+type extraDenomResolver struct {
+	denoms []string
+}
+
+func (r *extraDenomResolver) ConvertToDenom(_ context.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error) {
+	if denom == "atom" || denom == sdk.DefaultBondDenom {
+		return sdk.NewDecCoinFromDec(denom, coin.Amount), nil
+	}
+
+	return sdk.DecCoin{}, types.ErrUnknownResolverDenom.Wrapf("%s", denom)
+}
+
+func (r *extraDenomResolver) ExtraDenoms(_ context.Context) ([]string, error) {
+	return r.denoms, nil
+}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// GetDenomRate returns the static conversion rate of the given denom.
+func (k *Keeper) GetDenomRate(ctx context.Context, denom string) (types.DenomRate, error) {
+	rate, err := k.DenomRates.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DenomRate{}, types.ErrUnknownResolverDenom.Wrapf("%s", denom)
+	}
+
+	return rate, err
+}
+
+// SetDenomRate sets the static conversion rate of the denom of the given rate.
+func (k *Keeper) SetDenomRate(ctx context.Context, rate types.DenomRate) error {
+	return k.DenomRates.Set(ctx, rate.Denom, rate)
+}
+
+// RemoveDenomRate removes the given denom and its static conversion rate.
+func (k *Keeper) RemoveDenomRate(ctx context.Context, denom string) error {
+	return k.DenomRates.Remove(ctx, denom)
+}
+
+// HasDenomRate returns true if a static conversion rate is set for the given denom.
+func (k *Keeper) HasDenomRate(ctx context.Context, denom string) (bool, error) {
+	return k.DenomRates.Has(ctx, denom)
+}
+
+// GetAllDenomRates returns the static conversion rates of all denoms, ordered by denom.
+func (k *Keeper) GetAllDenomRates(ctx context.Context) ([]types.DenomRate, error) {
+	iterator, err := k.DenomRates.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return iterator.Values()
+}
+
+var _ types.DenomResolver = (*DenomRateResolver)(nil)
+
+// DenomRateResolver is a DenomResolver that converts between the fee denom and the denoms
+// configured with MsgSetDenomRate, using the static conversion rates stored in the keeper.
+type DenomRateResolver struct {
+	k *Keeper
+}
+
+// NewDenomRateResolver returns a DenomResolver backed by the denom rates of the given keeper.
+func NewDenomRateResolver(k *Keeper) *DenomRateResolver {
+	return &DenomRateResolver{k: k}
+}
+
+// ConvertToDenom converts the given coin into the equivalent amount of denom. Both denoms
+// must be either the fee denom or a denom with a configured rate.
+func (r *DenomRateResolver) ConvertToDenom(ctx context.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error) {
+	if coin.Denom == denom {
+		return coin, nil
+	}
+
+	params, err := r.k.GetParams(ctx)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	fromRate, err := r.rate(ctx, params.FeeDenom, coin.Denom)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	toRate, err := r.rate(ctx, params.FeeDenom, denom)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	return sdk.NewDecCoinFromDec(denom, coin.Amount.Mul(fromRate).Quo(toRate)), nil
+}
+
+// ExtraDenoms returns the denoms with a configured rate, ordered by denom.
+func (r *DenomRateResolver) ExtraDenoms(ctx context.Context) ([]string, error) {
+	rates, err := r.k.GetAllDenomRates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	denoms := make([]string, len(rates))
+	for i, rate := range rates {
+		denoms[i] = rate.Denom
+	}
+
+	return denoms, nil
+}
+
+// rate returns the amount of the fee denom that one unit of the given denom is worth.
+func (r *DenomRateResolver) rate(ctx context.Context, feeDenom, denom string) (math.LegacyDec, error) {
+	if denom == feeDenom {
+		return math.LegacyOneDec(), nil
+	}
+
+	rate, err := r.k.GetDenomRate(ctx, denom)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return rate.Rate, nil
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/keeper"
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestDenomRateResolver() {
+	gs := types.DefaultAIMDGenesisState()
+	s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+	resolver := keeper.NewDenomRateResolver(s.feeMarketKeeper)
+	s.feeMarketKeeper.SetDenomResolver(resolver)
+	defer s.feeMarketKeeper.SetDenomResolver(&types.TestDenomResolver{})
+
+	s.Run("has no extra denoms without denom rates", func() {
+		denoms, err := resolver.ExtraDenoms(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Empty(denoms)
+
+		_, err = resolver.ConvertToDenom(s.ctx, sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 1), "atom")
+		s.Require().ErrorIs(err, types.ErrUnknownResolverDenom)
+	})
+
+	s.Require().NoError(s.feeMarketKeeper.SetDenomRate(s.ctx, types.DenomRate{Denom: "atom", Rate: math.LegacyNewDec(4)}))
+	s.Require().NoError(s.feeMarketKeeper.SetDenomRate(s.ctx, types.DenomRate{Denom: "uusdc", Rate: math.LegacyMustNewDecFromStr("0.5")}))
+
+	s.Run("returns the configured denoms", func() {
+		denoms, err := resolver.ExtraDenoms(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal([]string{"atom", "uusdc"}, denoms)
+	})
+
+	s.Run("converts between the fee denom and the configured denoms", func() {
+		coin, err := resolver.ConvertToDenom(s.ctx, sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 8), "atom")
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt64DecCoin("atom", 2), coin)
+
+		coin, err = resolver.ConvertToDenom(s.ctx, sdk.NewInt64DecCoin("atom", 2), sdk.DefaultBondDenom)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 8), coin)
+
+		coin, err = resolver.ConvertToDenom(s.ctx, sdk.NewInt64DecCoin("atom", 1), "uusdc")
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt64DecCoin("uusdc", 8), coin)
+	})
+
+	s.Run("rejects an unknown denom", func() {
+		_, err := resolver.ConvertToDenom(s.ctx, sdk.NewInt64DecCoin("unknown", 1), sdk.DefaultBondDenom)
+		s.Require().ErrorIs(err, types.ErrUnknownResolverDenom)
+	})
+
+	s.Run("prices the configured denoms in the min gas prices", func() {
+		expected := sdk.NewDecCoins(
+			sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, gs.State.BaseGasPrice),
+			sdk.NewDecCoinFromDec("atom", gs.State.BaseGasPrice.QuoInt64(4)),
+			sdk.NewDecCoinFromDec("uusdc", gs.State.BaseGasPrice.MulInt64(2)),
+		)
+
+		mgp, err := s.feeMarketKeeper.GetMinGasPrices(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(expected, mgp)
+	})
+
+	s.Run("drops a removed denom from the min gas prices", func() {
+		s.Require().NoError(s.feeMarketKeeper.RemoveDenomRate(s.ctx, "uusdc"))
+
+		mgp, err := s.feeMarketKeeper.GetMinGasPrices(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewDecCoins(
+			sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, gs.State.BaseGasPrice),
+			sdk.NewDecCoinFromDec("atom", gs.State.BaseGasPrice.QuoInt64(4)),
+		), mgp)
+	})
+}
+
+func (s *KeeperTestSuite) TestCachingDenomResolverEndBlock() {
+	gs := types.DefaultAIMDGenesisState()
+	s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+	resolver := types.NewCachingDenomResolver(keeper.NewDenomRateResolver(s.feeMarketKeeper))
+	s.feeMarketKeeper.SetDenomResolver(resolver)
+	defer s.feeMarketKeeper.SetDenomResolver(&types.TestDenomResolver{})
+
+	ctx := s.ctx.WithExecMode(sdk.ExecModeFinalize)
+	s.Require().NoError(s.feeMarketKeeper.SetDenomRate(ctx, types.DenomRate{Denom: "atom", Rate: math.LegacyNewDec(4)}))
+
+	coin, err := s.feeMarketKeeper.ResolveToDenom(ctx, sdk.NewInt64DecCoin("atom", 1), sdk.DefaultBondDenom)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 4), coin)
+
+	// the rate is cached for the rest of the block
+	s.Require().NoError(s.feeMarketKeeper.SetDenomRate(ctx, types.DenomRate{Denom: "atom", Rate: math.LegacyNewDec(2)}))
+	coin, err = s.feeMarketKeeper.ResolveToDenom(ctx, sdk.NewInt64DecCoin("atom", 1), sdk.DefaultBondDenom)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 4), coin)
+
+	s.Require().NoError(s.feeMarketKeeper.EndBlock(ctx))
+
+	coin, err = s.feeMarketKeeper.ResolveToDenom(ctx, sdk.NewInt64DecCoin("atom", 1), sdk.DefaultBondDenom)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 2), coin)
+}
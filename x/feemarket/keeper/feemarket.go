@@ -1,16 +1,24 @@
 package keeper
 
 import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
 )
 
 // UpdateFeeMarket updates the base fee and learning rate based on the
-// AIMD learning rate adjustment algorithm. Note that if the fee market
+// pricing algorithm selected in the params. Note that if the fee market
 // is disabled, this function will return without updating the fee market.
 // This is executed in EndBlock which allows the next block's base fee to
 // be readily available for wallets to estimate gas prices.
-func (k *Keeper) UpdateFeeMarket(ctx sdk.Context) error {
+func (k *Keeper) UpdateFeeMarket(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
 	params, err := k.GetParams(ctx)
 	if err != nil {
 		return err
@@ -25,23 +33,66 @@ func (k *Keeper) UpdateFeeMarket(ctx sdk.Context) error {
 		return nil
 	}
 
+	algorithm, err := k.GetPricingAlgorithm(params.PricingAlgorithm)
+	if err != nil {
+		return err
+	}
+
 	state, err := k.GetState(ctx)
 	if err != nil {
 		return err
 	}
 
+	// Record the gas price and utilization of the current block before the
+	// state is moved on to the next block.
+	if err := k.recordGasPrice(ctx, state, params); err != nil {
+		return err
+	}
+
+	// While the fee market is frozen, only move on to the next block so that the
+	// utilization keeps being recorded in the window.
+	frozen, err := k.IsFrozen(ctx)
+	if err != nil {
+		return err
+	}
+
+	if frozen {
+		k.Logger(ctx).Info(
+			"fee market is frozen",
+			"height", sdkCtx.BlockHeight(),
+			"base_gas_price", state.BaseGasPrice,
+			"learning_rate", state.LearningRate,
+		)
+
+		state.IncrementHeight()
+		return k.SetState(ctx, state)
+	}
+
+	prevBaseGasPrice := state.BaseGasPrice
+
 	// Update the learning rate based on the block utilization seen in the
-	// current block. This is the AIMD learning rate adjustment algorithm.
-	newLR := state.UpdateLearningRate(
-		params,
-	)
+	// current block.
+	newLR := algorithm.UpdateLearningRate(&state, params)
+
+	// Update the base gas price based with the new learning rate.
+	newBaseGasPrice := algorithm.UpdateBaseGasPrice(&state, params)
 
-	// Update the base gas price based with the new learning rate and delta adjustment.
-	newBaseGasPrice := state.UpdateBaseGasPrice(params)
+	// Ensure the base gas price stays within the configured bounds.
+	if state.ClampBaseGasPrice(prevBaseGasPrice, params) {
+		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeBaseGasPriceClamped,
+			sdk.NewAttribute(types.AttributeKeyPreviousBaseGasPrice, prevBaseGasPrice.String()),
+			sdk.NewAttribute(types.AttributeKeyComputedBaseGasPrice, newBaseGasPrice.String()),
+			sdk.NewAttribute(types.AttributeKeyBaseGasPrice, state.BaseGasPrice.String()),
+		))
+
+		newBaseGasPrice = state.BaseGasPrice
+	}
 
 	k.Logger(ctx).Info(
 		"updated the fee market",
-		"height", ctx.BlockHeight(),
+		"height", sdkCtx.BlockHeight(),
+		"pricing_algorithm", algorithm.Name(),
 		"new_base_gas_price", newBaseGasPrice,
 		"new_learning_rate", newLR,
 		"average_block_utilization", state.GetAverageUtilization(params),
@@ -54,7 +105,7 @@ func (k *Keeper) UpdateFeeMarket(ctx sdk.Context) error {
 }
 
 // GetBaseGasPrice returns the base fee from the fee market state.
-func (k *Keeper) GetBaseGasPrice(ctx sdk.Context) (math.LegacyDec, error) {
+func (k *Keeper) GetBaseGasPrice(ctx context.Context) (math.LegacyDec, error) {
 	state, err := k.GetState(ctx)
 	if err != nil {
 		return math.LegacyDec{}, err
@@ -64,7 +115,7 @@ func (k *Keeper) GetBaseGasPrice(ctx sdk.Context) (math.LegacyDec, error) {
 }
 
 // GetLearningRate returns the learning rate from the fee market state.
-func (k *Keeper) GetLearningRate(ctx sdk.Context) (math.LegacyDec, error) {
+func (k *Keeper) GetLearningRate(ctx context.Context) (math.LegacyDec, error) {
 	state, err := k.GetState(ctx)
 	if err != nil {
 		return math.LegacyDec{}, err
@@ -74,7 +125,7 @@ func (k *Keeper) GetLearningRate(ctx sdk.Context) (math.LegacyDec, error) {
 }
 
 // GetMinGasPrice returns the mininum gas prices for given denom as sdk.DecCoins from the fee market state.
-func (k *Keeper) GetMinGasPrice(ctx sdk.Context, denom string) (sdk.DecCoin, error) {
+func (k *Keeper) GetMinGasPrice(ctx context.Context, denom string) (sdk.DecCoin, error) {
 	baseGasPrice, err := k.GetBaseGasPrice(ctx)
 	if err != nil {
 		return sdk.DecCoin{}, err
@@ -100,37 +151,106 @@ func (k *Keeper) GetMinGasPrice(ctx sdk.Context, denom string) (sdk.DecCoin, err
 }
 
 // GetMinGasPrices returns the mininum gas prices as sdk.DecCoins from the fee market state.
-func (k *Keeper) GetMinGasPrices(ctx sdk.Context) (sdk.DecCoins, error) {
-	baseGasPrice, err := k.GetBaseGasPrice(ctx)
+// The gas prices of extra denoms that cannot be resolved are left out of the min gas prices.
+func (k *Keeper) GetMinGasPrices(ctx context.Context) (sdk.DecCoins, error) {
+	minGasPrice, extraGasPrices, err := k.resolveExtraGasPrices(ctx)
 	if err != nil {
 		return sdk.NewDecCoins(), err
 	}
 
+	minGasPrices := sdk.NewDecCoins(minGasPrice)
+	for _, extra := range extraGasPrices {
+		if extra.err != nil {
+			k.Logger(ctx).Info(
+				"failed to convert gas price",
+				"min gas price", minGasPrice,
+				"denom", extra.denom,
+				"err", extra.err,
+			)
+			continue
+		}
+
+		minGasPrices = minGasPrices.Add(extra.gasPrice)
+	}
+
+	return minGasPrices, nil
+}
+
+// GetMinGasPricesWithStatus returns the mininum gas prices as sdk.DecCoins from the fee market
+// state, along with the status of the gas price of each extra denom of the denom resolver and
+// the last gas price it resolved to. The gas prices of extra denoms that cannot be resolved are
+// left out of the min gas prices. It backs the GasPrices query; GetMinGasPrices is cheaper when
+// the statuses are not needed.
+func (k *Keeper) GetMinGasPricesWithStatus(ctx context.Context) (sdk.DecCoins, []types.DenomGasPriceStatus, error) {
+	minGasPrice, extraGasPrices, err := k.resolveExtraGasPrices(ctx)
+	if err != nil {
+		return sdk.NewDecCoins(), nil, err
+	}
+
+	minGasPrices := sdk.NewDecCoins(minGasPrice)
+	statuses := make([]types.DenomGasPriceStatus, 0, len(extraGasPrices))
+
+	for _, extra := range extraGasPrices {
+		status := types.DenomGasPriceStatus{Denom: extra.denom}
+
+		if extra.err != nil {
+			status.Error = extra.err.Error()
+		} else {
+			minGasPrices = minGasPrices.Add(extra.gasPrice)
+			status.Available = true
+		}
+
+		last, err := k.LastDenomGasPrices.Get(ctx, extra.denom)
+		switch {
+		case err == nil:
+			status.LastPrice = &last
+		case !errors.Is(err, collections.ErrNotFound):
+			return sdk.NewDecCoins(), nil, err
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return minGasPrices, statuses, nil
+}
+
+// extraGasPrice is the result of converting the min gas price to an extra denom of the
+// denom resolver.
+type extraGasPrice struct {
+	denom    string
+	gasPrice sdk.DecCoin
+	err      error
+}
+
+// resolveExtraGasPrices returns the min gas price in the fee denom and the result of
+// converting it to each extra denom of the denom resolver.
+func (k *Keeper) resolveExtraGasPrices(ctx context.Context) (sdk.DecCoin, []extraGasPrice, error) {
+	baseGasPrice, err := k.GetBaseGasPrice(ctx)
+	if err != nil {
+		return sdk.DecCoin{}, nil, err
+	}
+
 	params, err := k.GetParams(ctx)
 	if err != nil {
-		return sdk.NewDecCoins(), err
+		return sdk.DecCoin{}, nil, err
 	}
 
 	minGasPrice := sdk.NewDecCoinFromDec(params.FeeDenom, baseGasPrice)
-	minGasPrices := sdk.NewDecCoins(minGasPrice)
 
 	extraDenoms, err := k.resolver.ExtraDenoms(ctx)
 	if err != nil {
-		return sdk.NewDecCoins(), err
+		return sdk.DecCoin{}, nil, err
 	}
 
+	extraGasPrices := make([]extraGasPrice, 0, len(extraDenoms))
 	for _, denom := range extraDenoms {
-		gasPrice, err := k.ResolveToDenom(ctx, minGasPrice, denom)
-		if err != nil {
-			k.Logger(ctx).Info(
-				"failed to convert gas price",
-				"min gas price", minGasPrice,
-				"denom", denom,
-			)
+		if denom == params.FeeDenom {
 			continue
 		}
-		minGasPrices = minGasPrices.Add(gasPrice)
+
+		gasPrice, err := k.ResolveToDenom(ctx, minGasPrice, denom)
+		extraGasPrices = append(extraGasPrices, extraGasPrice{denom: denom, gasPrice: gasPrice, err: err})
 	}
 
-	return minGasPrices, nil
+	return minGasPrice, extraGasPrices, nil
 }
@@ -3,8 +3,11 @@ package keeper_test
 import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
 
+	"github.com/skip-mev/feemarket/x/feemarket/keeper"
 	"github.com/skip-mev/feemarket/x/feemarket/types"
+	"github.com/skip-mev/feemarket/x/feemarket/types/mocks"
 )
 
 func (s *KeeperTestSuite) TestUpdateFeeMarket() {
@@ -449,6 +452,192 @@ func (s *KeeperTestSuite) TestGetLearningRate() {
 	})
 }
 
+func (s *KeeperTestSuite) TestUpdateFeeMarketPricingAlgorithm() {
+	s.Run("uses the pricing algorithm selected in params", func() {
+		s.Require().NoError(s.feeMarketKeeper.GetPricingRegistry().Register(halvingPricingAlgorithm{}))
+
+		state := types.DefaultAIMDState()
+		state.BaseGasPrice = state.BaseGasPrice.MulInt64(4)
+		params := types.DefaultAIMDParams()
+		params.PricingAlgorithm = halvingPricingAlgorithm{}.Name()
+		s.setGenesisState(params, state)
+
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
+
+		fee, err := s.feeMarketKeeper.GetBaseGasPrice(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(state.BaseGasPrice.QuoInt64(2), fee)
+
+		lr, err := s.feeMarketKeeper.GetLearningRate(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(state.LearningRate, lr)
+	})
+
+	s.Run("defaults to aimd when no pricing algorithm is set", func() {
+		state := types.DefaultAIMDState()
+		params := types.DefaultAIMDParams()
+		params.PricingAlgorithm = ""
+		s.setGenesisState(params, state)
+
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
+
+		expectedState := types.DefaultAIMDState()
+		expectedLR := expectedState.UpdateLearningRate(params)
+
+		lr, err := s.feeMarketKeeper.GetLearningRate(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(expectedLR, lr)
+	})
+
+	s.Run("errors with an unknown pricing algorithm", func() {
+		state := types.DefaultAIMDState()
+		params := types.DefaultAIMDParams()
+		s.setGenesisState(params, state)
+
+		params.PricingAlgorithm = "unknown"
+		s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+
+		err := s.feeMarketKeeper.UpdateFeeMarket(s.ctx)
+		s.Require().ErrorIs(err, types.ErrUnknownPricingAlgorithm)
+	})
+}
+
+func (s *KeeperTestSuite) TestPricingRegistry() {
+	s.Run("algorithms registered after construction are used by copies of the keeper", func() {
+		s.setGenesisState(types.DefaultAIMDParams(), types.DefaultAIMDState())
+
+		// the module holds a copy of the keeper, as NewAppModule takes it by value
+		moduleKeeper := *s.feeMarketKeeper
+		s.Require().NoError(s.feeMarketKeeper.GetPricingRegistry().Register(halvingPricingAlgorithm{}))
+
+		params := types.DefaultAIMDParams()
+		params.PricingAlgorithm = halvingPricingAlgorithm{}.Name()
+		_, err := keeper.NewMsgServer(&moduleKeeper).Params(s.ctx, &types.MsgParams{
+			Authority: s.authorityAccount.String(),
+			Params:    params,
+		})
+		s.Require().NoError(err)
+
+		state, err := moduleKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		state.BaseGasPrice = state.BaseGasPrice.MulInt64(4)
+		s.Require().NoError(moduleKeeper.SetState(s.ctx, state))
+
+		s.Require().NoError(moduleKeeper.UpdateFeeMarket(s.ctx))
+
+		fee, err := moduleKeeper.GetBaseGasPrice(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(state.BaseGasPrice.QuoInt64(2), fee)
+	})
+}
+
+func (s *KeeperTestSuite) TestUpdateFeeMarketClamp() {
+	s.Run("limits the change per block", func() {
+		state := types.DefaultState()
+		params := types.DefaultParams()
+		params.MaxChangePerBlock = math.LegacyMustNewDecFromStr("0.05")
+
+		err := state.Update(params.MaxBlockUtilization, params)
+		s.Require().NoError(err)
+
+		s.setGenesisState(params, state)
+
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(ctx))
+
+		// Without the clamp, the base fee would increase by 1/8th.
+		fee, err := s.feeMarketKeeper.GetBaseGasPrice(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(state.BaseGasPrice.Mul(math.LegacyMustNewDecFromStr("1.05")), fee)
+
+		events := ctx.EventManager().Events()
+		s.Require().Len(events, 1)
+		s.Require().Equal(types.EventTypeBaseGasPriceClamped, events[0].Type)
+
+		computed, ok := events[0].GetAttribute(types.AttributeKeyComputedBaseGasPrice)
+		s.Require().True(ok)
+		s.Require().Equal(state.BaseGasPrice.Mul(math.LegacyMustNewDecFromStr("1.125")).String(), computed.Value)
+	})
+
+	s.Run("caps the base gas price", func() {
+		state := types.DefaultState()
+		params := types.DefaultParams()
+		params.MaxBaseGasPrice = state.BaseGasPrice.Mul(math.LegacyMustNewDecFromStr("1.1"))
+
+		err := state.Update(params.MaxBlockUtilization, params)
+		s.Require().NoError(err)
+
+		s.setGenesisState(params, state)
+
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(ctx))
+
+		fee, err := s.feeMarketKeeper.GetBaseGasPrice(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(params.MaxBaseGasPrice, fee)
+		s.Require().Len(ctx.EventManager().Events(), 1)
+	})
+
+	s.Run("does not emit an event within bounds", func() {
+		state := types.DefaultState()
+		params := types.DefaultParams()
+		params.MaxChangePerBlock = math.LegacyMustNewDecFromStr("0.125")
+		params.MaxBaseGasPrice = state.BaseGasPrice.MulInt64(2)
+
+		err := state.Update(params.MaxBlockUtilization, params)
+		s.Require().NoError(err)
+
+		s.setGenesisState(params, state)
+
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(ctx))
+
+		fee, err := s.feeMarketKeeper.GetBaseGasPrice(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(state.BaseGasPrice.Mul(math.LegacyMustNewDecFromStr("1.125")), fee)
+		s.Require().Empty(ctx.EventManager().Events())
+	})
+}
+
+func (s *KeeperTestSuite) TestUpdateFeeMarketFrozen() {
+	s.Run("skips adjustments but keeps recording utilization", func() {
+		state := types.DefaultAIMDState()
+		params := types.DefaultAIMDParams()
+		state.BaseGasPrice = params.MinBaseGasPrice.MulInt64(2)
+
+		err := state.Update(params.MaxBlockUtilization, params)
+		s.Require().NoError(err)
+
+		s.setGenesisState(params, state)
+		s.Require().NoError(s.feeMarketKeeper.SetFrozen(s.ctx, true))
+		defer func() { s.Require().NoError(s.feeMarketKeeper.SetFrozen(s.ctx, false)) }()
+
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
+
+		gotState, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(state.BaseGasPrice, gotState.BaseGasPrice)
+		s.Require().Equal(state.LearningRate, gotState.LearningRate)
+		s.Require().Equal(state.Index+1, gotState.Index)
+		s.Require().Equal(params.MaxBlockUtilization, gotState.Window[state.Index])
+	})
+}
+
+// halvingPricingAlgorithm is a test pricing algorithm that halves the base gas
+// price every block.
+type halvingPricingAlgorithm struct{}
+
+func (halvingPricingAlgorithm) Name() string { return "halving" }
+
+func (halvingPricingAlgorithm) UpdateLearningRate(state *types.State, _ types.Params) math.LegacyDec {
+	return state.LearningRate
+}
+
+func (halvingPricingAlgorithm) UpdateBaseGasPrice(state *types.State, _ types.Params) math.LegacyDec {
+	state.BaseGasPrice = state.BaseGasPrice.QuoInt64(2)
+	return state.BaseGasPrice
+}
+
 func (s *KeeperTestSuite) TestGetMinGasPrices() {
 	s.Run("can retrieve min gas prices with default eip-1559", func() {
 		gs := types.DefaultGenesisState()
@@ -471,6 +660,36 @@ func (s *KeeperTestSuite) TestGetMinGasPrices() {
 		s.Require().NoError(err)
 		s.Require().Equal(expected, mgp)
 	})
+
+	s.Run("can retrieve min gas prices with an oracle denom resolver", func() {
+		gs := types.DefaultAIMDGenesisState()
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		oracle := mocks.NewOracleKeeper(s.T())
+		oracle.On("GetPrice", mock.Anything, "STAKE/USD").Return(types.OraclePrice{Price: math.LegacyNewDec(2)}, nil)
+		oracle.On("GetPrice", mock.Anything, "ATOM/USD").Return(types.OraclePrice{Price: math.LegacyNewDec(10)}, nil)
+
+		resolver, err := types.NewOracleDenomResolver(oracle, types.OracleDenomResolverConfig{
+			CurrencyPairs: []types.OracleCurrencyPair{
+				{Denom: sdk.DefaultBondDenom, CurrencyPair: "STAKE/USD"},
+				{Denom: "atom", CurrencyPair: "ATOM/USD"},
+			},
+		})
+		s.Require().NoError(err)
+
+		s.feeMarketKeeper.SetDenomResolver(resolver)
+		defer s.feeMarketKeeper.SetDenomResolver(&types.TestDenomResolver{})
+
+		// the fee denom is only priced once
+		expected := sdk.NewDecCoins(
+			sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, gs.State.BaseGasPrice),
+			sdk.NewDecCoinFromDec("atom", gs.State.BaseGasPrice.QuoInt64(5)),
+		)
+
+		mgp, err := s.feeMarketKeeper.GetMinGasPrices(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(expected, mgp)
+	})
 }
 
 func (s *KeeperTestSuite) setGenesisState(params types.Params, state types.State) {
@@ -1,13 +1,13 @@
 package keeper
 
 import (
-	sdk "github.com/cosmos/cosmos-sdk/types"
+	"context"
 
 	"github.com/skip-mev/feemarket/x/feemarket/types"
 )
 
 // InitGenesis initializes the feemarket module's state from a given genesis state.
-func (k *Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
+func (k *Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) {
 	if err := gs.ValidateBasic(); err != nil {
 		panic(err)
 	}
@@ -16,6 +16,14 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
 		panic("genesis state and parameters do not match for window")
 	}
 
+	if _, err := k.GetPricingAlgorithm(gs.Params.PricingAlgorithm); err != nil {
+		panic(err)
+	}
+
+	if err := k.ValidateFeeRecipients(gs.Params); err != nil {
+		panic(err)
+	}
+
 	// Initialize the fee market state and parameters.
 	if err := k.SetParams(ctx, gs.Params); err != nil {
 		panic(err)
@@ -25,12 +33,62 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
 		panic(err)
 	}
 
-	// always init enabled height to -1 until it is explicitly set later in the application
-	k.SetEnabledHeight(ctx, -1)
+	// the enabled height is -1 unless the fee market was enabled on the exported chain
+	if err := k.SetEnabledHeight(ctx, gs.EnabledHeight); err != nil {
+		panic(err)
+	}
+
+	if err := k.SetFrozen(ctx, gs.Frozen); err != nil {
+		panic(err)
+	}
+
+	for _, scheduled := range gs.ScheduledParams {
+		if _, err := k.GetPricingAlgorithm(scheduled.Params.PricingAlgorithm); err != nil {
+			panic(err)
+		}
+
+		if err := k.ValidateFeeRecipients(scheduled.Params); err != nil {
+			panic(err)
+		}
+
+		if err := k.SetScheduledParams(ctx, scheduled); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, record := range gs.GasPriceHistory {
+		if err := k.SetGasPriceRecord(ctx, record); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, records := range gs.TipRecords {
+		if err := k.SetTipRecords(ctx, records); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, swept := range gs.SweptFees {
+		if err := k.SetSweptFees(ctx, swept); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, rate := range gs.DenomRates {
+		if err := k.SetDenomRate(ctx, rate); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, price := range gs.LastDenomGasPrices {
+		if err := k.SetLastDenomGasPrice(ctx, price); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context.
-func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+func (k *Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 	// Get the feemarket module's parameters.
 	params, err := k.GetParams(ctx)
 	if err != nil {
@@ -43,5 +101,47 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
-	return types.NewGenesisState(params, state)
+	gs := types.NewGenesisState(params, state)
+
+	gs.EnabledHeight, err = k.GetEnabledHeight(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	gs.Frozen, err = k.IsFrozen(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	gs.ScheduledParams, err = k.GetAllScheduledParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	gs.GasPriceHistory, err = k.GetAllGasPriceRecords(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	gs.TipRecords, err = k.GetAllTipRecords(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	gs.SweptFees, err = k.GetAllSweptFees(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	gs.DenomRates, err = k.GetAllDenomRates(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	gs.LastDenomGasPrices, err = k.GetAllLastDenomGasPrices(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return gs
 }
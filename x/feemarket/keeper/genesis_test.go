@@ -1,6 +1,9 @@
 package keeper_test
 
 import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/skip-mev/feemarket/x/feemarket/types"
 )
 
@@ -25,6 +28,17 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 		})
 	})
 
+	s.Run("unknown pricing algorithm of scheduled params should panic", func() {
+		gs := types.DefaultGenesisState()
+		params := types.DefaultParams()
+		params.PricingAlgorithm = "unknown"
+		gs.ScheduledParams = []types.ScheduledParams{{Height: 10, Params: params}}
+
+		s.Require().Panics(func() {
+			s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+		})
+	})
+
 	s.Run("mismatch in params and state for window should panic", func() {
 		gs := types.DefaultAIMDGenesisState()
 		gs.Params.Window = 1
@@ -57,6 +71,51 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 			exportedGenesis = s.feeMarketKeeper.ExportGenesis(s.ctx)
 		})
 
+		s.Require().Equal(gs, exportedGenesis)
+	})
+	s.Run("export genesis round-trips the keeper data", func() {
+		gs := types.DefaultAIMDGenesisState()
+		gs.EnabledHeight = 5
+		gs.Frozen = true
+		gs.ScheduledParams = []types.ScheduledParams{
+			{Height: 20, Params: types.DefaultParams()},
+			{Height: 30, Params: types.DefaultAIMDParams()},
+		}
+		gs.GasPriceHistory = []types.GasPriceRecord{
+			{Height: 8, BaseGasPrice: math.LegacyOneDec(), LearningRate: math.LegacyMustNewDecFromStr("0.125"), BlockUtilization: 100},
+			{Height: 9, BaseGasPrice: math.LegacyNewDec(2), LearningRate: math.LegacyMustNewDecFromStr("0.125"), BlockUtilization: 200},
+		}
+		gs.TipRecords = []types.BlockTipRecords{
+			{Height: 8, Tips: []types.TipRecord{{TipPerGas: math.LegacyOneDec(), GasUsed: 100}}},
+			{Height: 9, Tips: []types.TipRecord{
+				{TipPerGas: math.LegacyZeroDec(), GasUsed: 50},
+				{TipPerGas: math.LegacyNewDec(3), GasUsed: 150},
+			}},
+		}
+		gs.SweptFees = []types.SweptFees{
+			{Destination: types.FeeSplitDestinationBurn, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))},
+		}
+		gs.DenomRates = []types.DenomRate{
+			{Denom: "atom", Rate: math.LegacyMustNewDecFromStr("0.5")},
+			{Denom: "uusdc", Rate: math.LegacyNewDec(2)},
+		}
+		gs.LastDenomGasPrices = []types.DenomGasPrice{
+			{Denom: "atom", Price: math.LegacyNewDec(2), Height: 9},
+		}
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		enabledHeight, err := s.feeMarketKeeper.GetEnabledHeight(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(int64(5), enabledHeight)
+		frozen, err := s.feeMarketKeeper.IsFrozen(s.ctx)
+		s.Require().NoError(err)
+		s.Require().True(frozen)
+
+		var exportedGenesis *types.GenesisState
+		s.Require().NotPanics(func() {
+			exportedGenesis = s.feeMarketKeeper.ExportGenesis(s.ctx)
+		})
+
 		s.Require().Equal(gs, exportedGenesis)
 	})
 }
@@ -0,0 +1,207 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// GetGasPriceRecord returns the gas price record of the given height.
+func (k *Keeper) GetGasPriceRecord(ctx context.Context, height int64) (types.GasPriceRecord, error) {
+	record, err := k.GasPriceHistory.Get(ctx, uint64(height))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.GasPriceRecord{}, types.ErrGasPriceRecordNotFound.Wrapf("height %d", height)
+	}
+
+	return record, err
+}
+
+// SetGasPriceRecord sets the gas price record of the record's height.
+func (k *Keeper) SetGasPriceRecord(ctx context.Context, record types.GasPriceRecord) error {
+	return k.GasPriceHistory.Set(ctx, uint64(record.Height), record)
+}
+
+// PruneGasPriceHistory removes all gas price records that are older than the
+// given number of most recent blocks, including the current block.
+func (k *Keeper) PruneGasPriceHistory(ctx context.Context, retention uint64) error {
+	// Records with a height lower than the first retained height are pruned.
+	firstRetained := sdk.UnwrapSDKContext(ctx).BlockHeight() + 1 - int64(retention)
+	if firstRetained <= 0 {
+		return nil
+	}
+
+	records := new(collections.Range[uint64]).EndExclusive(uint64(firstRetained))
+	if err := k.GasPriceHistory.Clear(ctx, records); err != nil {
+		return err
+	}
+
+	tips := new(collections.Range[collections.Pair[uint64, uint64]]).
+		EndExclusive(collections.Join(uint64(firstRetained), uint64(0)))
+	return k.TipRecords.Clear(ctx, tips)
+}
+
+// GetLatestGasPriceRecord returns the gas price record with the greatest height.
+func (k *Keeper) GetLatestGasPriceRecord(ctx context.Context) (types.GasPriceRecord, error) {
+	iterator, err := k.GasPriceHistory.Iterate(ctx, new(collections.Range[uint64]).Descending())
+	if err != nil {
+		return types.GasPriceRecord{}, err
+	}
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.GasPriceRecord{}, types.ErrGasPriceRecordNotFound.Wrap("no gas price history recorded")
+	}
+
+	return iterator.Value()
+}
+
+// GetGasPriceRecords returns the gas price records in the height range [from, to],
+// ordered by height. No records are returned for an empty or negative range.
+func (k *Keeper) GetGasPriceRecords(ctx context.Context, from, to int64) ([]types.GasPriceRecord, error) {
+	if from < 0 || from > to {
+		return []types.GasPriceRecord{}, nil
+	}
+
+	ranger := new(collections.Range[uint64]).StartInclusive(uint64(from)).EndInclusive(uint64(to))
+
+	iterator, err := k.GasPriceHistory.Iterate(ctx, ranger)
+	if err != nil {
+		return nil, err
+	}
+
+	return iterator.Values()
+}
+
+// GetAllGasPriceRecords returns all retained gas price records, ordered by height.
+func (k *Keeper) GetAllGasPriceRecords(ctx context.Context) ([]types.GasPriceRecord, error) {
+	iterator, err := k.GasPriceHistory.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return iterator.Values()
+}
+
+// RecordTip records the tip paid by a transaction of the current block for the fee
+// history. The tip coins are converted to the fee denom, summed and divided by the
+// gas used. Tips are only recorded while the gas price history is enabled.
+func (k *Keeper) RecordTip(ctx context.Context, tips sdk.Coins, gasUsed uint64) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.HistoryRetention == 0 || gasUsed == 0 {
+		return nil
+	}
+
+	tipValue := math.LegacyZeroDec()
+	for _, tip := range tips {
+		if !tip.IsPositive() {
+			continue
+		}
+
+		tipDec := sdk.NewDecCoinFromCoin(tip)
+		if tip.Denom != params.FeeDenom {
+			tipDec, err = k.ResolveToDenom(ctx, tipDec, params.FeeDenom)
+			if err != nil {
+				return err
+			}
+		}
+
+		tipValue = tipValue.Add(tipDec.Amount)
+	}
+
+	tipPerGas := tipValue.QuoInt64(int64(gasUsed))
+
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+
+	// The index of the new tip is one greater than the last tip of the block.
+	var index uint64
+	iterator, err := k.TipRecords.Iterate(ctx, collections.NewPrefixedPairRange[uint64, uint64](height).Descending())
+	if err != nil {
+		return err
+	}
+	if iterator.Valid() {
+		key, err := iterator.Key()
+		if err != nil {
+			iterator.Close()
+			return err
+		}
+		index = key.K2() + 1
+	}
+	iterator.Close()
+
+	record := types.TipRecord{
+		TipPerGas: tipPerGas,
+		GasUsed:   gasUsed,
+	}
+
+	return k.TipRecords.Set(ctx, collections.Join(height, index), record)
+}
+
+// GetTipRecords returns the tips recorded in the block of the given height.
+func (k *Keeper) GetTipRecords(ctx context.Context, height int64) ([]types.TipRecord, error) {
+	iterator, err := k.TipRecords.Iterate(ctx, collections.NewPrefixedPairRange[uint64, uint64](uint64(height)))
+	if err != nil {
+		return nil, err
+	}
+
+	return iterator.Values()
+}
+
+// SetTipRecords sets the tips recorded in the block of the given height, replacing the
+// tips with the same index.
+func (k *Keeper) SetTipRecords(ctx context.Context, records types.BlockTipRecords) error {
+	for i, tip := range records.Tips {
+		if err := k.TipRecords.Set(ctx, collections.Join(uint64(records.Height), uint64(i)), tip); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetAllTipRecords returns all retained tip records grouped by height, ordered by height.
+func (k *Keeper) GetAllTipRecords(ctx context.Context) ([]types.BlockTipRecords, error) {
+	var all []types.BlockTipRecords
+	err := k.TipRecords.Walk(ctx, nil, func(key collections.Pair[uint64, uint64], tip types.TipRecord) (bool, error) {
+		height := int64(key.K1())
+		if len(all) == 0 || all[len(all)-1].Height != height {
+			all = append(all, types.BlockTipRecords{Height: height})
+		}
+		all[len(all)-1].Tips = append(all[len(all)-1].Tips, tip)
+
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return all, nil
+}
+
+// recordGasPrice records the gas price, learning rate, utilization and max utilization of
+// the current block and prunes the records that are no longer retained.
+func (k *Keeper) recordGasPrice(ctx context.Context, state types.State, params types.Params) error {
+	if err := k.PruneGasPriceHistory(ctx, params.HistoryRetention); err != nil {
+		return err
+	}
+
+	if params.HistoryRetention == 0 {
+		return nil
+	}
+
+	return k.SetGasPriceRecord(ctx, types.GasPriceRecord{
+		Height:              sdk.UnwrapSDKContext(ctx).BlockHeight(),
+		BaseGasPrice:        state.BaseGasPrice,
+		LearningRate:        state.LearningRate,
+		BlockUtilization:    state.Window[state.Index],
+		MaxBlockUtilization: params.MaxBlockUtilization,
+	})
+}
@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestGasPriceHistory() {
+	s.Run("records nothing when retention is zero", func() {
+		params := types.DefaultParams()
+		s.setGenesisState(params, types.DefaultState())
+
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
+
+		_, err := s.feeMarketKeeper.GetGasPriceRecord(s.ctx, s.ctx.BlockHeight())
+		s.Require().ErrorIs(err, types.ErrGasPriceRecordNotFound)
+	})
+
+	s.Run("records the gas price of each block before it is updated", func() {
+		params := types.DefaultAIMDParams()
+		params.HistoryRetention = 10
+		state := types.DefaultAIMDState()
+		state.BaseGasPrice = params.MinBaseGasPrice.MulInt64(2)
+		s.Require().NoError(state.Update(params.MaxBlockUtilization, params))
+		s.setGenesisState(params, state)
+
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
+
+		record, err := s.feeMarketKeeper.GetGasPriceRecord(s.ctx, s.ctx.BlockHeight())
+		s.Require().NoError(err)
+		s.Require().Equal(s.ctx.BlockHeight(), record.Height)
+		s.Require().Equal(state.BaseGasPrice, record.BaseGasPrice)
+		s.Require().Equal(state.LearningRate, record.LearningRate)
+		s.Require().Equal(params.MaxBlockUtilization, record.BlockUtilization)
+
+		newState, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		s.Require().True(newState.BaseGasPrice.GT(record.BaseGasPrice))
+	})
+
+	s.Run("prunes records outside of the retention", func() {
+		params := types.DefaultParams()
+		params.HistoryRetention = 3
+		s.setGenesisState(params, types.DefaultState())
+
+		start := s.ctx.BlockHeight()
+		for i := int64(0); i < 5; i++ {
+			s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx.WithBlockHeight(start + i)))
+		}
+
+		ctx := s.ctx.WithBlockHeight(start + 4)
+		for i := int64(0); i < 5; i++ {
+			_, err := s.feeMarketKeeper.GetGasPriceRecord(ctx, start+i)
+			if i < 2 {
+				s.Require().ErrorIs(err, types.ErrGasPriceRecordNotFound)
+			} else {
+				s.Require().NoError(err)
+			}
+		}
+
+		// Disabling the history prunes all remaining records.
+		params.HistoryRetention = 0
+		s.Require().NoError(s.feeMarketKeeper.SetParams(ctx, params))
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(ctx.WithBlockHeight(start + 5)))
+
+		resp, err := s.queryServer.GasPriceHistory(ctx, &types.GasPriceHistoryRequest{})
+		s.Require().NoError(err)
+		s.Require().Empty(resp.Records)
+	})
+	s.Run("returns no records for an empty or negative range", func() {
+		s.Require().NoError(s.feeMarketKeeper.SetGasPriceRecord(s.ctx, types.GasPriceRecord{
+			Height:       1,
+			BaseGasPrice: math.LegacyOneDec(),
+			LearningRate: math.LegacyOneDec(),
+		}))
+
+		records, err := s.feeMarketKeeper.GetGasPriceRecords(s.ctx, 2, 1)
+		s.Require().NoError(err)
+		s.Require().Empty(records)
+
+		records, err = s.feeMarketKeeper.GetGasPriceRecords(s.ctx, -5, 1)
+		s.Require().NoError(err)
+		s.Require().Empty(records)
+	})
+}
+
+func (s *KeeperTestSuite) TestRecordTip() {
+	s.Run("records nothing when retention is zero", func() {
+		s.setGenesisState(types.DefaultParams(), types.DefaultState())
+
+		s.Require().NoError(s.feeMarketKeeper.RecordTip(s.ctx, sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, 100)), 10))
+
+		tips, err := s.feeMarketKeeper.GetTipRecords(s.ctx, s.ctx.BlockHeight())
+		s.Require().NoError(err)
+		s.Require().Empty(tips)
+	})
+
+	s.Run("records the tip per gas of each transaction", func() {
+		params := types.DefaultParams()
+		params.HistoryRetention = 2
+		s.setGenesisState(params, types.DefaultState())
+
+		s.Require().NoError(s.feeMarketKeeper.RecordTip(s.ctx, sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, 100)), 10))
+		s.Require().NoError(s.feeMarketKeeper.RecordTip(s.ctx, sdk.Coins{}, 20))
+		s.Require().NoError(s.feeMarketKeeper.RecordTip(s.ctx, sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, 30)), 30))
+
+		tips, err := s.feeMarketKeeper.GetTipRecords(s.ctx, s.ctx.BlockHeight())
+		s.Require().NoError(err)
+		s.Require().Equal([]types.TipRecord{
+			{TipPerGas: math.LegacyNewDec(10), GasUsed: 10},
+			{TipPerGas: math.LegacyZeroDec(), GasUsed: 20},
+			{TipPerGas: math.LegacyNewDec(1), GasUsed: 30},
+		}, tips)
+
+		// Tips are pruned together with the gas price records.
+		ctx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 2)
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(ctx))
+
+		tips, err = s.feeMarketKeeper.GetTipRecords(ctx, s.ctx.BlockHeight())
+		s.Require().NoError(err)
+		s.Require().Empty(tips)
+	})
+}
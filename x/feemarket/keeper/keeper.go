@@ -1,11 +1,13 @@
 package keeper
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"strconv"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
-	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,42 +17,109 @@ import (
 
 // Keeper is the x/feemarket keeper.
 type Keeper struct {
-	cdc      codec.BinaryCodec
-	storeKey storetypes.StoreKey
-	ak       types.AccountKeeper
-	resolver types.DenomResolver
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+	ak           types.AccountKeeper
+	bk           types.BankKeeper
+	dk           types.DistributionKeeper
+	resolver     types.DenomResolver
+	pricing      *types.PricingRegistry
 
 	// The address that is capable of executing a MsgParams message.
 	// Typically, this will be the governance module's address.
 	authority string
+
+	Schema        collections.Schema
+	Params        collections.Item[types.Params]
+	State         collections.Item[types.State]
+	EnabledHeight collections.Item[int64]
+	Frozen        collections.Item[bool]
+
+	// Heights are encoded as big endian uint64 keys, which keeps the layout of
+	// the records written before the migration to collections.
+	ScheduledParams collections.Map[uint64, types.ScheduledParams]
+	GasPriceHistory collections.Map[uint64, types.GasPriceRecord]
+	TipRecords      collections.Map[collections.Pair[uint64, uint64], types.TipRecord]
+	SweptFees       collections.Map[string, types.SweptFees]
+	DenomRates      collections.Map[string, types.DenomRate]
+
+	LastDenomGasPrices collections.Map[string, types.DenomGasPrice]
 }
 
-// NewKeeper constructs a new feemarket keeper.
+// NewKeeper constructs a new feemarket keeper. If pricing is nil, the keeper uses the
+// default registry of pricing algorithms, see types.DefaultPricingRegistry.
 func NewKeeper(
 	cdc codec.BinaryCodec,
-	storeKey storetypes.StoreKey,
+	storeService store.KVStoreService,
 	authKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	distributionKeeper types.DistributionKeeper,
 	resolver types.DenomResolver,
+	pricing *types.PricingRegistry,
 	authority string,
 ) *Keeper {
 	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address: %s", authority))
 	}
 
+	if pricing == nil {
+		pricing = types.DefaultPricingRegistry()
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+
 	k := &Keeper{
-		cdc:       cdc,
-		storeKey:  storeKey,
-		ak:        authKeeper,
-		resolver:  resolver,
-		authority: authority,
+		cdc:          cdc,
+		storeService: storeService,
+		ak:           authKeeper,
+		bk:           bankKeeper,
+		dk:           distributionKeeper,
+		resolver:     resolver,
+		pricing:      pricing,
+		authority:    authority,
+
+		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		State:         collections.NewItem(sb, types.StateKey, "state", codec.CollValue[types.State](cdc)),
+		EnabledHeight: collections.NewItem(sb, types.EnabledHeightKey, "enabled_height", collections.Int64Value),
+		Frozen:        collections.NewItem(sb, types.FrozenKey, "frozen", collections.BoolValue),
+		ScheduledParams: collections.NewMap(
+			sb, types.ScheduledParamsPrefix, "scheduled_params",
+			collections.Uint64Key, codec.CollValue[types.ScheduledParams](cdc),
+		),
+		GasPriceHistory: collections.NewMap(
+			sb, types.GasPriceHistoryPrefix, "gas_price_history",
+			collections.Uint64Key, codec.CollValue[types.GasPriceRecord](cdc),
+		),
+		TipRecords: collections.NewMap(
+			sb, types.TipRecordsPrefix, "tip_records",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), codec.CollValue[types.TipRecord](cdc),
+		),
+		SweptFees: collections.NewMap(
+			sb, types.SweptFeesPrefix, "swept_fees",
+			collections.StringKey, codec.CollValue[types.SweptFees](cdc),
+		),
+		DenomRates: collections.NewMap(
+			sb, types.DenomRatesPrefix, "denom_rates",
+			collections.StringKey, codec.CollValue[types.DenomRate](cdc),
+		),
+		LastDenomGasPrices: collections.NewMap(
+			sb, types.LastDenomGasPricesPrefix, "last_denom_gas_prices",
+			collections.StringKey, codec.CollValue[types.DenomGasPrice](cdc),
+		),
 	}
 
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
 	return k
 }
 
 // Logger returns a feemarket module-specific logger.
-func (k *Keeper) Logger(ctx sdk.Context) log.Logger {
-	return ctx.Logger().With("module", "x/"+types.ModuleName)
+func (k *Keeper) Logger(ctx context.Context) log.Logger {
+	return sdk.UnwrapSDKContext(ctx).Logger().With("module", "x/"+types.ModuleName)
 }
 
 // GetAuthority returns the address that is capable of executing a MsgUpdateParams message.
@@ -59,29 +128,38 @@ func (k *Keeper) GetAuthority() string {
 }
 
 // GetEnabledHeight returns the height at which the feemarket was enabled.
-func (k *Keeper) GetEnabledHeight(ctx sdk.Context) (int64, error) {
-	store := ctx.KVStore(k.storeKey)
-
-	key := types.KeyEnabledHeight
-	bz := store.Get(key)
-	if bz == nil {
+func (k *Keeper) GetEnabledHeight(ctx context.Context) (int64, error) {
+	height, err := k.EnabledHeight.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
 		return -1, nil
 	}
 
-	return strconv.ParseInt(string(bz), 10, 64)
+	return height, err
 }
 
 // SetEnabledHeight sets the height at which the feemarket was enabled.
-func (k *Keeper) SetEnabledHeight(ctx sdk.Context, height int64) {
-	store := ctx.KVStore(k.storeKey)
+func (k *Keeper) SetEnabledHeight(ctx context.Context, height int64) error {
+	return k.EnabledHeight.Set(ctx, height)
+}
 
-	bz := []byte(strconv.FormatInt(height, 10))
+// IsFrozen returns true if the learning rate and base gas price adjustments of the
+// feemarket are frozen.
+func (k *Keeper) IsFrozen(ctx context.Context) (bool, error) {
+	return k.Frozen.Has(ctx)
+}
 
-	store.Set(types.KeyEnabledHeight, bz)
+// SetFrozen freezes or unfreezes the learning rate and base gas price adjustments
+// of the feemarket.
+func (k *Keeper) SetFrozen(ctx context.Context, frozen bool) error {
+	if frozen {
+		return k.Frozen.Set(ctx, true)
+	}
+
+	return k.Frozen.Remove(ctx)
 }
 
 // ResolveToDenom converts the given coin to the given denomination.
-func (k *Keeper) ResolveToDenom(ctx sdk.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error) {
+func (k *Keeper) ResolveToDenom(ctx context.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error) {
 	if k.resolver == nil {
 		return sdk.DecCoin{}, types.ErrResolverNotSet
 	}
@@ -94,60 +172,98 @@ func (k *Keeper) SetDenomResolver(resolver types.DenomResolver) {
 	k.resolver = resolver
 }
 
-// GetState returns the feemarket module's state.
-func (k *Keeper) GetState(ctx sdk.Context) (types.State, error) {
-	store := ctx.KVStore(k.storeKey)
+// GetPricingRegistry returns the registry of pricing algorithms that can be selected
+// with Params.PricingAlgorithm. The registry is shared by all copies of the keeper, so
+// algorithms registered on it are also used by the module, e.g. in EndBlock and the
+// MsgServer.
+func (k *Keeper) GetPricingRegistry() *types.PricingRegistry {
+	return k.pricing
+}
 
-	key := types.KeyState
-	bz := store.Get(key)
+// GetPricingAlgorithm returns the registered pricing algorithm with the given name.
+func (k *Keeper) GetPricingAlgorithm(name string) (types.PricingAlgorithm, error) {
+	return k.pricing.Get(name)
+}
 
-	state := types.State{}
-	if err := state.Unmarshal(bz); err != nil {
-		return types.State{}, err
+// ValidateFeeRecipients returns an error if an extra fee recipient of the given params
+// is not a registered module account.
+func (k *Keeper) ValidateFeeRecipients(params types.Params) error {
+	for _, recipient := range params.ExtraFeeRecipients {
+		if k.ak.GetModuleAddress(recipient.Module) == nil {
+			return types.ErrUnknownFeeRecipient.Wrapf("%s", recipient.Module)
+		}
 	}
 
-	return state, nil
+	return nil
 }
 
-// SetState sets the feemarket module's state.
-func (k *Keeper) SetState(ctx sdk.Context, state types.State) error {
-	store := ctx.KVStore(k.storeKey)
+// GetState returns the feemarket module's state.
+func (k *Keeper) GetState(ctx context.Context) (types.State, error) {
+	return k.State.Get(ctx)
+}
 
-	bz, err := state.Marshal()
-	if err != nil {
-		return err
-	}
+// SetState sets the feemarket module's state.
+func (k *Keeper) SetState(ctx context.Context, state types.State) error {
+	return k.State.Set(ctx, state)
+}
 
-	store.Set(types.KeyState, bz)
+// GetParams returns the feemarket module's parameters.
+func (k *Keeper) GetParams(ctx context.Context) (types.Params, error) {
+	return k.Params.Get(ctx)
+}
 
-	return nil
+// SetParams sets the feemarket module's parameters.
+func (k *Keeper) SetParams(ctx context.Context, params types.Params) error {
+	return k.Params.Set(ctx, params)
 }
 
-// GetParams returns the feemarket module's parameters.
-func (k *Keeper) GetParams(ctx sdk.Context) (types.Params, error) {
-	store := ctx.KVStore(k.storeKey)
+// UpdateParams sets the given params and updates the enabled height and the fee
+// market state accordingly. If resetState is false, the current state is carried
+// over to the new params.
+func (k *Keeper) UpdateParams(ctx context.Context, params types.Params, resetState bool) error {
+	if _, err := k.GetPricingAlgorithm(params.PricingAlgorithm); err != nil {
+		return err
+	}
 
-	key := types.KeyParams
-	bz := store.Get(key)
+	if err := k.ValidateFeeRecipients(params); err != nil {
+		return err
+	}
 
-	params := types.Params{}
-	if err := params.Unmarshal(bz); err != nil {
-		return types.Params{}, err
+	gotParams, err := k.GetParams(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting params: %w", err)
 	}
 
-	return params, nil
-}
+	// if going from disabled -> enabled, set enabled height
+	if !gotParams.Enabled && params.Enabled {
+		if err := k.SetEnabledHeight(ctx, sdk.UnwrapSDKContext(ctx).BlockHeight()); err != nil {
+			return fmt.Errorf("error setting enabled height: %w", err)
+		}
+	}
 
-// SetParams sets the feemarket module's parameters.
-func (k *Keeper) SetParams(ctx sdk.Context, params types.Params) error {
-	store := ctx.KVStore(k.storeKey)
+	if err := k.SetParams(ctx, params); err != nil {
+		return fmt.Errorf("error setting params: %w", err)
+	}
 
-	bz, err := params.Marshal()
+	state, err := k.GetState(ctx)
 	if err != nil {
-		return err
+		return fmt.Errorf("error getting state: %w", err)
 	}
 
-	store.Set(types.KeyParams, bz)
+	if resetState {
+		state = types.NewState(params.Window, params.MinBaseGasPrice, params.MinLearningRate)
+	} else {
+		// Carry the current state over to the new params, keeping the block
+		// utilizations, base gas price and learning rate within the new bounds.
+		state.Resize(params.Window)
+		state.ClampUtilization(params)
+		state.ClampBaseGasPrice(state.BaseGasPrice, params)
+		state.ClampLearningRate(params)
+	}
+
+	if err := k.SetState(ctx, state); err != nil {
+		return fmt.Errorf("error setting state: %w", err)
+	}
 
 	return nil
 }
@@ -14,6 +14,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/suite"
@@ -28,6 +30,8 @@ type KeeperTestSuite struct {
 	suite.Suite
 
 	accountKeeper    *mocks.AccountKeeper
+	bankKeeper       bankkeeper.Keeper
+	distrKeeper      distrkeeper.Keeper
 	feeMarketKeeper  *keeper.Keeper
 	encCfg           TestEncodingConfig
 	ctx              sdk.Context
@@ -51,10 +55,12 @@ func (s *KeeperTestSuite) SetupTest() {
 	ctx, tk, tm := testkeeper.NewTestSetup(s.T())
 
 	s.ctx = ctx
+	s.bankKeeper = tk.BankKeeper
+	s.distrKeeper = tk.DistrKeeper
 	s.feeMarketKeeper = tk.FeeMarketKeeper
 	s.msgServer = tm.FeeMarketMsgServer
 	s.queryServer = keeper.NewQueryServer(*s.feeMarketKeeper)
-	s.feeMarketKeeper.SetEnabledHeight(s.ctx, -1)
+	s.Require().NoError(s.feeMarketKeeper.SetEnabledHeight(s.ctx, -1))
 }
 
 func (s *KeeperTestSuite) TestState() {
@@ -98,16 +104,22 @@ func (s *KeeperTestSuite) TestParams() {
 
 	s.Run("set and get custom params", func() {
 		params := types.Params{
-			Alpha:               math.LegacyMustNewDecFromStr("0.1"),
-			Beta:                math.LegacyMustNewDecFromStr("0.1"),
-			Gamma:               math.LegacyMustNewDecFromStr("0.1"),
-			Delta:               math.LegacyMustNewDecFromStr("0.1"),
-			MinBaseGasPrice:     math.LegacyNewDec(10),
-			MinLearningRate:     math.LegacyMustNewDecFromStr("0.1"),
-			MaxLearningRate:     math.LegacyMustNewDecFromStr("0.1"),
-			MaxBlockUtilization: 10,
-			Window:              1,
-			Enabled:             true,
+			Alpha:                       math.LegacyMustNewDecFromStr("0.1"),
+			Beta:                        math.LegacyMustNewDecFromStr("0.1"),
+			Gamma:                       math.LegacyMustNewDecFromStr("0.1"),
+			Delta:                       math.LegacyMustNewDecFromStr("0.1"),
+			MinBaseGasPrice:             math.LegacyNewDec(10),
+			MinLearningRate:             math.LegacyMustNewDecFromStr("0.1"),
+			MaxLearningRate:             math.LegacyMustNewDecFromStr("0.1"),
+			MaxBlockUtilization:         10,
+			Window:                      1,
+			Enabled:                     true,
+			TargetBlockUtilizationRatio: math.LegacyMustNewDecFromStr("0.5"),
+			MaxBaseGasPrice:             math.LegacyZeroDec(),
+			MaxChangePerBlock:           math.LegacyZeroDec(),
+			BurnRatio:                   math.LegacyZeroDec(),
+			StakerRatio:                 math.LegacyZeroDec(),
+			CommunityPoolRatio:          math.LegacyZeroDec(),
 		}
 
 		err := s.feeMarketKeeper.SetParams(s.ctx, params)
@@ -122,7 +134,7 @@ func (s *KeeperTestSuite) TestParams() {
 
 func (s *KeeperTestSuite) TestEnabledHeight() {
 	s.Run("get and set values", func() {
-		s.feeMarketKeeper.SetEnabledHeight(s.ctx, 10)
+		s.Require().NoError(s.feeMarketKeeper.SetEnabledHeight(s.ctx, 10))
 
 		got, err := s.feeMarketKeeper.GetEnabledHeight(s.ctx)
 		s.Require().NoError(err)
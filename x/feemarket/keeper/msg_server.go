@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -30,25 +31,310 @@ func (ms MsgServer) Params(goCtx context.Context, msg *types.MsgParams) (*types.
 		return nil, fmt.Errorf("invalid authority to execute message")
 	}
 
+	if err := ms.k.UpdateParams(ctx, msg.Params, msg.ResetState); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgParamsResponse{}, nil
+}
+
+// UpdateParamsPartial defines a method that updates the given fields of the module's parameters.
+// The signer of the message must be the module authority.
+func (ms MsgServer) UpdateParamsPartial(
+	goCtx context.Context,
+	msg *types.MsgUpdateParamsPartial,
+) (*types.MsgUpdateParamsPartialResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != ms.k.GetAuthority() {
+		return nil, fmt.Errorf("invalid authority to execute message")
+	}
+
 	gotParams, err := ms.k.GetParams(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error getting params: %w", err)
 	}
 
-	// if going from disabled -> enabled, set enabled height
-	if !gotParams.Enabled && msg.Params.Enabled {
-		ms.k.SetEnabledHeight(ctx, ctx.BlockHeight())
+	params, err := gotParams.MergeFields(msg.Params, msg.Fields)
+	if err != nil {
+		return nil, err
 	}
 
-	params := msg.Params
-	if err := ms.k.SetParams(ctx, params); err != nil {
-		return nil, fmt.Errorf("error setting params: %w", err)
+	if err := params.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid params: %w", err)
 	}
 
-	newState := types.NewState(params.Window, params.MinBaseGasPrice, params.MinLearningRate)
-	if err := ms.k.SetState(ctx, newState); err != nil {
+	if err := ms.k.UpdateParams(ctx, params, false); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsPartialResponse{}, nil
+}
+
+// ScheduleParams defines a method that schedules a change of the module's parameters at a future
+// block height. Params cannot be scheduled for a height that already has scheduled params. The
+// signer of the message must be the module authority.
+func (ms MsgServer) ScheduleParams(goCtx context.Context, msg *types.MsgScheduleParams) (*types.MsgScheduleParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != ms.k.GetAuthority() {
+		return nil, fmt.Errorf("invalid authority to execute message")
+	}
+
+	if msg.Height <= ctx.BlockHeight() {
+		return nil, fmt.Errorf("height %d must be greater than the current height %d", msg.Height, ctx.BlockHeight())
+	}
+
+	if err := msg.Params.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid params: %w", err)
+	}
+
+	if _, err := ms.k.GetPricingAlgorithm(msg.Params.PricingAlgorithm); err != nil {
+		return nil, err
+	}
+
+	if err := ms.k.ValidateFeeRecipients(msg.Params); err != nil {
+		return nil, err
+	}
+
+	_, found, err := ms.k.GetScheduledParams(ctx, msg.Height)
+	if err != nil {
+		return nil, fmt.Errorf("error getting scheduled params: %w", err)
+	}
+
+	if found {
+		return nil, fmt.Errorf("params are already scheduled at height %d", msg.Height)
+	}
+
+	scheduled := types.ScheduledParams{
+		Height: msg.Height,
+		Params: msg.Params,
+	}
+	if err := ms.k.SetScheduledParams(ctx, scheduled); err != nil {
+		return nil, fmt.Errorf("error setting scheduled params: %w", err)
+	}
+
+	return &types.MsgScheduleParamsResponse{}, nil
+}
+
+// CancelScheduledParams defines a method that cancels the change of the module's parameters
+// scheduled at a future block height. The signer of the message must be the module authority.
+func (ms MsgServer) CancelScheduledParams(goCtx context.Context, msg *types.MsgCancelScheduledParams) (*types.MsgCancelScheduledParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != ms.k.GetAuthority() {
+		return nil, fmt.Errorf("invalid authority to execute message")
+	}
+
+	_, found, err := ms.k.GetScheduledParams(ctx, msg.Height)
+	if err != nil {
+		return nil, fmt.Errorf("error getting scheduled params: %w", err)
+	}
+
+	if !found {
+		return nil, fmt.Errorf("no params are scheduled at height %d", msg.Height)
+	}
+
+	if err := ms.k.DeleteScheduledParams(ctx, msg.Height); err != nil {
+		return nil, fmt.Errorf("error deleting scheduled params: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeScheduledParamsCancelled,
+		sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(msg.Height, 10)),
+	))
+
+	return &types.MsgCancelScheduledParamsResponse{}, nil
+}
+
+// SetBaseGasPrice defines a method that overrides the current base gas price. The signer of the
+// message must be the module authority.
+func (ms MsgServer) SetBaseGasPrice(goCtx context.Context, msg *types.MsgSetBaseGasPrice) (*types.MsgSetBaseGasPriceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != ms.k.GetAuthority() {
+		return nil, fmt.Errorf("invalid authority to execute message")
+	}
+
+	params, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting params: %w", err)
+	}
+
+	if msg.BaseGasPrice.IsNil() || msg.BaseGasPrice.LT(params.MinBaseGasPrice) {
+		return nil, fmt.Errorf("base gas price must be greater than or equal to the min base gas price %s", params.MinBaseGasPrice)
+	}
+
+	if params.HasMaxBaseGasPrice() && msg.BaseGasPrice.GT(params.MaxBaseGasPrice) {
+		return nil, fmt.Errorf("base gas price must be less than or equal to the max base gas price %s", params.MaxBaseGasPrice)
+	}
+
+	state, err := ms.k.GetState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting state: %w", err)
+	}
+
+	prevBaseGasPrice := state.BaseGasPrice
+	state.BaseGasPrice = msg.BaseGasPrice
+	if err := ms.k.SetState(ctx, state); err != nil {
 		return nil, fmt.Errorf("error setting state: %w", err)
 	}
 
-	return &types.MsgParamsResponse{}, nil
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBaseGasPriceSet,
+		sdk.NewAttribute(types.AttributeKeyPreviousBaseGasPrice, prevBaseGasPrice.String()),
+		sdk.NewAttribute(types.AttributeKeyBaseGasPrice, msg.BaseGasPrice.String()),
+	))
+
+	return &types.MsgSetBaseGasPriceResponse{}, nil
+}
+
+// FreezeFeeMarket defines a method that freezes the learning rate and base gas price adjustments.
+// The signer of the message must be the module authority.
+func (ms MsgServer) FreezeFeeMarket(goCtx context.Context, msg *types.MsgFreezeFeeMarket) (*types.MsgFreezeFeeMarketResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != ms.k.GetAuthority() {
+		return nil, fmt.Errorf("invalid authority to execute message")
+	}
+
+	frozen, err := ms.k.IsFrozen(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if frozen {
+		return nil, fmt.Errorf("fee market is already frozen")
+	}
+
+	if err := ms.k.SetFrozen(ctx, true); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFeeMarketFrozen,
+		sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+	))
+
+	return &types.MsgFreezeFeeMarketResponse{}, nil
+}
+
+// UnfreezeFeeMarket defines a method that resumes the learning rate and base gas price adjustments.
+// The signer of the message must be the module authority.
+func (ms MsgServer) UnfreezeFeeMarket(goCtx context.Context, msg *types.MsgUnfreezeFeeMarket) (*types.MsgUnfreezeFeeMarketResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != ms.k.GetAuthority() {
+		return nil, fmt.Errorf("invalid authority to execute message")
+	}
+
+	frozen, err := ms.k.IsFrozen(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !frozen {
+		return nil, fmt.Errorf("fee market is not frozen")
+	}
+
+	if err := ms.k.SetFrozen(ctx, false); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFeeMarketUnfrozen,
+		sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+	))
+
+	return &types.MsgUnfreezeFeeMarketResponse{}, nil
+}
+
+// SweepFeeCollector defines a method that burns the fees held by the feemarket fee collector or
+// sends them to the community pool or a module account. The signer of the message must be the
+// module authority.
+func (ms MsgServer) SweepFeeCollector(goCtx context.Context, msg *types.MsgSweepFeeCollector) (*types.MsgSweepFeeCollectorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != ms.k.GetAuthority() {
+		return nil, fmt.Errorf("invalid authority to execute message")
+	}
+
+	amount, err := ms.k.SweepFeeCollector(ctx, msg.Destination, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFeeCollectorSwept,
+		sdk.NewAttribute(types.AttributeKeyDestination, msg.Destination),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	))
+
+	return &types.MsgSweepFeeCollectorResponse{Amount: amount}, nil
+}
+
+// SetDenomRate defines a method that sets the static conversion rate of a denom that fees can
+// be paid in. The signer of the message must be the module authority.
+func (ms MsgServer) SetDenomRate(goCtx context.Context, msg *types.MsgSetDenomRate) (*types.MsgSetDenomRateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != ms.k.GetAuthority() {
+		return nil, fmt.Errorf("invalid authority to execute message")
+	}
+
+	rate := types.DenomRate{Denom: msg.Denom, Rate: msg.Rate}
+	if err := rate.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	params, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting params: %w", err)
+	}
+
+	if msg.Denom == params.FeeDenom {
+		return nil, fmt.Errorf("the fee denom %s cannot have a denom rate", msg.Denom)
+	}
+
+	if err := ms.k.SetDenomRate(ctx, rate); err != nil {
+		return nil, fmt.Errorf("error setting denom rate: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDenomRateSet,
+		sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+		sdk.NewAttribute(types.AttributeKeyRate, msg.Rate.String()),
+	))
+
+	return &types.MsgSetDenomRateResponse{}, nil
+}
+
+// RemoveDenom defines a method that removes a denom and its static conversion rate, after which
+// fees can no longer be paid in it. The signer of the message must be the module authority.
+func (ms MsgServer) RemoveDenom(goCtx context.Context, msg *types.MsgRemoveDenom) (*types.MsgRemoveDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != ms.k.GetAuthority() {
+		return nil, fmt.Errorf("invalid authority to execute message")
+	}
+
+	has, err := ms.k.HasDenomRate(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if !has {
+		return nil, types.ErrUnknownResolverDenom.Wrapf("%s", msg.Denom)
+	}
+
+	if err := ms.k.RemoveDenomRate(ctx, msg.Denom); err != nil {
+		return nil, fmt.Errorf("error removing denom rate: %w", err)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDenomRemoved,
+		sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+	))
+
+	return &types.MsgRemoveDenomResponse{}, nil
 }
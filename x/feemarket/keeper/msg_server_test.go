@@ -1,6 +1,12 @@
 package keeper_test
 
 import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
 	"github.com/skip-mev/feemarket/x/feemarket/types"
 )
 
@@ -32,6 +38,53 @@ func (s *KeeperTestSuite) TestMsgParams() {
 		s.Require().Equal(req.Params, params)
 	})
 
+	s.Run("rejects a req with an unknown pricing algorithm", func() {
+		params := types.DefaultParams()
+		params.PricingAlgorithm = "unknown"
+
+		req := &types.MsgParams{
+			Authority: s.authorityAccount.String(),
+			Params:    params,
+		}
+		_, err := s.msgServer.Params(s.ctx, req)
+		s.Require().ErrorIs(err, types.ErrUnknownPricingAlgorithm)
+	})
+
+	s.Run("rejects a req with an unknown fee recipient", func() {
+		params := types.DefaultParams()
+		params.StakerRatio = math.LegacyMustNewDecFromStr("0.5")
+		params.ExtraFeeRecipients = []types.FeeRecipient{
+			{Module: "unknown", Ratio: math.LegacyMustNewDecFromStr("0.5")},
+		}
+
+		req := &types.MsgParams{
+			Authority: s.authorityAccount.String(),
+			Params:    params,
+		}
+		_, err := s.msgServer.Params(s.ctx, req)
+		s.Require().ErrorIs(err, types.ErrUnknownFeeRecipient)
+	})
+
+	s.Run("accepts a req with a fee split", func() {
+		params := types.DefaultParams()
+		params.BurnRatio = math.LegacyMustNewDecFromStr("0.5")
+		params.StakerRatio = math.LegacyMustNewDecFromStr("0.25")
+		params.ExtraFeeRecipients = []types.FeeRecipient{
+			{Module: minttypes.ModuleName, Ratio: math.LegacyMustNewDecFromStr("0.25")},
+		}
+
+		req := &types.MsgParams{
+			Authority: s.authorityAccount.String(),
+			Params:    params,
+		}
+		_, err := s.msgServer.Params(s.ctx, req)
+		s.Require().NoError(err)
+
+		gotParams, err := s.feeMarketKeeper.GetParams(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(params, gotParams)
+	})
+
 	s.Run("rejects a req with invalid signer", func() {
 		req := &types.MsgParams{
 			Authority: "invalid",
@@ -95,8 +148,9 @@ func (s *KeeperTestSuite) TestMsgParams() {
 
 		params.Window = 100
 		req := &types.MsgParams{
-			Authority: s.authorityAccount.String(),
-			Params:    params,
+			Authority:  s.authorityAccount.String(),
+			Params:     params,
+			ResetState: true,
 		}
 		_, err = s.msgServer.Params(s.ctx, req)
 		s.Require().NoError(err)
@@ -105,5 +159,672 @@ func (s *KeeperTestSuite) TestMsgParams() {
 		s.Require().NoError(err)
 		s.Require().Equal(params.Window, uint64(len(state.Window)))
 		s.Require().Equal(state.Window[0], uint64(0))
+		s.Require().Equal(params.MinBaseGasPrice, state.BaseGasPrice)
+		s.Require().Equal(params.MinLearningRate, state.LearningRate)
+	})
+
+	s.Run("preserves state after new params request", func() {
+		params := types.DefaultAIMDParams()
+		state := types.DefaultAIMDState()
+		state.BaseGasPrice = params.MinBaseGasPrice.MulInt64(3)
+		state.LearningRate = math.LegacyMustNewDecFromStr("0.2")
+		s.Require().NoError(state.Update(params.MaxBlockUtilization, params))
+		s.setGenesisState(params, state)
+
+		params.Alpha = math.LegacyMustNewDecFromStr("0.05")
+		req := &types.MsgParams{
+			Authority: s.authorityAccount.String(),
+			Params:    params,
+		}
+		_, err := s.msgServer.Params(s.ctx, req)
+		s.Require().NoError(err)
+
+		gotState, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(state, gotState)
+	})
+
+	s.Run("resizes the window keeping the newest entries", func() {
+		params := types.DefaultAIMDParams()
+		state := types.DefaultAIMDState()
+		for i := uint64(0); i < params.Window; i++ {
+			state.Window[i] = i + 1
+		}
+		state.Index = 2
+		s.setGenesisState(params, state)
+
+		params.Window = 4
+		req := &types.MsgParams{
+			Authority: s.authorityAccount.String(),
+			Params:    params,
+		}
+		_, err := s.msgServer.Params(s.ctx, req)
+		s.Require().NoError(err)
+
+		gotState, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal([]uint64{8, 1, 2, 3}, gotState.Window)
+		s.Require().Equal(uint64(3), gotState.Index)
+		s.Require().Equal(state.BaseGasPrice, gotState.BaseGasPrice)
+	})
+
+	s.Run("clamps the state to the new bounds", func() {
+		params := types.DefaultAIMDParams()
+		state := types.DefaultAIMDState()
+		state.LearningRate = params.MaxLearningRate
+		s.setGenesisState(params, state)
+
+		params.MinBaseGasPrice = state.BaseGasPrice.MulInt64(2)
+		params.MaxLearningRate = math.LegacyMustNewDecFromStr("0.1")
+		req := &types.MsgParams{
+			Authority: s.authorityAccount.String(),
+			Params:    params,
+		}
+		_, err := s.msgServer.Params(s.ctx, req)
+		s.Require().NoError(err)
+
+		gotState, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(params.MinBaseGasPrice, gotState.BaseGasPrice)
+		s.Require().Equal(params.MaxLearningRate, gotState.LearningRate)
+	})
+
+	s.Run("clamps the block utilizations to a lowered max block utilization", func() {
+		params := types.DefaultAIMDParams()
+		state := types.DefaultAIMDState()
+		state.Window[0] = params.MaxBlockUtilization
+		state.IncrementHeight()
+		s.Require().NoError(state.Update(params.MaxBlockUtilization*3/4, params))
+		s.setGenesisState(params, state)
+
+		params.MaxBlockUtilization /= 2
+		req := &types.MsgParams{
+			Authority: s.authorityAccount.String(),
+			Params:    params,
+		}
+		_, err := s.msgServer.Params(s.ctx, req)
+		s.Require().NoError(err)
+
+		gotState, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(params.MaxBlockUtilization, gotState.Window[0])
+		s.Require().Equal(params.MaxBlockUtilization, gotState.Window[gotState.Index])
+
+		// the average utilization of the window does not exceed the max
+		s.Require().True(gotState.GetAverageUtilization(params).LTE(math.LegacyOneDec()))
+	})
+}
+
+func (s *KeeperTestSuite) TestMsgUpdateParamsPartial() {
+	s.Run("updates only the given fields", func() {
+		params := types.DefaultAIMDParams()
+		state := types.DefaultAIMDState()
+		state.BaseGasPrice = params.MinBaseGasPrice.MulInt64(3)
+		s.setGenesisState(params, state)
+
+		update := types.Params{
+			Alpha:    math.LegacyMustNewDecFromStr("0.05"),
+			FeeDenom: "other",
+		}
+		req := &types.MsgUpdateParamsPartial{
+			Authority: s.authorityAccount.String(),
+			Params:    update,
+			Fields:    []string{"alpha"},
+		}
+		_, err := s.msgServer.UpdateParamsPartial(s.ctx, req)
+		s.Require().NoError(err)
+
+		gotParams, err := s.feeMarketKeeper.GetParams(s.ctx)
+		s.Require().NoError(err)
+
+		expectedParams := types.DefaultAIMDParams()
+		expectedParams.Alpha = update.Alpha
+		s.Require().Equal(expectedParams, gotParams)
+
+		gotState, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(state, gotState)
+	})
+
+	s.Run("rejects a req with an invalid authority", func() {
+		req := &types.MsgUpdateParamsPartial{
+			Authority: "invalid",
+			Params:    types.DefaultParams(),
+			Fields:    []string{"alpha"},
+		}
+		_, err := s.msgServer.UpdateParamsPartial(s.ctx, req)
+		s.Require().Error(err)
+	})
+
+	s.Run("rejects a req with an unknown field", func() {
+		req := &types.MsgUpdateParamsPartial{
+			Authority: s.authorityAccount.String(),
+			Params:    types.DefaultParams(),
+			Fields:    []string{"unknown"},
+		}
+		_, err := s.msgServer.UpdateParamsPartial(s.ctx, req)
+		s.Require().ErrorIs(err, types.ErrInvalidParamsField)
+	})
+
+	s.Run("rejects a req that results in invalid params", func() {
+		params := types.DefaultAIMDParams()
+		s.setGenesisState(params, types.DefaultAIMDState())
+
+		req := &types.MsgUpdateParamsPartial{
+			Authority: s.authorityAccount.String(),
+			Params:    types.Params{},
+			Fields:    []string{"fee_denom"},
+		}
+		_, err := s.msgServer.UpdateParamsPartial(s.ctx, req)
+		s.Require().Error(err)
+
+		gotParams, err := s.feeMarketKeeper.GetParams(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(params, gotParams)
+	})
+
+	s.Run("rejects a req with an unknown pricing algorithm", func() {
+		params := types.DefaultAIMDParams()
+		s.setGenesisState(params, types.DefaultAIMDState())
+
+		req := &types.MsgUpdateParamsPartial{
+			Authority: s.authorityAccount.String(),
+			Params:    types.Params{PricingAlgorithm: "unknown"},
+			Fields:    []string{"pricing_algorithm"},
+		}
+		_, err := s.msgServer.UpdateParamsPartial(s.ctx, req)
+		s.Require().ErrorIs(err, types.ErrUnknownPricingAlgorithm)
+	})
+}
+
+func (s *KeeperTestSuite) TestMsgScheduleParams() {
+	s.Run("schedules params at a future height", func() {
+		params := types.DefaultParams()
+		s.setGenesisState(params, types.DefaultState())
+
+		newParams := types.DefaultAIMDParams()
+		req := &types.MsgScheduleParams{
+			Authority: s.authorityAccount.String(),
+			Height:    s.ctx.BlockHeight() + 100,
+			Params:    newParams,
+		}
+		_, err := s.msgServer.ScheduleParams(s.ctx, req)
+		s.Require().NoError(err)
+
+		scheduled, found, err := s.feeMarketKeeper.GetScheduledParams(s.ctx, req.Height)
+		s.Require().NoError(err)
+		s.Require().True(found)
+		s.Require().Equal(newParams, scheduled.Params)
+
+		// The current params are not changed.
+		gotParams, err := s.feeMarketKeeper.GetParams(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(params, gotParams)
+
+		s.Require().NoError(s.feeMarketKeeper.DeleteScheduledParams(s.ctx, req.Height))
+	})
+
+	s.Run("rejects a req with an invalid authority", func() {
+		req := &types.MsgScheduleParams{
+			Authority: "invalid",
+			Height:    s.ctx.BlockHeight() + 100,
+			Params:    types.DefaultParams(),
+		}
+		_, err := s.msgServer.ScheduleParams(s.ctx, req)
+		s.Require().Error(err)
+	})
+
+	s.Run("rejects a req for the current height", func() {
+		req := &types.MsgScheduleParams{
+			Authority: s.authorityAccount.String(),
+			Height:    s.ctx.BlockHeight(),
+			Params:    types.DefaultParams(),
+		}
+		_, err := s.msgServer.ScheduleParams(s.ctx, req)
+		s.Require().Error(err)
+	})
+
+	s.Run("rejects a req with invalid params", func() {
+		req := &types.MsgScheduleParams{
+			Authority: s.authorityAccount.String(),
+			Height:    s.ctx.BlockHeight() + 100,
+			Params:    types.Params{},
+		}
+		_, err := s.msgServer.ScheduleParams(s.ctx, req)
+		s.Require().Error(err)
+	})
+
+	s.Run("rejects a req with an unknown pricing algorithm", func() {
+		params := types.DefaultParams()
+		params.PricingAlgorithm = "unknown"
+
+		req := &types.MsgScheduleParams{
+			Authority: s.authorityAccount.String(),
+			Height:    s.ctx.BlockHeight() + 100,
+			Params:    params,
+		}
+		_, err := s.msgServer.ScheduleParams(s.ctx, req)
+		s.Require().ErrorIs(err, types.ErrUnknownPricingAlgorithm)
+	})
+
+	s.Run("rejects a req for a height that already has scheduled params", func() {
+		s.setGenesisState(types.DefaultParams(), types.DefaultState())
+
+		req := &types.MsgScheduleParams{
+			Authority: s.authorityAccount.String(),
+			Height:    s.ctx.BlockHeight() + 100,
+			Params:    types.DefaultParams(),
+		}
+		_, err := s.msgServer.ScheduleParams(s.ctx, req)
+		s.Require().NoError(err)
+
+		newParams := types.DefaultAIMDParams()
+		_, err = s.msgServer.ScheduleParams(s.ctx, &types.MsgScheduleParams{
+			Authority: s.authorityAccount.String(),
+			Height:    req.Height,
+			Params:    newParams,
+		})
+		s.Require().ErrorContains(err, "already scheduled")
+
+		// The params scheduled first are kept.
+		scheduled, found, err := s.feeMarketKeeper.GetScheduledParams(s.ctx, req.Height)
+		s.Require().NoError(err)
+		s.Require().True(found)
+		s.Require().Equal(req.Params, scheduled.Params)
+
+		s.Require().NoError(s.feeMarketKeeper.DeleteScheduledParams(s.ctx, req.Height))
+	})
+}
+
+func (s *KeeperTestSuite) TestMsgCancelScheduledParams() {
+	s.Run("cancels the params scheduled at a height", func() {
+		s.setGenesisState(types.DefaultParams(), types.DefaultState())
+
+		height := s.ctx.BlockHeight() + 100
+		s.Require().NoError(s.feeMarketKeeper.SetScheduledParams(s.ctx, types.ScheduledParams{
+			Height: height,
+			Params: types.DefaultAIMDParams(),
+		}))
+
+		req := &types.MsgCancelScheduledParams{
+			Authority: s.authorityAccount.String(),
+			Height:    height,
+		}
+
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		_, err := s.msgServer.CancelScheduledParams(ctx, req)
+		s.Require().NoError(err)
+
+		_, found, err := s.feeMarketKeeper.GetScheduledParams(ctx, height)
+		s.Require().NoError(err)
+		s.Require().False(found)
+
+		events := ctx.EventManager().Events()
+		s.Require().Len(events, 1)
+		s.Require().Equal(types.EventTypeScheduledParamsCancelled, events[0].Type)
+	})
+
+	s.Run("rejects a req with an invalid authority", func() {
+		req := &types.MsgCancelScheduledParams{
+			Authority: "invalid",
+			Height:    s.ctx.BlockHeight() + 100,
+		}
+		_, err := s.msgServer.CancelScheduledParams(s.ctx, req)
+		s.Require().Error(err)
+	})
+
+	s.Run("rejects a req for a height without scheduled params", func() {
+		s.setGenesisState(types.DefaultParams(), types.DefaultState())
+
+		req := &types.MsgCancelScheduledParams{
+			Authority: s.authorityAccount.String(),
+			Height:    s.ctx.BlockHeight() + 100,
+		}
+		_, err := s.msgServer.CancelScheduledParams(s.ctx, req)
+		s.Require().ErrorContains(err, "no params are scheduled")
+	})
+}
+
+func (s *KeeperTestSuite) TestMsgSetBaseGasPrice() {
+	s.Run("sets the base gas price", func() {
+		params := types.DefaultAIMDParams()
+		state := types.DefaultAIMDState()
+		s.setGenesisState(params, state)
+
+		price := params.MinBaseGasPrice.MulInt64(5)
+		req := &types.MsgSetBaseGasPrice{
+			Authority:    s.authorityAccount.String(),
+			BaseGasPrice: price,
+		}
+
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		_, err := s.msgServer.SetBaseGasPrice(ctx, req)
+		s.Require().NoError(err)
+
+		gotState, err := s.feeMarketKeeper.GetState(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(price, gotState.BaseGasPrice)
+		s.Require().Equal(state.LearningRate, gotState.LearningRate)
+		s.Require().Equal(state.Window, gotState.Window)
+
+		events := ctx.EventManager().Events()
+		s.Require().Len(events, 1)
+		s.Require().Equal(types.EventTypeBaseGasPriceSet, events[0].Type)
+	})
+
+	s.Run("rejects a req with an invalid authority", func() {
+		req := &types.MsgSetBaseGasPrice{
+			Authority:    "invalid",
+			BaseGasPrice: types.DefaultAIMDMinBaseFee,
+		}
+		_, err := s.msgServer.SetBaseGasPrice(s.ctx, req)
+		s.Require().Error(err)
+	})
+
+	s.Run("rejects a price below the min base gas price", func() {
+		params := types.DefaultAIMDParams()
+		s.setGenesisState(params, types.DefaultAIMDState())
+
+		req := &types.MsgSetBaseGasPrice{
+			Authority:    s.authorityAccount.String(),
+			BaseGasPrice: params.MinBaseGasPrice.QuoInt64(2),
+		}
+		_, err := s.msgServer.SetBaseGasPrice(s.ctx, req)
+		s.Require().Error(err)
+	})
+
+	s.Run("rejects a price above the max base gas price", func() {
+		params := types.DefaultAIMDParams()
+		params.MaxBaseGasPrice = params.MinBaseGasPrice.MulInt64(2)
+		s.setGenesisState(params, types.DefaultAIMDState())
+
+		req := &types.MsgSetBaseGasPrice{
+			Authority:    s.authorityAccount.String(),
+			BaseGasPrice: params.MinBaseGasPrice.MulInt64(3),
+		}
+		_, err := s.msgServer.SetBaseGasPrice(s.ctx, req)
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestMsgFreezeFeeMarket() {
+	s.Run("freezes and unfreezes the fee market", func() {
+		s.setGenesisState(types.DefaultAIMDParams(), types.DefaultAIMDState())
+
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		_, err := s.msgServer.FreezeFeeMarket(ctx, &types.MsgFreezeFeeMarket{
+			Authority: s.authorityAccount.String(),
+		})
+		s.Require().NoError(err)
+
+		frozen, err := s.feeMarketKeeper.IsFrozen(ctx)
+		s.Require().NoError(err)
+		s.Require().True(frozen)
+
+		_, err = s.msgServer.UnfreezeFeeMarket(ctx, &types.MsgUnfreezeFeeMarket{
+			Authority: s.authorityAccount.String(),
+		})
+		s.Require().NoError(err)
+
+		frozen, err = s.feeMarketKeeper.IsFrozen(ctx)
+		s.Require().NoError(err)
+		s.Require().False(frozen)
+
+		events := ctx.EventManager().Events()
+		s.Require().Len(events, 2)
+		s.Require().Equal(types.EventTypeFeeMarketFrozen, events[0].Type)
+		s.Require().Equal(types.EventTypeFeeMarketUnfrozen, events[1].Type)
+	})
+
+	s.Run("rejects a req with an invalid authority", func() {
+		_, err := s.msgServer.FreezeFeeMarket(s.ctx, &types.MsgFreezeFeeMarket{
+			Authority: "invalid",
+		})
+		s.Require().Error(err)
+
+		_, err = s.msgServer.UnfreezeFeeMarket(s.ctx, &types.MsgUnfreezeFeeMarket{
+			Authority: "invalid",
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("rejects freezing a frozen fee market", func() {
+		s.Require().NoError(s.feeMarketKeeper.SetFrozen(s.ctx, true))
+		defer func() { s.Require().NoError(s.feeMarketKeeper.SetFrozen(s.ctx, false)) }()
+
+		_, err := s.msgServer.FreezeFeeMarket(s.ctx, &types.MsgFreezeFeeMarket{
+			Authority: s.authorityAccount.String(),
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("rejects unfreezing a fee market that is not frozen", func() {
+		_, err := s.msgServer.UnfreezeFeeMarket(s.ctx, &types.MsgUnfreezeFeeMarket{
+			Authority: s.authorityAccount.String(),
+		})
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestMsgSweepFeeCollector() {
+	feeCollector := authtypes.NewModuleAddress(types.FeeCollectorName)
+	s.Require().NoError(s.distrKeeper.FeePool.Set(s.ctx, distrtypes.InitialFeePool()))
+
+	s.Run("burns the whole balance", func() {
+		s.fundFeeCollector(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		resp, err := s.msgServer.SweepFeeCollector(ctx, &types.MsgSweepFeeCollector{
+			Authority:   s.authorityAccount.String(),
+			Destination: types.FeeSplitDestinationBurn,
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), resp.Amount)
+		s.Require().True(s.bankKeeper.GetAllBalances(ctx, feeCollector).IsZero())
+		s.Require().True(s.bankKeeper.GetSupply(ctx, "stake").IsZero())
+
+		events := ctx.EventManager().Events()
+		s.Require().Equal(types.EventTypeFeeCollectorSwept, events[len(events)-1].Type)
+	})
+
+	s.Run("sends a part of the balance to the community pool", func() {
+		s.fundFeeCollector(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+
+		_, err := s.msgServer.SweepFeeCollector(s.ctx, &types.MsgSweepFeeCollector{
+			Authority:   s.authorityAccount.String(),
+			Destination: types.FeeSplitDestinationCommunityPool,
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 40)),
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 60)), s.bankKeeper.GetAllBalances(s.ctx, feeCollector))
+
+		feePool, err := s.distrKeeper.FeePool.Get(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 40)), feePool.CommunityPool)
+	})
+
+	s.Run("sends the balance to a module account", func() {
+		_, err := s.msgServer.SweepFeeCollector(s.ctx, &types.MsgSweepFeeCollector{
+			Authority:   s.authorityAccount.String(),
+			Destination: minttypes.ModuleName,
+		})
+		s.Require().NoError(err)
+		s.Require().True(s.bankKeeper.GetAllBalances(s.ctx, feeCollector).IsZero())
+		s.Require().Equal(
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 60)),
+			s.bankKeeper.GetAllBalances(s.ctx, authtypes.NewModuleAddress(minttypes.ModuleName)),
+		)
+	})
+
+	s.Run("does not sweep the fees escrowed by the executing tx", func() {
+		s.fundFeeCollector(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+
+		ctx := types.ContextWithEscrowedFees(s.ctx, sdk.NewCoins(sdk.NewInt64Coin("stake", 30)))
+		resp, err := s.msgServer.SweepFeeCollector(ctx, &types.MsgSweepFeeCollector{
+			Authority:   s.authorityAccount.String(),
+			Destination: types.FeeSplitDestinationBurn,
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 70)), resp.Amount)
+		s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 30)), s.bankKeeper.GetAllBalances(ctx, feeCollector))
+
+		_, err = s.msgServer.SweepFeeCollector(ctx, &types.MsgSweepFeeCollector{
+			Authority:   s.authorityAccount.String(),
+			Destination: types.FeeSplitDestinationBurn,
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("accumulates the swept fees per destination", func() {
+		swept, err := s.feeMarketKeeper.GetAllSweptFees(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal([]types.SweptFees{
+			{Destination: types.FeeSplitDestinationBurn, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 170))},
+			{Destination: types.FeeSplitDestinationCommunityPool, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 40))},
+			{Destination: minttypes.ModuleName, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 60))},
+		}, swept)
+	})
+
+	s.Run("rejects a req with an invalid authority", func() {
+		_, err := s.msgServer.SweepFeeCollector(s.ctx, &types.MsgSweepFeeCollector{
+			Authority:   "invalid",
+			Destination: types.FeeSplitDestinationBurn,
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("rejects a req with an unknown destination", func() {
+		_, err := s.msgServer.SweepFeeCollector(s.ctx, &types.MsgSweepFeeCollector{
+			Authority:   s.authorityAccount.String(),
+			Destination: "unknown",
+		})
+		s.Require().ErrorIs(err, types.ErrUnknownFeeRecipient)
+
+		_, err = s.msgServer.SweepFeeCollector(s.ctx, &types.MsgSweepFeeCollector{
+			Authority:   s.authorityAccount.String(),
+			Destination: types.FeeCollectorName,
+		})
+		s.Require().ErrorIs(err, types.ErrUnknownFeeRecipient)
+	})
+
+	s.Run("rejects a req with an amount above the balance", func() {
+		_, err := s.msgServer.SweepFeeCollector(s.ctx, &types.MsgSweepFeeCollector{
+			Authority:   s.authorityAccount.String(),
+			Destination: types.FeeSplitDestinationBurn,
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 31)),
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("rejects a req without fees to sweep", func() {
+		_, err := s.msgServer.SweepFeeCollector(s.ctx, &types.MsgSweepFeeCollector{
+			Authority:   s.authorityAccount.String(),
+			Destination: types.FeeSplitDestinationBurn,
+		})
+		s.Require().NoError(err)
+
+		_, err = s.msgServer.SweepFeeCollector(s.ctx, &types.MsgSweepFeeCollector{
+			Authority:   s.authorityAccount.String(),
+			Destination: types.FeeSplitDestinationBurn,
+		})
+		s.Require().Error(err)
+	})
+}
+
+// fundFeeCollector mints the given coins to the feemarket fee collector.
+func (s *KeeperTestSuite) fundFeeCollector(coins sdk.Coins) {
+	s.Require().NoError(s.bankKeeper.MintCoins(s.ctx, minttypes.ModuleName, coins))
+	s.Require().NoError(s.bankKeeper.SendCoinsFromModuleToModule(s.ctx, minttypes.ModuleName, types.FeeCollectorName, coins))
+}
+
+func (s *KeeperTestSuite) TestMsgSetDenomRate() {
+	s.Run("sets and updates a denom rate", func() {
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		_, err := s.msgServer.SetDenomRate(ctx, &types.MsgSetDenomRate{
+			Authority: s.authorityAccount.String(),
+			Denom:     "atom",
+			Rate:      math.LegacyMustNewDecFromStr("0.5"),
+		})
+		s.Require().NoError(err)
+
+		events := ctx.EventManager().Events()
+		s.Require().Equal(types.EventTypeDenomRateSet, events[len(events)-1].Type)
+
+		_, err = s.msgServer.SetDenomRate(s.ctx, &types.MsgSetDenomRate{
+			Authority: s.authorityAccount.String(),
+			Denom:     "atom",
+			Rate:      math.LegacyNewDec(2),
+		})
+		s.Require().NoError(err)
+
+		rate, err := s.feeMarketKeeper.GetDenomRate(s.ctx, "atom")
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyNewDec(2), rate.Rate)
+	})
+
+	s.Run("rejects a req with an invalid authority", func() {
+		_, err := s.msgServer.SetDenomRate(s.ctx, &types.MsgSetDenomRate{
+			Authority: "invalid",
+			Denom:     "atom",
+			Rate:      math.LegacyOneDec(),
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("rejects a non-positive rate", func() {
+		_, err := s.msgServer.SetDenomRate(s.ctx, &types.MsgSetDenomRate{
+			Authority: s.authorityAccount.String(),
+			Denom:     "atom",
+			Rate:      math.LegacyZeroDec(),
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("rejects a rate for the fee denom", func() {
+		params, err := s.feeMarketKeeper.GetParams(s.ctx)
+		s.Require().NoError(err)
+
+		_, err = s.msgServer.SetDenomRate(s.ctx, &types.MsgSetDenomRate{
+			Authority: s.authorityAccount.String(),
+			Denom:     params.FeeDenom,
+			Rate:      math.LegacyOneDec(),
+		})
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestMsgRemoveDenom() {
+	s.Require().NoError(s.feeMarketKeeper.SetDenomRate(s.ctx, types.DenomRate{Denom: "atom", Rate: math.LegacyOneDec()}))
+
+	s.Run("rejects a req with an invalid authority", func() {
+		_, err := s.msgServer.RemoveDenom(s.ctx, &types.MsgRemoveDenom{
+			Authority: "invalid",
+			Denom:     "atom",
+		})
+		s.Require().Error(err)
+	})
+
+	s.Run("removes a denom", func() {
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		_, err := s.msgServer.RemoveDenom(ctx, &types.MsgRemoveDenom{
+			Authority: s.authorityAccount.String(),
+			Denom:     "atom",
+		})
+		s.Require().NoError(err)
+
+		events := ctx.EventManager().Events()
+		s.Require().Equal(types.EventTypeDenomRemoved, events[len(events)-1].Type)
+
+		_, err = s.feeMarketKeeper.GetDenomRate(s.ctx, "atom")
+		s.Require().ErrorIs(err, types.ErrUnknownResolverDenom)
+	})
+
+	s.Run("rejects an unknown denom", func() {
+		_, err := s.msgServer.RemoveDenom(s.ctx, &types.MsgRemoveDenom{
+			Authority: s.authorityAccount.String(),
+			Denom:     "atom",
+		})
+		s.Require().ErrorIs(err, types.ErrUnknownResolverDenom)
 	})
 }
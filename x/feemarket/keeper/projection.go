@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// ProjectGasPrice returns the base gas price and learning rate after each of the next
+// blocks, assuming the given amount of gas is used in each block. The projection runs
+// the selected pricing algorithm on a copy of the current state, the same way as
+// UpdateFeeMarket does, and nothing is persisted.
+func (k *Keeper) ProjectGasPrice(ctx context.Context, utilizations []uint64) ([]types.ProjectedGasPrice, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	algorithm, err := k.GetPricingAlgorithm(params.PricingAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := k.GetState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	frozen, err := k.IsFrozen(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The fee market is not updated while it is disabled or frozen.
+	static := !params.Enabled || frozen
+
+	path := make([]types.ProjectedGasPrice, len(utilizations))
+	for i, utilization := range utilizations {
+		if !static {
+			state.Window[state.Index] = utilization
+
+			prevBaseGasPrice := state.BaseGasPrice
+			algorithm.UpdateLearningRate(&state, params)
+			algorithm.UpdateBaseGasPrice(&state, params)
+			state.ClampBaseGasPrice(prevBaseGasPrice, params)
+
+			state.IncrementHeight()
+		}
+
+		path[i] = types.ProjectedGasPrice{
+			BaseGasPrice: state.BaseGasPrice,
+			LearningRate: state.LearningRate,
+		}
+	}
+
+	return path, nil
+}
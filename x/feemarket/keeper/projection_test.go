@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestProjectGasPrice() {
+	s.Run("matches the fee market updates without persisting them", func() {
+		params := types.DefaultAIMDParams()
+		state := types.DefaultAIMDState()
+		s.setGenesisState(params, state)
+
+		utilizations := []uint64{params.MaxBlockUtilization, params.MaxBlockUtilization / 2, 0}
+
+		path, err := s.feeMarketKeeper.ProjectGasPrice(s.ctx, utilizations)
+		s.Require().NoError(err)
+		s.Require().Len(path, len(utilizations))
+
+		gotState, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(state, gotState)
+
+		// Running the fee market with the same utilizations yields the same path.
+		for i, utilization := range utilizations {
+			ctx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + int64(i))
+
+			current, err := s.feeMarketKeeper.GetState(ctx)
+			s.Require().NoError(err)
+			s.Require().NoError(current.Update(utilization, params))
+			s.Require().NoError(s.feeMarketKeeper.SetState(ctx, current))
+			s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(ctx))
+
+			updated, err := s.feeMarketKeeper.GetState(ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(updated.BaseGasPrice, path[i].BaseGasPrice)
+			s.Require().Equal(updated.LearningRate, path[i].LearningRate)
+		}
+
+		s.Require().True(path[0].BaseGasPrice.GT(state.BaseGasPrice))
+	})
+
+	s.Run("projects a constant price while frozen", func() {
+		params := types.DefaultAIMDParams()
+		state := types.DefaultAIMDState()
+		s.setGenesisState(params, state)
+
+		s.feeMarketKeeper.SetFrozen(s.ctx, true)
+		defer s.feeMarketKeeper.SetFrozen(s.ctx, false)
+
+		path, err := s.feeMarketKeeper.ProjectGasPrice(s.ctx, []uint64{params.MaxBlockUtilization, params.MaxBlockUtilization})
+		s.Require().NoError(err)
+		for _, projected := range path {
+			s.Require().Equal(state.BaseGasPrice, projected.BaseGasPrice)
+			s.Require().Equal(state.LearningRate, projected.LearningRate)
+		}
+	})
+}
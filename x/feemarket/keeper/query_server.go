@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/skip-mev/feemarket/x/feemarket/types"
 )
@@ -33,7 +34,12 @@ func (q QueryServer) State(goCtx context.Context, _ *types.StateRequest) (*types
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	state, err := q.k.GetState(ctx)
-	return &types.StateResponse{State: state}, err
+	if err != nil {
+		return nil, err
+	}
+
+	frozen, err := q.k.IsFrozen(ctx)
+	return &types.StateResponse{State: state, Frozen: frozen}, err
 }
 
 // GasPrice defines a method that returns the current feemarket base gas price.
@@ -44,10 +50,171 @@ func (q QueryServer) GasPrice(goCtx context.Context, req *types.GasPriceRequest)
 	return &types.GasPriceResponse{Price: gasPrice}, err
 }
 
-// GasPrices defines a method that returns the current feemarket list of gas prices.
+// GasPrices defines a method that returns the current feemarket list of gas prices, along with
+// the status of the gas price of each extra denom of the denom resolver.
 func (q QueryServer) GasPrices(goCtx context.Context, _ *types.GasPricesRequest) (*types.GasPricesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	gasPrices, err := q.k.GetMinGasPrices(ctx)
-	return &types.GasPricesResponse{Prices: gasPrices}, err
+	gasPrices, statuses, err := q.k.GetMinGasPricesWithStatus(ctx)
+	return &types.GasPricesResponse{Prices: gasPrices, DenomStatuses: statuses}, err
+}
+
+// ScheduledParams defines a method that returns the pending parameter changes.
+func (q QueryServer) ScheduledParams(goCtx context.Context, _ *types.ScheduledParamsRequest) (*types.ScheduledParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	scheduled, err := q.k.GetAllScheduledParams(ctx)
+	return &types.ScheduledParamsResponse{ScheduledParams: scheduled}, err
+}
+
+// GasPriceHistory defines a method that returns the recorded gas price history.
+func (q QueryServer) GasPriceHistory(goCtx context.Context, req *types.GasPriceHistoryRequest) (*types.GasPriceHistoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	records, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.GasPriceHistory,
+		req.GetPagination(),
+		func(_ uint64, record types.GasPriceRecord) (types.GasPriceRecord, error) {
+			return record, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GasPriceHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// GasPriceRecord defines a method that returns the gas price record of a given height.
+func (q QueryServer) GasPriceRecord(goCtx context.Context, req *types.GasPriceRecordRequest) (*types.GasPriceRecordResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	record, err := q.k.GetGasPriceRecord(ctx, req.GetHeight())
+	return &types.GasPriceRecordResponse{Record: record}, err
+}
+
+// FeeHistory defines a method that returns the base gas prices, gas used ratios and
+// tip percentiles of a range of recorded blocks.
+func (q QueryServer) FeeHistory(goCtx context.Context, req *types.FeeHistoryRequest) (*types.FeeHistoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.GetBlockCount() == 0 || req.GetBlockCount() > types.MaxFeeHistoryBlockCount {
+		return nil, types.ErrInvalidFeeHistory.Wrapf(
+			"block count must be between [1, %d], got %d", types.MaxFeeHistoryBlockCount, req.GetBlockCount(),
+		)
+	}
+
+	if err := types.ValidateRewardPercentiles(req.RewardPercentiles); err != nil {
+		return nil, err
+	}
+
+	params, err := q.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetNewestBlock() < 0 {
+		return nil, types.ErrInvalidFeeHistory.Wrapf("newest block cannot be negative, got %d", req.GetNewestBlock())
+	}
+
+	newest := req.GetNewestBlock()
+	if newest == 0 {
+		latest, err := q.k.GetLatestGasPriceRecord(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		newest = latest.Height
+	}
+
+	// chains younger than the block count return all blocks up to the newest block
+	oldest := max(1, newest-int64(req.GetBlockCount())+1)
+
+	records, err := q.k.GetGasPriceRecords(ctx, oldest, newest)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, types.ErrGasPriceRecordNotFound.Wrapf("no gas price history recorded up to height %d", newest)
+	}
+
+	resp := &types.FeeHistoryResponse{OldestBlock: records[0].Height}
+	for _, record := range records {
+		tips, err := q.k.GetTipRecords(ctx, record.Height)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.BaseGasPrices = append(resp.BaseGasPrices, record.BaseGasPrice)
+		resp.GasUsedRatios = append(resp.GasUsedRatios, record.GasUsedRatio(params.MaxBlockUtilization))
+		resp.Rewards = append(resp.Rewards, types.FeeHistoryReward{
+			Tips: types.RewardPercentiles(tips, req.RewardPercentiles),
+		})
+	}
+
+	return resp, nil
+}
+
+// ProjectGasPrice defines a method that returns the projected base gas price and
+// learning rate of the next blocks under an assumed block utilization.
+func (q QueryServer) ProjectGasPrice(goCtx context.Context, req *types.ProjectGasPriceRequest) (*types.ProjectGasPriceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.GetBlockCount() == 0 || req.GetBlockCount() > types.MaxProjectionBlockCount {
+		return nil, types.ErrInvalidProjection.Wrapf(
+			"block count must be between [1, %d], got %d", types.MaxProjectionBlockCount, req.GetBlockCount(),
+		)
+	}
+
+	if err := types.ValidateUtilizationRatios(req.UtilizationRatios, req.GetBlockCount()); err != nil {
+		return nil, err
+	}
+
+	params, err := q.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := q.k.ProjectGasPrice(ctx, types.ProjectedUtilizations(req.GetBlockCount(), req.UtilizationRatios, params))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ProjectGasPriceResponse{
+		BaseGasPrice: path[len(path)-1].BaseGasPrice,
+		Path:         path,
+	}, nil
+}
+
+// FeeCollector defines a method that returns the balance of the feemarket fee collector and
+// the cumulative amount of fees swept from it.
+func (q QueryServer) FeeCollector(goCtx context.Context, _ *types.FeeCollectorRequest) (*types.FeeCollectorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	swept, err := q.k.GetAllSweptFees(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var totalSwept sdk.Coins
+	for _, s := range swept {
+		totalSwept = totalSwept.Add(s.Amount...)
+	}
+
+	return &types.FeeCollectorResponse{
+		Balance:    q.k.bk.GetAllBalances(ctx, q.k.ak.GetModuleAddress(types.FeeCollectorName)),
+		Swept:      swept,
+		TotalSwept: totalSwept,
+	}, nil
+}
+
+// DenomRates defines a method that returns the static conversion rates of the denoms that
+// fees can be paid in.
+func (q QueryServer) DenomRates(goCtx context.Context, _ *types.DenomRatesRequest) (*types.DenomRatesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rates, err := q.k.GetAllDenomRates(ctx)
+	return &types.DenomRatesResponse{DenomRates: rates}, err
 }
@@ -2,7 +2,11 @@ package keeper_test
 
 import (
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 
+	"github.com/skip-mev/feemarket/x/feemarket/keeper"
 	"github.com/skip-mev/feemarket/x/feemarket/types"
 )
 
@@ -23,16 +27,22 @@ func (s *KeeperTestSuite) TestParamsRequest() {
 
 	s.Run("can get updated params", func() {
 		params := types.Params{
-			Alpha:               math.LegacyMustNewDecFromStr("0.1"),
-			Beta:                math.LegacyMustNewDecFromStr("0.1"),
-			Gamma:               math.LegacyMustNewDecFromStr("0.1"),
-			Delta:               math.LegacyMustNewDecFromStr("0.1"),
-			MinBaseGasPrice:     math.LegacyNewDec(10),
-			MinLearningRate:     math.LegacyMustNewDecFromStr("0.1"),
-			MaxLearningRate:     math.LegacyMustNewDecFromStr("0.1"),
-			MaxBlockUtilization: 10,
-			Window:              1,
-			Enabled:             true,
+			Alpha:                       math.LegacyMustNewDecFromStr("0.1"),
+			Beta:                        math.LegacyMustNewDecFromStr("0.1"),
+			Gamma:                       math.LegacyMustNewDecFromStr("0.1"),
+			Delta:                       math.LegacyMustNewDecFromStr("0.1"),
+			MinBaseGasPrice:             math.LegacyNewDec(10),
+			MinLearningRate:             math.LegacyMustNewDecFromStr("0.1"),
+			MaxLearningRate:             math.LegacyMustNewDecFromStr("0.1"),
+			MaxBlockUtilization:         10,
+			Window:                      1,
+			Enabled:                     true,
+			TargetBlockUtilizationRatio: math.LegacyMustNewDecFromStr("0.5"),
+			MaxBaseGasPrice:             math.LegacyZeroDec(),
+			MaxChangePerBlock:           math.LegacyZeroDec(),
+			BurnRatio:                   math.LegacyZeroDec(),
+			StakerRatio:                 math.LegacyZeroDec(),
+			CommunityPoolRatio:          math.LegacyZeroDec(),
 		}
 		err := s.feeMarketKeeper.SetParams(s.ctx, params)
 		s.Require().NoError(err)
@@ -157,3 +167,324 @@ func (s *KeeperTestSuite) TestBaseFeeRequest() {
 		s.Require().Equal(resp.GetPrice(), fee)
 	})
 }
+
+func (s *KeeperTestSuite) TestScheduledParamsRequest() {
+	s.Run("can get empty scheduled params", func() {
+		req := &types.ScheduledParamsRequest{}
+		resp, err := s.queryServer.ScheduledParams(s.ctx, req)
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+		s.Require().Empty(resp.ScheduledParams)
+	})
+
+	s.Run("can get scheduled params", func() {
+		scheduled := types.ScheduledParams{
+			Height: s.ctx.BlockHeight() + 10,
+			Params: types.DefaultAIMDParams(),
+		}
+		s.Require().NoError(s.feeMarketKeeper.SetScheduledParams(s.ctx, scheduled))
+		defer s.feeMarketKeeper.DeleteScheduledParams(s.ctx, scheduled.Height)
+
+		req := &types.ScheduledParamsRequest{}
+		resp, err := s.queryServer.ScheduledParams(s.ctx, req)
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+		s.Require().Equal([]types.ScheduledParams{scheduled}, resp.ScheduledParams)
+	})
+}
+
+func (s *KeeperTestSuite) TestStateRequestFrozen() {
+	s.Run("reports whether the fee market is frozen", func() {
+		resp, err := s.queryServer.State(s.ctx, &types.StateRequest{})
+		s.Require().NoError(err)
+		s.Require().False(resp.Frozen)
+
+		s.feeMarketKeeper.SetFrozen(s.ctx, true)
+		defer s.feeMarketKeeper.SetFrozen(s.ctx, false)
+
+		resp, err = s.queryServer.State(s.ctx, &types.StateRequest{})
+		s.Require().NoError(err)
+		s.Require().True(resp.Frozen)
+	})
+}
+
+func (s *KeeperTestSuite) TestGasPriceHistoryRequest() {
+	params := types.DefaultParams()
+	params.HistoryRetention = 10
+	s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+	s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, types.DefaultState()))
+
+	start := s.ctx.BlockHeight()
+	for i := int64(0); i < 4; i++ {
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx.WithBlockHeight(start + i)))
+	}
+
+	s.Run("can paginate the gas price history", func() {
+		resp, err := s.queryServer.GasPriceHistory(s.ctx, &types.GasPriceHistoryRequest{
+			Pagination: &query.PageRequest{Limit: 3, CountTotal: true},
+		})
+		s.Require().NoError(err)
+		s.Require().Len(resp.Records, 3)
+		s.Require().Equal(uint64(4), resp.Pagination.Total)
+		s.Require().Equal(start, resp.Records[0].Height)
+
+		resp, err = s.queryServer.GasPriceHistory(s.ctx, &types.GasPriceHistoryRequest{
+			Pagination: &query.PageRequest{Key: resp.Pagination.NextKey},
+		})
+		s.Require().NoError(err)
+		s.Require().Len(resp.Records, 1)
+		s.Require().Equal(start+3, resp.Records[0].Height)
+	})
+
+	s.Run("can get the gas price record of a height", func() {
+		resp, err := s.queryServer.GasPriceRecord(s.ctx, &types.GasPriceRecordRequest{Height: start + 1})
+		s.Require().NoError(err)
+		s.Require().Equal(start+1, resp.Record.Height)
+	})
+
+	s.Run("errors for a height without a record", func() {
+		_, err := s.queryServer.GasPriceRecord(s.ctx, &types.GasPriceRecordRequest{Height: start + 100})
+		s.Require().ErrorIs(err, types.ErrGasPriceRecordNotFound)
+	})
+}
+
+func (s *KeeperTestSuite) TestFeeHistoryRequest() {
+	params := types.DefaultParams()
+	params.HistoryRetention = 10
+	params.MaxBlockUtilization = 100
+	s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+	s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, types.DefaultState()))
+
+	percentiles := []math.LegacyDec{math.LegacyNewDec(10), math.LegacyNewDec(95)}
+
+	s.Run("errors without recorded history", func() {
+		_, err := s.queryServer.FeeHistory(s.ctx, &types.FeeHistoryRequest{BlockCount: 1})
+		s.Require().ErrorIs(err, types.ErrGasPriceRecordNotFound)
+	})
+
+	start := s.ctx.BlockHeight()
+	for i := int64(0); i < 3; i++ {
+		ctx := s.ctx.WithBlockHeight(start + i)
+
+		state, err := s.feeMarketKeeper.GetState(ctx)
+		s.Require().NoError(err)
+		s.Require().NoError(state.Update(uint64(25*(i+1)), params))
+		s.Require().NoError(s.feeMarketKeeper.SetState(ctx, state))
+
+		s.Require().NoError(s.feeMarketKeeper.RecordTip(ctx, sdk.NewCoins(sdk.NewInt64Coin(params.FeeDenom, 10*(i+1))), 5))
+		s.Require().NoError(s.feeMarketKeeper.RecordTip(ctx, sdk.NewCoins(sdk.NewInt64Coin(params.FeeDenom, 0)), 20*(uint64(i)+1)))
+
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(ctx))
+	}
+
+	s.Run("returns the latest blocks by default", func() {
+		resp, err := s.queryServer.FeeHistory(s.ctx, &types.FeeHistoryRequest{
+			BlockCount:        2,
+			RewardPercentiles: percentiles,
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(start+1, resp.OldestBlock)
+		s.Require().Len(resp.BaseGasPrices, 2)
+		s.Require().Equal([]math.LegacyDec{
+			math.LegacyMustNewDecFromStr("0.5"),
+			math.LegacyMustNewDecFromStr("0.75"),
+		}, resp.GasUsedRatios)
+		s.Require().Equal([]types.FeeHistoryReward{
+			{Tips: []math.LegacyDec{math.LegacyZeroDec(), math.LegacyNewDec(4)}},
+			{Tips: []math.LegacyDec{math.LegacyZeroDec(), math.LegacyNewDec(6)}},
+		}, resp.Rewards)
+	})
+
+	s.Run("uses the max block utilization of each block", func() {
+		changed := params
+		changed.MaxBlockUtilization = 50
+		s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, changed))
+		defer func() { s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params)) }()
+
+		resp, err := s.queryServer.FeeHistory(s.ctx, &types.FeeHistoryRequest{BlockCount: 2})
+		s.Require().NoError(err)
+		s.Require().Equal([]math.LegacyDec{
+			math.LegacyMustNewDecFromStr("0.5"),
+			math.LegacyMustNewDecFromStr("0.75"),
+		}, resp.GasUsedRatios)
+	})
+
+	s.Run("returns the available blocks up to the newest block", func() {
+		resp, err := s.queryServer.FeeHistory(s.ctx, &types.FeeHistoryRequest{
+			BlockCount:  10,
+			NewestBlock: start + 1,
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(start, resp.OldestBlock)
+		s.Require().Len(resp.BaseGasPrices, 2)
+		s.Require().Len(resp.Rewards, 2)
+		s.Require().Empty(resp.Rewards[0].Tips)
+	})
+
+	s.Run("returns all blocks of a chain younger than the block count", func() {
+		for height := int64(1); height <= 3; height++ {
+			s.Require().NoError(s.feeMarketKeeper.SetGasPriceRecord(s.ctx, types.GasPriceRecord{
+				Height:       height,
+				BaseGasPrice: math.LegacyOneDec(),
+				LearningRate: math.LegacyOneDec(),
+			}))
+		}
+
+		resp, err := s.queryServer.FeeHistory(s.ctx, &types.FeeHistoryRequest{
+			BlockCount:  10,
+			NewestBlock: 3,
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(int64(1), resp.OldestBlock)
+		s.Require().Len(resp.BaseGasPrices, 3)
+	})
+
+	s.Run("errors for a negative newest block", func() {
+		_, err := s.queryServer.FeeHistory(s.ctx, &types.FeeHistoryRequest{BlockCount: 1, NewestBlock: -1})
+		s.Require().ErrorIs(err, types.ErrInvalidFeeHistory)
+	})
+
+	s.Run("errors for an invalid block count", func() {
+		_, err := s.queryServer.FeeHistory(s.ctx, &types.FeeHistoryRequest{BlockCount: 0})
+		s.Require().ErrorIs(err, types.ErrInvalidFeeHistory)
+
+		_, err = s.queryServer.FeeHistory(s.ctx, &types.FeeHistoryRequest{BlockCount: types.MaxFeeHistoryBlockCount + 1})
+		s.Require().ErrorIs(err, types.ErrInvalidFeeHistory)
+	})
+
+	s.Run("errors for invalid percentiles", func() {
+		_, err := s.queryServer.FeeHistory(s.ctx, &types.FeeHistoryRequest{
+			BlockCount:        1,
+			RewardPercentiles: []math.LegacyDec{math.LegacyNewDec(90), math.LegacyNewDec(10)},
+		})
+		s.Require().ErrorIs(err, types.ErrInvalidFeeHistory)
+	})
+}
+
+func (s *KeeperTestSuite) TestProjectGasPriceRequest() {
+	params := types.DefaultAIMDParams()
+	s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+	s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, types.DefaultAIMDState()))
+
+	s.Run("repeats the last utilization ratio", func() {
+		resp, err := s.queryServer.ProjectGasPrice(s.ctx, &types.ProjectGasPriceRequest{
+			BlockCount:        3,
+			UtilizationRatios: []math.LegacyDec{math.LegacyMustNewDecFromStr("0.9")},
+		})
+		s.Require().NoError(err)
+		s.Require().Len(resp.Path, 3)
+		s.Require().Equal(resp.Path[2].BaseGasPrice, resp.BaseGasPrice)
+		s.Require().True(resp.Path[2].BaseGasPrice.GT(resp.Path[1].BaseGasPrice))
+		s.Require().True(resp.Path[1].BaseGasPrice.GT(resp.Path[0].BaseGasPrice))
+	})
+
+	s.Run("errors for an invalid block count", func() {
+		_, err := s.queryServer.ProjectGasPrice(s.ctx, &types.ProjectGasPriceRequest{
+			BlockCount:        0,
+			UtilizationRatios: []math.LegacyDec{math.LegacyOneDec()},
+		})
+		s.Require().ErrorIs(err, types.ErrInvalidProjection)
+
+		_, err = s.queryServer.ProjectGasPrice(s.ctx, &types.ProjectGasPriceRequest{
+			BlockCount:        types.MaxProjectionBlockCount + 1,
+			UtilizationRatios: []math.LegacyDec{math.LegacyOneDec()},
+		})
+		s.Require().ErrorIs(err, types.ErrInvalidProjection)
+	})
+
+	s.Run("errors for invalid utilization ratios", func() {
+		_, err := s.queryServer.ProjectGasPrice(s.ctx, &types.ProjectGasPriceRequest{BlockCount: 1})
+		s.Require().ErrorIs(err, types.ErrInvalidProjection)
+
+		_, err = s.queryServer.ProjectGasPrice(s.ctx, &types.ProjectGasPriceRequest{
+			BlockCount:        1,
+			UtilizationRatios: []math.LegacyDec{math.LegacyMustNewDecFromStr("1.5")},
+		})
+		s.Require().ErrorIs(err, types.ErrInvalidProjection)
+
+		_, err = s.queryServer.ProjectGasPrice(s.ctx, &types.ProjectGasPriceRequest{
+			BlockCount:        1,
+			UtilizationRatios: []math.LegacyDec{math.LegacyZeroDec(), math.LegacyOneDec()},
+		})
+		s.Require().ErrorIs(err, types.ErrInvalidProjection)
+	})
+}
+
+func (s *KeeperTestSuite) TestFeeCollectorRequest() {
+	s.Run("can get an empty fee collector", func() {
+		resp, err := s.queryServer.FeeCollector(s.ctx, &types.FeeCollectorRequest{})
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+		s.Require().True(resp.Balance.IsZero())
+		s.Require().Empty(resp.Swept)
+		s.Require().True(resp.TotalSwept.IsZero())
+	})
+
+	s.Run("can get the balance and swept fees", func() {
+		s.fundFeeCollector(sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("atom", 10)))
+
+		_, err := s.feeMarketKeeper.SweepFeeCollector(s.ctx, types.FeeSplitDestinationBurn, sdk.NewCoins(sdk.NewInt64Coin("stake", 20)))
+		s.Require().NoError(err)
+		_, err = s.feeMarketKeeper.SweepFeeCollector(s.ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("stake", 30), sdk.NewInt64Coin("atom", 10)))
+		s.Require().NoError(err)
+
+		resp, err := s.queryServer.FeeCollector(s.ctx, &types.FeeCollectorRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), resp.Balance)
+		s.Require().Equal([]types.SweptFees{
+			{Destination: types.FeeSplitDestinationBurn, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 20))},
+			{Destination: minttypes.ModuleName, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 30), sdk.NewInt64Coin("atom", 10))},
+		}, resp.Swept)
+		s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 50), sdk.NewInt64Coin("atom", 10)), resp.TotalSwept)
+	})
+}
+
+func (s *KeeperTestSuite) TestDenomRatesRequest() {
+	s.Run("can get empty denom rates", func() {
+		resp, err := s.queryServer.DenomRates(s.ctx, &types.DenomRatesRequest{})
+		s.Require().NoError(err)
+		s.Require().Empty(resp.DenomRates)
+	})
+
+	s.Run("can get the denom rates", func() {
+		rates := []types.DenomRate{
+			{Denom: "atom", Rate: math.LegacyMustNewDecFromStr("0.5")},
+			{Denom: "uusdc", Rate: math.LegacyNewDec(2)},
+		}
+		for _, rate := range rates {
+			s.Require().NoError(s.feeMarketKeeper.SetDenomRate(s.ctx, rate))
+		}
+
+		resp, err := s.queryServer.DenomRates(s.ctx, &types.DenomRatesRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal(rates, resp.DenomRates)
+	})
+}
+
+func (s *KeeperTestSuite) TestGasPricesRequest() {
+	gs := types.DefaultAIMDGenesisState()
+	s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+	s.feeMarketKeeper.SetDenomResolver(keeper.NewDenomRateResolver(s.feeMarketKeeper))
+	defer s.feeMarketKeeper.SetDenomResolver(&types.TestDenomResolver{})
+
+	s.Require().NoError(s.feeMarketKeeper.SetDenomRate(s.ctx, types.DenomRate{Denom: "atom", Rate: math.LegacyNewDec(4)}))
+	s.Require().NoError(s.feeMarketKeeper.EndBlock(s.ctx))
+
+	state, err := s.feeMarketKeeper.GetState(s.ctx)
+	s.Require().NoError(err)
+
+	// the query server holds a copy of the keeper with the previous resolver
+	queryServer := keeper.NewQueryServer(*s.feeMarketKeeper)
+	resp, err := queryServer.GasPrices(s.ctx, &types.GasPricesRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, state.BaseGasPrice),
+		sdk.NewDecCoinFromDec("atom", state.BaseGasPrice.QuoInt64(4)),
+	), resp.Prices)
+	s.Require().Equal([]types.DenomGasPriceStatus{{
+		Denom:     "atom",
+		Available: true,
+		LastPrice: &types.DenomGasPrice{Denom: "atom", Price: state.BaseGasPrice.QuoInt64(4), Height: s.ctx.BlockHeight() + 1},
+	}}, resp.DenomStatuses)
+}
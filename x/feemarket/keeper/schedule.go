@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// GetScheduledParams returns the parameters scheduled at the given height. The
+// boolean is false if no parameters are scheduled at the height.
+func (k *Keeper) GetScheduledParams(ctx context.Context, height int64) (types.ScheduledParams, bool, error) {
+	scheduled, err := k.ScheduledParams.Get(ctx, uint64(height))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.ScheduledParams{}, false, nil
+	}
+	if err != nil {
+		return types.ScheduledParams{}, false, err
+	}
+
+	return scheduled, true, nil
+}
+
+// SetScheduledParams schedules the given parameters, replacing any parameters that
+// are already scheduled at the same height. MsgScheduleParams rejects a height that
+// already has scheduled parameters before calling it.
+func (k *Keeper) SetScheduledParams(ctx context.Context, scheduled types.ScheduledParams) error {
+	return k.ScheduledParams.Set(ctx, uint64(scheduled.Height), scheduled)
+}
+
+// DeleteScheduledParams removes the parameters scheduled at the given height.
+func (k *Keeper) DeleteScheduledParams(ctx context.Context, height int64) error {
+	return k.ScheduledParams.Remove(ctx, uint64(height))
+}
+
+// GetAllScheduledParams returns all scheduled parameters ordered by height.
+func (k *Keeper) GetAllScheduledParams(ctx context.Context) ([]types.ScheduledParams, error) {
+	return k.getScheduledParams(ctx, nil)
+}
+
+// ApplyScheduledParams applies all parameters that are scheduled at or before the
+// current height in order of height and removes them from the schedule. The fee
+// market state is carried over to the scheduled parameters. Parameters that fail to
+// apply, e.g. because their pricing algorithm is no longer registered, are dropped
+// with a failure event instead of halting the chain.
+func (k *Keeper) ApplyScheduledParams(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	ranger := new(collections.Range[uint64]).EndInclusive(uint64(sdkCtx.BlockHeight()))
+	pending, err := k.getScheduledParams(ctx, ranger)
+	if err != nil {
+		return err
+	}
+
+	for _, scheduled := range pending {
+		if err := k.DeleteScheduledParams(ctx, scheduled.Height); err != nil {
+			return err
+		}
+
+		if err := k.applyScheduledParams(ctx, scheduled.Params); err != nil {
+			k.Logger(ctx).Error(
+				"failed to apply scheduled params",
+				"height", sdkCtx.BlockHeight(),
+				"scheduled_height", scheduled.Height,
+				"err", err,
+			)
+
+			sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeScheduledParamsFailed,
+				sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(scheduled.Height, 10)),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			))
+
+			continue
+		}
+
+		k.Logger(ctx).Info(
+			"applied scheduled params",
+			"height", sdkCtx.BlockHeight(),
+			"scheduled_height", scheduled.Height,
+			"params", scheduled.Params,
+		)
+
+		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeScheduledParamsApplied,
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(scheduled.Height, 10)),
+		))
+	}
+
+	return nil
+}
+
+// applyScheduledParams updates the parameters in a cached context, so that the store
+// is left untouched if the update fails halfway.
+func (k *Keeper) applyScheduledParams(ctx context.Context, params types.Params) error {
+	cacheCtx, write := sdk.UnwrapSDKContext(ctx).CacheContext()
+	if err := k.UpdateParams(cacheCtx, params, false); err != nil {
+		return err
+	}
+
+	write()
+	return nil
+}
+
+// getScheduledParams returns the scheduled parameters in the given range of heights,
+// ordered by height. If ranger is nil, all scheduled parameters are returned.
+func (k *Keeper) getScheduledParams(ctx context.Context, ranger collections.Ranger[uint64]) ([]types.ScheduledParams, error) {
+	iterator, err := k.ScheduledParams.Iterate(ctx, ranger)
+	if err != nil {
+		return nil, err
+	}
+
+	return iterator.Values()
+}
@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestScheduledParams() {
+	s.Run("returns scheduled params ordered by height", func() {
+		s.setGenesisState(types.DefaultParams(), types.DefaultState())
+
+		for _, height := range []int64{300, 20, 1000} {
+			params := types.DefaultParams()
+			params.Window = uint64(height)
+			s.Require().NoError(s.feeMarketKeeper.SetScheduledParams(s.ctx, types.ScheduledParams{
+				Height: height,
+				Params: params,
+			}))
+		}
+
+		scheduled, err := s.feeMarketKeeper.GetAllScheduledParams(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Len(scheduled, 3)
+		s.Require().Equal(int64(20), scheduled[0].Height)
+		s.Require().Equal(int64(300), scheduled[1].Height)
+		s.Require().Equal(int64(1000), scheduled[2].Height)
+
+		got, found, err := s.feeMarketKeeper.GetScheduledParams(s.ctx, 300)
+		s.Require().NoError(err)
+		s.Require().True(found)
+		s.Require().Equal(uint64(300), got.Params.Window)
+
+		s.feeMarketKeeper.DeleteScheduledParams(s.ctx, 300)
+		_, found, err = s.feeMarketKeeper.GetScheduledParams(s.ctx, 300)
+		s.Require().NoError(err)
+		s.Require().False(found)
+
+		for _, height := range []int64{20, 1000} {
+			s.feeMarketKeeper.DeleteScheduledParams(s.ctx, height)
+		}
+	})
+
+	s.Run("applies scheduled params at the end of the scheduled height", func() {
+		params := types.DefaultAIMDParams()
+		state := types.DefaultAIMDState()
+		state.BaseGasPrice = params.MinBaseGasPrice.MulInt64(3)
+		s.setGenesisState(params, state)
+
+		newParams := types.DefaultAIMDParams()
+		newParams.MinBaseGasPrice = params.MinBaseGasPrice.MulInt64(2)
+		newParams.Alpha = math.LegacyMustNewDecFromStr("0.05")
+
+		height := s.ctx.BlockHeight() + 2
+		s.Require().NoError(s.feeMarketKeeper.SetScheduledParams(s.ctx, types.ScheduledParams{
+			Height: height,
+			Params: newParams,
+		}))
+
+		// The params are not applied before the scheduled height.
+		ctx := s.ctx.WithBlockHeight(height - 1)
+		s.Require().NoError(s.feeMarketKeeper.EndBlock(ctx))
+
+		gotParams, err := s.feeMarketKeeper.GetParams(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(params, gotParams)
+
+		ctx = s.ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.feeMarketKeeper.EndBlock(ctx))
+
+		gotParams, err = s.feeMarketKeeper.GetParams(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(newParams, gotParams)
+
+		// The fee market state is carried over.
+		gotState, err := s.feeMarketKeeper.GetState(ctx)
+		s.Require().NoError(err)
+		s.Require().True(gotState.BaseGasPrice.GT(newParams.MinBaseGasPrice))
+
+		scheduled, err := s.feeMarketKeeper.GetAllScheduledParams(ctx)
+		s.Require().NoError(err)
+		s.Require().Empty(scheduled)
+
+		events := ctx.EventManager().Events()
+		s.Require().Len(events, 1)
+		s.Require().Equal(types.EventTypeScheduledParamsApplied, events[0].Type)
+	})
+
+	s.Run("drops scheduled params that fail to apply without halting the chain", func() {
+		params := types.DefaultAIMDParams()
+		s.setGenesisState(params, types.DefaultAIMDState())
+
+		newParams := types.DefaultAIMDParams()
+		newParams.PricingAlgorithm = "unknown"
+
+		height := s.ctx.BlockHeight() + 2
+		s.Require().NoError(s.feeMarketKeeper.SetScheduledParams(s.ctx, types.ScheduledParams{
+			Height: height,
+			Params: newParams,
+		}))
+
+		ctx := s.ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.feeMarketKeeper.EndBlock(ctx))
+
+		gotParams, err := s.feeMarketKeeper.GetParams(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(params, gotParams)
+
+		scheduled, err := s.feeMarketKeeper.GetAllScheduledParams(ctx)
+		s.Require().NoError(err)
+		s.Require().Empty(scheduled)
+
+		events := ctx.EventManager().Events()
+		s.Require().Len(events, 1)
+		s.Require().Equal(types.EventTypeScheduledParamsFailed, events[0].Type)
+		s.Require().Len(events[0].Attributes, 2)
+		s.Require().Equal(types.AttributeKeyError, events[0].Attributes[1].Key)
+	})
+
+	s.Run("enabling the fee market sets the enabled height", func() {
+		params := types.DefaultParams()
+		params.Enabled = false
+		s.setGenesisState(params, types.DefaultState())
+
+		newParams := types.DefaultParams()
+		height := s.ctx.BlockHeight() + 10
+		s.Require().NoError(s.feeMarketKeeper.SetScheduledParams(s.ctx, types.ScheduledParams{
+			Height: height,
+			Params: newParams,
+		}))
+
+		ctx := s.ctx.WithBlockHeight(height)
+		s.Require().NoError(s.feeMarketKeeper.EndBlock(ctx))
+
+		enabledHeight, err := s.feeMarketKeeper.GetEnabledHeight(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(height, enabledHeight)
+	})
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// GetSweptFees returns the cumulative fees swept from the feemarket fee collector to the
+// given destination.
+func (k *Keeper) GetSweptFees(ctx context.Context, destination string) (types.SweptFees, error) {
+	swept, err := k.SweptFees.Get(ctx, destination)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.SweptFees{Destination: destination}, nil
+	}
+
+	return swept, err
+}
+
+// SetSweptFees sets the cumulative fees swept from the feemarket fee collector to the
+// destination of the given swept fees.
+func (k *Keeper) SetSweptFees(ctx context.Context, swept types.SweptFees) error {
+	return k.SweptFees.Set(ctx, swept.Destination, swept)
+}
+
+// GetAllSweptFees returns the cumulative fees swept from the feemarket fee collector for
+// all destinations, ordered by destination.
+func (k *Keeper) GetAllSweptFees(ctx context.Context) ([]types.SweptFees, error) {
+	iterator, err := k.SweptFees.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return iterator.Values()
+}
+
+// SweepFeeCollector burns the given amount of fees held by the feemarket fee collector
+// or sends it to the community pool or a module account, and returns the swept amount.
+// If the amount is empty, the whole balance is swept. Fees escrowed by the transaction
+// executing the sweep are paid out in the post handler and cannot be swept.
+func (k *Keeper) SweepFeeCollector(ctx context.Context, destination string, amount sdk.Coins) (sdk.Coins, error) {
+	switch destination {
+	case types.FeeSplitDestinationBurn, types.FeeSplitDestinationCommunityPool:
+	default:
+		if destination == types.FeeCollectorName || k.ak.GetModuleAddress(destination) == nil {
+			return nil, types.ErrUnknownFeeRecipient.Wrapf("%s", destination)
+		}
+	}
+
+	feeCollector := k.ak.GetModuleAddress(types.FeeCollectorName)
+	balance := k.bk.GetAllBalances(ctx, feeCollector)
+
+	available, hasNeg := balance.SafeSub(types.EscrowedFeesFromContext(ctx)...)
+	if hasNeg {
+		return nil, fmt.Errorf("fee collector balance %s does not cover the escrowed fees", balance)
+	}
+
+	if amount.Empty() {
+		amount = available
+	} else if !amount.IsAllLTE(available) {
+		return nil, fmt.Errorf("amount %s exceeds the sweepable balance %s", amount, available)
+	}
+
+	if amount.IsZero() {
+		return nil, fmt.Errorf("no fees to sweep")
+	}
+
+	var err error
+	switch destination {
+	case types.FeeSplitDestinationBurn:
+		err = k.bk.BurnCoins(ctx, types.FeeCollectorName, amount)
+	case types.FeeSplitDestinationCommunityPool:
+		err = k.dk.FundCommunityPool(ctx, amount, feeCollector)
+	default:
+		err = k.bk.SendCoinsFromModuleToModule(ctx, types.FeeCollectorName, destination, amount)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to sweep fees to %s: %w", destination, err)
+	}
+
+	swept, err := k.GetSweptFees(ctx, destination)
+	if err != nil {
+		return nil, fmt.Errorf("error getting swept fees: %w", err)
+	}
+
+	swept.Amount = swept.Amount.Add(amount...)
+	if err := k.SetSweptFees(ctx, swept); err != nil {
+		return nil, fmt.Errorf("error setting swept fees: %w", err)
+	}
+
+	return amount, nil
+}
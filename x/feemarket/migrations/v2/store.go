@@ -0,0 +1,121 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	corestore "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2. The migration
+// performs the following:
+//
+//   - Set the parameters that were added in v2 to defaults that keep the v1
+//     behavior of the fee market.
+//   - Rewrite the enabled height, which v1 holds as a decimal string, with the
+//     int64 encoding of collections.
+//
+// All other values and keys of the v1 store are already laid out the way the
+// v2 collections read them.
+func MigrateStore(ctx context.Context, storeService corestore.KVStoreService, cdc codec.BinaryCodec) error {
+	store := storeService.OpenKVStore(ctx)
+
+	if err := migrateParams(store, cdc); err != nil {
+		return err
+	}
+
+	return migrateEnabledHeight(store)
+}
+
+// migrateParams sets the unset v2 parameters to their defaults. The v1 fee market
+// always uses the AIMD pricing algorithm with a 50% target utilization, does not
+// cap the base gas price or its change per block, pays tips to the proposer's
+// operator and handles the base fees as determined by DistributeFees.
+func migrateParams(store corestore.KVStore, cdc codec.BinaryCodec) error {
+	bz, err := store.Get(types.ParamsKey)
+	if err != nil {
+		return err
+	}
+
+	if bz == nil {
+		return nil
+	}
+
+	var params types.Params
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+
+	if params.PricingAlgorithm == "" {
+		params.PricingAlgorithm = types.DefaultPricingAlgorithmName
+	}
+
+	if params.TargetBlockUtilizationRatio.IsNil() {
+		params.TargetBlockUtilizationRatio = types.DefaultTargetBlockUtilizationRatio
+	}
+
+	if params.MaxBaseGasPrice.IsNil() {
+		params.MaxBaseGasPrice = types.DefaultMaxBaseGasPrice
+	}
+
+	if params.MaxChangePerBlock.IsNil() {
+		params.MaxChangePerBlock = types.DefaultMaxChangePerBlock
+	}
+
+	if params.TipDestination == "" {
+		params.TipDestination = types.DefaultTipDestination
+	}
+
+	if params.BurnRatio.IsNil() {
+		params.BurnRatio = types.DefaultFeeSplitRatio
+	}
+
+	if params.StakerRatio.IsNil() {
+		params.StakerRatio = types.DefaultFeeSplitRatio
+	}
+
+	if params.CommunityPoolRatio.IsNil() {
+		params.CommunityPoolRatio = types.DefaultFeeSplitRatio
+	}
+
+	if err := params.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid migrated params: %w", err)
+	}
+
+	bz, err = cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	return store.Set(types.ParamsKey, bz)
+}
+
+// migrateEnabledHeight rewrites the decimal string enabled height of v1 with the
+// int64 encoding of collections.
+func migrateEnabledHeight(store corestore.KVStore) error {
+	bz, err := store.Get(types.EnabledHeightKey)
+	if err != nil {
+		return err
+	}
+
+	if bz == nil {
+		return nil
+	}
+
+	height, err := strconv.ParseInt(string(bz), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid v1 enabled height %q: %w", bz, err)
+	}
+
+	bz, err = collections.Int64Value.Encode(height)
+	if err != nil {
+		return err
+	}
+
+	return store.Set(types.EnabledHeightKey, bz)
+}
@@ -0,0 +1,211 @@
+package v2_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"strconv"
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/feemarket/x/feemarket/keeper"
+	v2 "github.com/skip-mev/feemarket/x/feemarket/migrations/v2"
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	storeService := runtime.NewKVStoreService(storeKey)
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+
+	params := types.DefaultParams()
+	state := types.DefaultState()
+	record := types.GasPriceRecord{
+		Height:           7,
+		BaseGasPrice:     math.LegacyNewDec(2),
+		LearningRate:     math.LegacyMustNewDecFromStr("0.125"),
+		BlockUtilization: 100,
+	}
+	tip := types.TipRecord{TipPerGas: math.LegacyOneDec(), GasUsed: 50}
+	swept := types.SweptFees{
+		Destination: types.FeeSplitDestinationBurn,
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+	}
+
+	// Write the store the way the v1 keeper did.
+	store := ctx.KVStore(storeKey)
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+	store.Set(types.StateKey, cdc.MustMarshal(&state))
+	store.Set(types.EnabledHeightKey, []byte(strconv.FormatInt(5, 10)))
+	store.Set(types.FrozenKey, []byte{1})
+	store.Set(
+		append(append([]byte{}, types.GasPriceHistoryPrefix...), sdk.Uint64ToBigEndian(7)...),
+		cdc.MustMarshal(&record),
+	)
+	store.Set(
+		append(append(append([]byte{}, types.TipRecordsPrefix...), sdk.Uint64ToBigEndian(7)...), sdk.Uint64ToBigEndian(0)...),
+		cdc.MustMarshal(&tip),
+	)
+	store.Set(append(append([]byte{}, types.SweptFeesPrefix...), swept.Destination...), cdc.MustMarshal(&swept))
+
+	require.NoError(t, v2.MigrateStore(ctx, storeService, cdc))
+
+	k := keeper.NewKeeper(
+		cdc,
+		storeService,
+		nil,
+		nil,
+		nil,
+		&types.TestDenomResolver{},
+		nil,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	enabledHeight, err := k.GetEnabledHeight(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), enabledHeight)
+
+	gotParams, err := k.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params, gotParams)
+
+	gotState, err := k.GetState(ctx)
+	require.NoError(t, err)
+	require.Equal(t, state, gotState)
+
+	frozen, err := k.IsFrozen(ctx)
+	require.NoError(t, err)
+	require.True(t, frozen)
+
+	gotRecord, err := k.GetGasPriceRecord(ctx, 7)
+	require.NoError(t, err)
+	require.Equal(t, record, gotRecord)
+
+	gotTips, err := k.GetTipRecords(ctx, 7)
+	require.NoError(t, err)
+	require.Equal(t, []types.TipRecord{tip}, gotTips)
+
+	gotSwept, err := k.GetSweptFees(ctx, swept.Destination)
+	require.NoError(t, err)
+	require.Equal(t, swept, gotSwept)
+}
+
+func TestMigrateStoreDisabled(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	storeService := runtime.NewKVStoreService(storeKey)
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+
+	// The v1 genesis sets the enabled height to -1 if the fee market is not enabled.
+	ctx.KVStore(storeKey).Set(types.EnabledHeightKey, []byte("-1"))
+
+	require.NoError(t, v2.MigrateStore(ctx, storeService, cdc))
+
+	k := keeper.NewKeeper(
+		cdc,
+		storeService,
+		nil,
+		nil,
+		nil,
+		&types.TestDenomResolver{},
+		nil,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	enabledHeight, err := k.GetEnabledHeight(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), enabledHeight)
+}
+
+// TestMigrateV1Fixture migrates a store that was written by the v1 keeper. In the
+// fixture, the AIMD fee market was initialized at genesis with distributed fees,
+// enabled at height 42 and updated for three full blocks.
+func TestMigrateV1Fixture(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+
+	bz, err := os.ReadFile("testdata/v1_store.json")
+	require.NoError(t, err)
+
+	var fixture map[string]string
+	require.NoError(t, json.Unmarshal(bz, &fixture))
+
+	store := ctx.KVStore(storeKey)
+	for key, value := range fixture {
+		keyBz, err := hex.DecodeString(key)
+		require.NoError(t, err)
+
+		valueBz, err := hex.DecodeString(value)
+		require.NoError(t, err)
+
+		store.Set(keyBz, valueBz)
+	}
+
+	k := keeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(storeKey),
+		nil,
+		nil,
+		nil,
+		&types.TestDenomResolver{},
+		nil,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	require.NoError(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+
+	params, err := k.GetParams(ctx)
+	require.NoError(t, err)
+	require.NoError(t, params.ValidateBasic())
+
+	// the v1 parameters are kept
+	v1Params := types.DefaultAIMDParams()
+	require.Equal(t, v1Params.Window, params.Window)
+	require.Equal(t, v1Params.Alpha, params.Alpha)
+	require.Equal(t, v1Params.Beta, params.Beta)
+	require.Equal(t, v1Params.Gamma, params.Gamma)
+	require.Equal(t, v1Params.Delta, params.Delta)
+	require.Equal(t, v1Params.MaxBlockUtilization, params.MaxBlockUtilization)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.0025"), params.MinBaseGasPrice)
+	require.Equal(t, v1Params.MinLearningRate, params.MinLearningRate)
+	require.Equal(t, v1Params.MaxLearningRate, params.MaxLearningRate)
+	require.Equal(t, v1Params.FeeDenom, params.FeeDenom)
+	require.True(t, params.Enabled)
+	require.True(t, params.DistributeFees)
+
+	// the parameters added in v2 keep the v1 behavior
+	require.Equal(t, types.AIMDPricingAlgorithmName, params.PricingAlgorithm)
+	require.Equal(t, types.DefaultTargetBlockUtilizationRatio, params.TargetBlockUtilizationRatio)
+	require.False(t, params.HasMaxBaseGasPrice())
+	require.False(t, params.HasMaxChangePerBlock())
+	require.Zero(t, params.HistoryRetention)
+	require.Equal(t, types.TipDestinationOperator, params.TipDestination)
+	require.False(t, params.HasFeeSplit())
+
+	enabledHeight, err := k.GetEnabledHeight(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(42), enabledHeight)
+
+	state, err := k.GetState(ctx)
+	require.NoError(t, err)
+	require.NoError(t, state.ValidateBasic())
+	require.Equal(t, uint64(3), state.Index)
+	require.True(t, state.BaseGasPrice.GT(params.MinBaseGasPrice))
+
+	// the fee market keeps being updated after the migration
+	require.NoError(t, k.UpdateFeeMarket(ctx.WithBlockHeight(45)))
+
+	updated, err := k.GetState(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), updated.Index)
+}
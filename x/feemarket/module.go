@@ -3,10 +3,11 @@ package feemarket
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
-	store "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -25,7 +26,7 @@ import (
 )
 
 // ConsensusVersion is the x/feemarket module's consensus version identifier.
-const ConsensusVersion = 1
+const ConsensusVersion = 2
 
 var (
 	_ module.HasName        = AppModule{}
@@ -101,8 +102,7 @@ func NewAppModule(cdc codec.Codec, k keeper.Keeper) AppModule {
 
 // EndBlock returns an endblocker for the x/feemarket module.
 func (am AppModule) EndBlock(ctx context.Context) error {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	return am.k.EndBlock(sdkCtx)
+	return am.k.EndBlock(ctx)
 }
 
 // IsAppModule implements the appmodule.AppModule interface.
@@ -118,6 +118,11 @@ func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 func (am AppModule) RegisterServices(cfc module.Configurator) {
 	types.RegisterMsgServer(cfc.MsgServer(), keeper.NewMsgServer(&am.k))
 	types.RegisterQueryServer(cfc.QueryServer(), keeper.NewQueryServer(am.k))
+
+	m := keeper.NewMigrator(&am.k)
+	if err := cfc.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
 }
 
 // DefaultGenesis returns default genesis state as raw bytes for the feemarket
@@ -162,10 +167,14 @@ func init() {
 type Inputs struct {
 	depinject.In
 
-	Config        *modulev1.Module
-	Cdc           codec.Codec
-	Key           *store.KVStoreKey
-	AccountKeeper types.AccountKeeper
+	Config             *modulev1.Module
+	Cdc                codec.Codec
+	StoreService       store.KVStoreService
+	AccountKeeper      types.AccountKeeper
+	BankKeeper         types.BankKeeper
+	DistributionKeeper types.DistributionKeeper
+	DenomResolver      types.DenomResolver    `optional:"true"`
+	PricingRegistry    *types.PricingRegistry `optional:"true"`
 }
 
 type Outputs struct {
@@ -191,12 +200,20 @@ func ProvideModule(in Inputs) Outputs {
 
 	Keeper := keeper.NewKeeper(
 		in.Cdc,
-		in.Key,
+		in.StoreService,
 		in.AccountKeeper,
-		nil,
+		in.BankKeeper,
+		in.DistributionKeeper,
+		in.DenomResolver,
+		in.PricingRegistry,
 		authority.String(),
 	)
 
+	// default to the governance-managed denom rates when the app provides no resolver
+	if in.DenomResolver == nil {
+		Keeper.SetDenomResolver(keeper.NewDenomRateResolver(Keeper))
+	}
+
 	m := NewAppModule(in.Cdc, *Keeper)
 
 	return Outputs{Keeper: *Keeper, Module: m}
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
 )
@@ -31,17 +32,35 @@ type BankKeeper interface {
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+}
+
+// StakingKeeper defines the contract needed to resolve the validator that proposed a block.
+//
+//go:generate mockery --name StakingKeeper --filename mock_staking_keeper.go
+type StakingKeeper interface {
+	GetValidatorByConsAddr(ctx context.Context, consAddr sdk.ConsAddress) (stakingtypes.Validator, error)
+}
+
+// DistributionKeeper defines the contract needed to allocate tips to a validator and to fund
+// the community pool.
+//
+//go:generate mockery --name DistributionKeeper --filename mock_distribution_keeper.go
+type DistributionKeeper interface {
+	AllocateTokensToValidator(ctx context.Context, val stakingtypes.ValidatorI, tokens sdk.DecCoins) error
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
 
 // FeeMarketKeeper defines the expected feemarket keeper.
 //
 //go:generate mockery --name FeeMarketKeeper --filename mock_feemarket_keeper.go
 type FeeMarketKeeper interface {
-	GetState(ctx sdk.Context) (feemarkettypes.State, error)
-	GetParams(ctx sdk.Context) (feemarkettypes.Params, error)
-	SetParams(ctx sdk.Context, params feemarkettypes.Params) error
-	SetState(ctx sdk.Context, state feemarkettypes.State) error
-	ResolveToDenom(ctx sdk.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error)
-	GetMinGasPrice(ctx sdk.Context, denom string) (sdk.DecCoin, error)
-	GetEnabledHeight(ctx sdk.Context) (int64, error)
+	GetState(ctx context.Context) (feemarkettypes.State, error)
+	GetParams(ctx context.Context) (feemarkettypes.Params, error)
+	SetParams(ctx context.Context, params feemarkettypes.Params) error
+	SetState(ctx context.Context, state feemarkettypes.State) error
+	ResolveToDenom(ctx context.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error)
+	GetMinGasPrice(ctx context.Context, denom string) (sdk.DecCoin, error)
+	GetEnabledHeight(ctx context.Context) (int64, error)
+	RecordTip(ctx context.Context, tips sdk.Coins, gasUsed uint64) error
 }
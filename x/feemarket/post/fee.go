@@ -10,36 +10,76 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
 	"github.com/skip-mev/feemarket/x/feemarket/ante"
 	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
 )
 
-// BankSendGasConsumption is the gas consumption of the bank sends that occur during feemarket handler execution.
-const BankSendGasConsumption = 12490
+const (
+	// BankSendGasConsumption is the gas consumption of the bank sends that occur during feemarket handler execution.
+	BankSendGasConsumption = 12490
+
+	// RefundGasConsumption is the gas consumption of refunding the unused part of the escrowed fee.
+	RefundGasConsumption = 5000
+
+	// TipGasConsumption is the gas consumption of paying a tip to the block proposer, which covers
+	// resolving the proposer and allocating the tip to it through x/distribution, the most expensive
+	// tip destination.
+	TipGasConsumption = 50000
+
+	// TipRecordGasConsumption is the gas consumption of recording a tip for the fee history.
+	TipRecordGasConsumption = 6000
+)
+
+// SimulationGasConsumption returns the gas that a simulated tx consumes in place of the fee
+// payout, refund and tip of a delivered tx, which are not executed in simulation as the fee
+// is unknown. The tip and refund are assumed to be paid, so that simulated gas covers the gas
+// consumed by the delivered tx.
+func SimulationGasConsumption(params feemarkettypes.Params) uint64 {
+	gas := uint64(BankSendGasConsumption + RefundGasConsumption + TipGasConsumption)
+	if params.HistoryRetention > 0 {
+		gas += TipRecordGasConsumption
+	}
+
+	return gas
+}
 
 // FeeMarketDeductDecorator deducts fees from the fee payer based off of the current state of the feemarket.
 // The fee payer is the fee granter (if specified) or first signer of the tx.
 // If the fee payer does not have the funds to pay for the fees, return an InsufficientFunds error.
-// If there is an excess between the given fee and the on-chain min base fee is given as a tip.
+// The gas price offered on top of the on-chain min base fee is given as a tip for the gas consumed,
+// and the unused part of the escrowed fee is refunded to the fee payer. Tips are paid to the validator
+// that proposed the block as configured by Params.TipDestination.
 // Call next PostHandler if fees successfully deducted.
 // CONTRACT: Tx must implement FeeTx interface
 type FeeMarketDeductDecorator struct {
-	accountKeeper   AccountKeeper
-	bankKeeper      BankKeeper
-	feemarketKeeper FeeMarketKeeper
+	accountKeeper      AccountKeeper
+	bankKeeper         BankKeeper
+	feemarketKeeper    FeeMarketKeeper
+	stakingKeeper      StakingKeeper
+	distributionKeeper DistributionKeeper
 }
 
-func NewFeeMarketDeductDecorator(ak AccountKeeper, bk BankKeeper, fmk FeeMarketKeeper) FeeMarketDeductDecorator {
+func NewFeeMarketDeductDecorator(
+	ak AccountKeeper,
+	bk BankKeeper,
+	fmk FeeMarketKeeper,
+	sk StakingKeeper,
+	dk DistributionKeeper,
+) FeeMarketDeductDecorator {
 	return FeeMarketDeductDecorator{
-		accountKeeper:   ak,
-		bankKeeper:      bk,
-		feemarketKeeper: fmk,
+		accountKeeper:      ak,
+		bankKeeper:         bk,
+		feemarketKeeper:    fmk,
+		stakingKeeper:      sk,
+		distributionKeeper: dk,
 	}
 }
 
 // PostHandle deducts the fee from the fee payer based on the min base fee and the gas consumed in the gasmeter.
-// If there is a difference between the provided fee and the min-base fee, the difference is paid as a tip.
+// If the provided fee offers a higher gas price than the min-base fee, the difference is paid as a tip for
+// the gas consumed. The rest of the escrowed fee is refunded, see SplitFee.
 // Fees are sent to the x/feemarket fee-collector address.
 func (dfd FeeMarketDeductDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
 	// GenTx consume no fee
@@ -62,8 +102,12 @@ func (dfd FeeMarketDeductDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simul
 		return ctx, errorsmod.Wrapf(err, "unable to get fee market params")
 	}
 
-	// return if disabled
+	// return if disabled, refunding the fees escrowed if the fee market was disabled during the tx
 	if !params.Enabled {
+		if err := dfd.RefundEscrow(ctx, feeTx); err != nil {
+			return ctx, err
+		}
+
 		return next(ctx, tx, simulate, success)
 	}
 
@@ -73,7 +117,12 @@ func (dfd FeeMarketDeductDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simul
 	}
 
 	// if the current height is that which enabled the feemarket or lower, skip deduction
+	// and refund the fees escrowed by txs that follow the enabling tx in the same block
 	if ctx.BlockHeight() <= enabledHeight {
+		if err := dfd.RefundEscrow(ctx, feeTx); err != nil {
+			return ctx, err
+		}
+
 		return next(ctx, tx, simulate, success)
 	}
 
@@ -89,16 +138,14 @@ func (dfd FeeMarketDeductDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simul
 	if len(feeCoins) == 0 && !simulate {
 		return ctx, errorsmod.Wrapf(feemarkettypes.ErrNoFeeCoins, "got length %d", len(feeCoins))
 	}
-	if len(feeCoins) > 1 {
-		return ctx, errorsmod.Wrapf(feemarkettypes.ErrTooManyFeeCoins, "got length %d", len(feeCoins))
-	}
 
-	// if simulating and user did not provider a fee - create a dummy value for them
+	// if simulating and user did not provider a fee - create a dummy value for them.
+	// Fees paid with a combination of coins are priced in the base denom.
 	var (
-		tip     = sdk.NewCoin(params.FeeDenom, math.ZeroInt())
-		payCoin = sdk.NewCoin(params.FeeDenom, math.ZeroInt())
+		fees, tips, refunds sdk.Coins
+		payCoin             = sdk.NewCoin(params.FeeDenom, math.ZeroInt())
 	)
-	if !simulate {
+	if !simulate && len(feeCoins) == 1 {
 		payCoin = feeCoins[0]
 	}
 
@@ -115,21 +162,59 @@ func (dfd FeeMarketDeductDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simul
 	)
 
 	if !simulate {
-		payCoin, tip, err = ante.CheckTxFee(ctx, minGasPrice, payCoin, feeGas, false)
+		var values []math.LegacyDec
+		if len(feeCoins) > 1 {
+			var feeValue math.LegacyDec
+			values, feeValue, err = ante.ResolveFeeCoins(ctx, dfd.feemarketKeeper, feeCoins, params.FeeDenom)
+			if err != nil {
+				return ctx, errorsmod.Wrapf(err, "unable to resolve fee coins")
+			}
+
+			if err := ante.CheckTxFees(minGasPrice, feeCoins, feeValue, feeGas); err != nil {
+				return ctx, err
+			}
+		} else if _, _, err := ante.CheckTxFee(ctx, minGasPrice, payCoin, feeGas, false); err != nil {
+			return ctx, err
+		}
+
+		extOption, err := ante.GetFeeMarketExtensionOption(tx)
 		if err != nil {
 			return ctx, err
 		}
+
+		if err := ante.CheckExtensionOption(extOption, minGasPrice); err != nil {
+			return ctx, err
+		}
+
+		if len(feeCoins) > 1 {
+			fees, tips, refunds = SplitFees(minGasPrice, feeCoins, values, feeGas, int64(gas), extOption)
+		} else {
+			fee, tip, refund := SplitFee(minGasPrice, payCoin, feeGas, int64(gas), extOption)
+			fees, tips, refunds = sdk.NewCoins(fee), sdk.NewCoins(tip), sdk.NewCoins(refund)
+		}
 	}
 
 	ctx.Logger().Debug("fee deduct post handle",
-		"fee", payCoin,
-		"tip", tip,
+		"fee", fees,
+		"tip", tips,
+		"refund", refunds,
 	)
 
-	if err := dfd.PayOutFeeAndTip(ctx, payCoin, tip); err != nil {
+	if err := dfd.PayOutFeeAndTip(ctx, fees, tips); err != nil {
 		return ctx, err
 	}
 
+	if err := dfd.RefundFee(ctx, feeTx, refunds); err != nil {
+		return ctx, err
+	}
+
+	// record the tip for the fee history
+	if !simulate && params.HistoryRetention > 0 {
+		if err := dfd.feemarketKeeper.RecordTip(ctx, tips, gas); err != nil {
+			return ctx, errorsmod.Wrapf(err, "unable to record tip")
+		}
+	}
+
 	err = state.Update(gas, params)
 	if err != nil {
 		return ctx, errorsmod.Wrapf(err, "unable to update fee market state")
@@ -142,7 +227,7 @@ func (dfd FeeMarketDeductDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simul
 
 	if simulate {
 		// consume the gas that would be consumed during normal execution
-		ctx.GasMeter().ConsumeGas(BankSendGasConsumption, "simulation send gas consumption")
+		ctx.GasMeter().ConsumeGas(SimulationGasConsumption(params), "simulation send gas consumption")
 	}
 
 	return next(ctx, tx, simulate, success)
@@ -150,7 +235,7 @@ func (dfd FeeMarketDeductDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simul
 
 // PayOutFeeAndTip deducts the provided fee and tip from the fee payer.
 // If the tx uses a feegranter, the fee granter address will pay the fee instead of the tx signer.
-func (dfd FeeMarketDeductDecorator) PayOutFeeAndTip(ctx sdk.Context, fee, tip sdk.Coin) error {
+func (dfd FeeMarketDeductDecorator) PayOutFeeAndTip(ctx sdk.Context, fee, tip sdk.Coins) error {
 	params, err := dfd.feemarketKeeper.GetParams(ctx)
 	if err != nil {
 		return fmt.Errorf("error getting feemarket params: %v", err)
@@ -159,29 +244,64 @@ func (dfd FeeMarketDeductDecorator) PayOutFeeAndTip(ctx sdk.Context, fee, tip sd
 	var events sdk.Events
 
 	// deduct the fees and tip
-	if !fee.IsNil() {
-		err := DeductCoins(dfd.bankKeeper, ctx, sdk.NewCoins(fee), params.DistributeFees)
+	err = dfd.DistributeFee(ctx, fee, params)
+	if err != nil {
+		return err
+	}
+
+	events = append(events, sdk.NewEvent(
+		feemarkettypes.EventTypeFeePay,
+		sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+	))
+
+	if tip.IsAllPositive() {
+		payee, err := dfd.PayTip(ctx, tip, params.TipDestinationOrDefault())
 		if err != nil {
 			return err
 		}
 
 		events = append(events, sdk.NewEvent(
-			feemarkettypes.EventTypeFeePay,
-			sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+			feemarkettypes.EventTypeTipPay,
+			sdk.NewAttribute(feemarkettypes.AttributeKeyTip, tip.String()),
+			sdk.NewAttribute(feemarkettypes.AttributeKeyTipPayee, payee),
 		))
 	}
 
-	proposer := sdk.AccAddress(ctx.BlockHeader().ProposerAddress)
-	if !tip.IsNil() {
-		err := SendTip(dfd.bankKeeper, ctx, proposer, sdk.NewCoins(tip))
+	ctx.EventManager().EmitEvents(events)
+	return nil
+}
+
+// DistributeFee sends the base fee to its destinations. If the params define a fee split,
+// the fee is split between burning, the stakers, the community pool and the extra fee
+// recipients, and an event is emitted per destination. Otherwise, the fee is handled as
+// determined by DistributeFees, see DeductCoins.
+func (dfd FeeMarketDeductDecorator) DistributeFee(ctx sdk.Context, fee sdk.Coins, params feemarkettypes.Params) error {
+	if !params.HasFeeSplit() {
+		return DeductCoins(dfd.bankKeeper, ctx, fee, params.DistributeFees)
+	}
+
+	var events sdk.Events
+	for _, share := range params.FeeShares(fee) {
+		var err error
+		switch share.Destination {
+		case feemarkettypes.FeeSplitDestinationBurn:
+			err = dfd.bankKeeper.BurnCoins(ctx, feemarkettypes.FeeCollectorName, share.Coins)
+		case feemarkettypes.FeeSplitDestinationStakers:
+			err = DeductCoins(dfd.bankKeeper, ctx, share.Coins, true)
+		case feemarkettypes.FeeSplitDestinationCommunityPool:
+			feeCollector := dfd.accountKeeper.GetModuleAddress(feemarkettypes.FeeCollectorName)
+			err = dfd.distributionKeeper.FundCommunityPool(ctx, share.Coins, feeCollector)
+		default:
+			err = dfd.bankKeeper.SendCoinsFromModuleToModule(ctx, feemarkettypes.FeeCollectorName, share.Destination, share.Coins)
+		}
 		if err != nil {
-			return err
+			return errorsmod.Wrapf(err, "unable to send fee share to %s", share.Destination)
 		}
 
 		events = append(events, sdk.NewEvent(
-			feemarkettypes.EventTypeTipPay,
-			sdk.NewAttribute(feemarkettypes.AttributeKeyTip, tip.String()),
-			sdk.NewAttribute(feemarkettypes.AttributeKeyTipPayee, proposer.String()),
+			feemarkettypes.EventTypeFeeSplit,
+			sdk.NewAttribute(feemarkettypes.AttributeKeyDestination, share.Destination),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, share.Coins.String()),
 		))
 	}
 
@@ -189,6 +309,207 @@ func (dfd FeeMarketDeductDecorator) PayOutFeeAndTip(ctx sdk.Context, fee, tip sd
 	return nil
 }
 
+// PayTip pays the tip to the validator that proposed the current block and returns the
+// address of the payee. Depending on the tip destination, the tip is either sent to the
+// account of the validator operator or allocated to the validator through x/distribution.
+// If the proposer is not a known validator, the tip is sent to the default fee collector
+// and distributed to all stakers.
+func (dfd FeeMarketDeductDecorator) PayTip(ctx sdk.Context, tip sdk.Coins, destination string) (string, error) {
+	proposer := sdk.ConsAddress(ctx.BlockHeader().ProposerAddress)
+
+	validator, err := dfd.stakingKeeper.GetValidatorByConsAddr(ctx, proposer)
+	if err != nil {
+		ctx.Logger().Debug("unable to resolve block proposer, tip is distributed to all stakers",
+			"proposer", proposer,
+			"err", err,
+		)
+
+		err := DeductCoins(dfd.bankKeeper, ctx, tip, true)
+		if err != nil {
+			return "", err
+		}
+
+		return dfd.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName).String(), nil
+	}
+
+	switch destination {
+	case feemarkettypes.TipDestinationDistribution:
+		err := dfd.bankKeeper.SendCoinsFromModuleToModule(ctx, feemarkettypes.FeeCollectorName, distrtypes.ModuleName, tip)
+		if err != nil {
+			return "", err
+		}
+
+		err = dfd.distributionKeeper.AllocateTokensToValidator(ctx, validator, sdk.NewDecCoinsFromCoins(tip...))
+		if err != nil {
+			return "", err
+		}
+
+		return validator.GetOperator(), nil
+	default:
+		operator, err := sdk.ValAddressFromBech32(validator.GetOperator())
+		if err != nil {
+			return "", err
+		}
+
+		err = SendTip(dfd.bankKeeper, ctx, sdk.AccAddress(operator), tip)
+		if err != nil {
+			return "", err
+		}
+
+		return sdk.AccAddress(operator).String(), nil
+	}
+}
+
+// RefundFee refunds the part of the escrowed fee that was neither charged nor paid as
+// a tip. If the tx uses a feegranter, the refund is sent to the fee granter instead of
+// the tx signer. The refund is not credited back to the fee allowance of the grantee,
+// which is charged the full fee when it is escrowed.
+func (dfd FeeMarketDeductDecorator) RefundFee(ctx sdk.Context, feeTx sdk.FeeTx, refund sdk.Coins) error {
+	if !refund.IsAllPositive() {
+		return nil
+	}
+
+	refundee := sdk.AccAddress(feeTx.FeePayer())
+	if feeGranter := feeTx.FeeGranter(); feeGranter != nil {
+		refundee = feeGranter
+	}
+
+	err := dfd.bankKeeper.SendCoinsFromModuleToAccount(ctx, feemarkettypes.FeeCollectorName, refundee, refund)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		feemarkettypes.EventTypeFeeRefund,
+		sdk.NewAttribute(feemarkettypes.AttributeKeyRefund, refund.String()),
+		sdk.NewAttribute(feemarkettypes.AttributeKeyRefundee, refundee.String()),
+	))
+
+	return nil
+}
+
+// RefundEscrow refunds all fees that the ante handler escrowed for the tx, see
+// feemarkettypes.EscrowedFeesFromContext. It is used in the transition blocks in which the
+// fee market does not charge fees although the fees were escrowed, i.e. if the fee market
+// is enabled at the current height by a preceding tx or disabled during the tx.
+func (dfd FeeMarketDeductDecorator) RefundEscrow(ctx sdk.Context, feeTx sdk.FeeTx) error {
+	escrowed := feemarkettypes.EscrowedFeesFromContext(ctx)
+	if escrowed.Empty() {
+		return nil
+	}
+
+	ctx.Logger().Debug("fee market does not charge fees, refunding escrow",
+		"height", ctx.BlockHeight(),
+		"escrowed", escrowed,
+	)
+
+	return dfd.RefundFee(ctx, feeTx, escrowed)
+}
+
+// SplitFee splits the fee provided by a tx into the fee charged for the gas consumed,
+// the priority tip and the refund of the unused part of the escrowed fee:
+//
+//	fee = ceil(gasPrice * gasConsumed)
+//	tip = min(floor((providedFee / gasLimit - gasPrice) * gasConsumed), providedFee - fee)
+//	refund = providedFee - fee - tip
+//
+// If the tx carries a FeeMarketExtensionOption, the tip per gas is additionally capped
+// at the option's max tip per gas. The provided fee must cover the fee charged for the
+// gas limit, see CheckTxFee.
+func SplitFee(
+	gasPrice sdk.DecCoin,
+	providedFee sdk.Coin,
+	gasLimit, gasConsumed int64,
+	extOption *feemarkettypes.FeeMarketExtensionOption,
+) (fee, tip, refund sdk.Coin) {
+	gcDec := math.LegacyNewDec(gasConsumed)
+
+	fee = sdk.NewCoin(providedFee.Denom, gasPrice.Amount.Mul(gcDec).Ceil().RoundInt())
+	if fee.Amount.GT(providedFee.Amount) {
+		fee = providedFee
+	}
+
+	// The priority tip per gas is what the tx offers per unit of gas on top of the
+	// gas price.
+	tipPerGas := math.LegacyNewDecFromInt(providedFee.Amount).QuoInt64(gasLimit).Sub(gasPrice.Amount)
+	if extOption != nil {
+		tipPerGas = math.LegacyMinDec(tipPerGas, extOption.MaxTipPerGas(gasPrice.Amount))
+	}
+
+	tip = sdk.NewCoin(providedFee.Denom, math.ZeroInt())
+	if tipPerGas.IsPositive() {
+		tip = sdk.NewCoin(providedFee.Denom, math.MinInt(tipPerGas.Mul(gcDec).TruncateInt(), providedFee.Amount.Sub(fee.Amount)))
+	}
+
+	refund = providedFee.Sub(fee).Sub(tip)
+
+	return fee, tip, refund
+}
+
+// SplitFees splits the fee coins provided by a tx into the fees charged for the gas
+// consumed, the priority tips and the refunds of the unused part of the escrowed fee.
+// values holds the value of each fee coin in the denom of the gas price, see
+// ante.ResolveFeeCoins.
+//
+// The fee value, gasPrice * gasConsumed, is deducted from the fee coins in their
+// sorted order. The tip value is computed as in SplitFee from the total value of the
+// fee coins and is split across the rest of the coins proportionally to their value,
+// the remainder of which is refunded.
+func SplitFees(
+	gasPrice sdk.DecCoin,
+	feeCoins sdk.Coins,
+	values []math.LegacyDec,
+	gasLimit, gasConsumed int64,
+	extOption *feemarkettypes.FeeMarketExtensionOption,
+) (fees, tips, refunds sdk.Coins) {
+	gcDec := math.LegacyNewDec(gasConsumed)
+
+	var (
+		totalValue     = math.LegacyZeroDec()
+		feeValue       = gasPrice.Amount.Mul(gcDec)
+		remainders     = make(sdk.Coins, len(feeCoins))
+		remainderValue = math.LegacyZeroDec()
+	)
+	for i, coin := range feeCoins {
+		totalValue = totalValue.Add(values[i])
+
+		fee := math.ZeroInt()
+		if feeValue.IsPositive() && values[i].IsPositive() {
+			take := math.LegacyMinDec(feeValue, values[i])
+			fee = math.MinInt(take.MulInt(coin.Amount).Quo(values[i]).Ceil().TruncateInt(), coin.Amount)
+			feeValue = feeValue.Sub(take)
+		}
+
+		fees = fees.Add(sdk.NewCoin(coin.Denom, fee))
+		remainders[i] = coin.SubAmount(fee)
+		remainderValue = remainderValue.Add(values[i].MulInt(remainders[i].Amount).QuoInt(coin.Amount))
+	}
+
+	// The priority tip per gas is what the tx offers per unit of gas on top of the
+	// gas price.
+	tipPerGas := totalValue.QuoInt64(gasLimit).Sub(gasPrice.Amount)
+	if extOption != nil {
+		tipPerGas = math.LegacyMinDec(tipPerGas, extOption.MaxTipPerGas(gasPrice.Amount))
+	}
+
+	tipValue := math.LegacyZeroDec()
+	if tipPerGas.IsPositive() && remainderValue.IsPositive() {
+		tipValue = math.LegacyMinDec(tipPerGas.Mul(gcDec), remainderValue)
+	}
+
+	for _, remainder := range remainders {
+		tip := math.ZeroInt()
+		if tipValue.IsPositive() {
+			tip = tipValue.MulInt(remainder.Amount).Quo(remainderValue).TruncateInt()
+		}
+
+		tips = tips.Add(sdk.NewCoin(remainder.Denom, tip))
+		refunds = refunds.Add(remainder.SubAmount(tip))
+	}
+
+	return fees, tips, refunds
+}
+
 // DeductCoins deducts coins from the given account.
 // Coins can be sent to the default fee collector (
 // causes coins to be distributed to stakers) or kept in the fee collector account (soft burn).
@@ -202,7 +523,7 @@ func DeductCoins(bankKeeper BankKeeper, ctx sdk.Context, coins sdk.Coins, distri
 	return nil
 }
 
-// SendTip sends a tip to the current block proposer.
+// SendTip sends a tip to the account of the current block proposer.
 func SendTip(bankKeeper BankKeeper, ctx sdk.Context, proposer sdk.AccAddress, coins sdk.Coins) error {
 	err := bankKeeper.SendCoinsFromModuleToAccount(ctx, feemarkettypes.FeeCollectorName, proposer, coins)
 	if err != nil {
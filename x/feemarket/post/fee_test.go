@@ -5,12 +5,18 @@ import (
 	"testing"
 
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	antesuite "github.com/skip-mev/feemarket/x/feemarket/ante/suite"
 	"github.com/skip-mev/feemarket/x/feemarket/post"
@@ -148,14 +154,305 @@ func TestSendTip(t *testing.T) {
 	}
 }
 
+func TestPayTip(t *testing.T) {
+	tip := sdk.NewCoins(sdk.NewCoin("test", math.NewInt(10)))
+
+	tests := []struct {
+		name            string
+		destination     string
+		unknownProposer bool
+	}{
+		{
+			name:        "tip is sent to the operator",
+			destination: types.TipDestinationOperator,
+		},
+		{
+			name:        "tip is allocated to the validator",
+			destination: types.TipDestinationDistribution,
+		},
+		{
+			name:            "tip of an unknown proposer is distributed to all stakers",
+			destination:     types.TipDestinationOperator,
+			unknownProposer: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("Case %s", tc.name), func(t *testing.T) {
+			s := antesuite.SetupTestSuite(t, false)
+
+			feeCollector := s.AccountKeeper.GetModuleAccount(s.Ctx, types.FeeCollectorName).GetAddress()
+			genesis := s.BankKeeper.ExportGenesis(s.Ctx)
+			genesis.Balances = []banktypes.Balance{{Address: feeCollector.String(), Coins: tip}}
+			s.BankKeeper.InitGenesis(s.Ctx, genesis)
+
+			if tc.unknownProposer {
+				s.Ctx = s.Ctx.WithProposer(sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address()))
+			}
+
+			dfd := post.NewFeeMarketDeductDecorator(s.AccountKeeper, s.BankKeeper, s.FeeMarketKeeper, s.StakingKeeper, s.DistrKeeper)
+			payee, err := dfd.PayTip(s.Ctx, tip, tc.destination)
+			require.NoError(t, err)
+			require.True(t, s.BankKeeper.GetAllBalances(s.Ctx, feeCollector).IsZero())
+
+			operator, err := sdk.ValAddressFromBech32(s.Proposer.GetOperator())
+			require.NoError(t, err)
+
+			switch {
+			case tc.unknownProposer:
+				authFeeCollector := s.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+				require.Equal(t, authFeeCollector.String(), payee)
+				require.Equal(t, tip, s.BankKeeper.GetAllBalances(s.Ctx, authFeeCollector))
+			case tc.destination == types.TipDestinationDistribution:
+				require.Equal(t, s.Proposer.GetOperator(), payee)
+				require.Equal(t, tip, s.BankKeeper.GetAllBalances(s.Ctx, s.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)))
+
+				rewards, err := s.DistrKeeper.GetValidatorOutstandingRewards(s.Ctx, operator)
+				require.NoError(t, err)
+				require.Equal(t, sdk.NewDecCoinsFromCoins(tip...), rewards.Rewards)
+			default:
+				require.Equal(t, sdk.AccAddress(operator).String(), payee)
+				require.Equal(t, tip, s.BankKeeper.GetAllBalances(s.Ctx, sdk.AccAddress(operator)))
+			}
+		})
+	}
+}
+
+func TestDistributeFee(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewCoin("test", math.NewInt(100)))
+
+	s := antesuite.SetupTestSuite(t, false)
+
+	feeCollector := s.AccountKeeper.GetModuleAccount(s.Ctx, types.FeeCollectorName).GetAddress()
+	genesis := s.BankKeeper.ExportGenesis(s.Ctx)
+	genesis.Balances = []banktypes.Balance{{Address: feeCollector.String(), Coins: fee}}
+	s.BankKeeper.InitGenesis(s.Ctx, genesis)
+	require.NoError(t, s.DistrKeeper.FeePool.Set(s.Ctx, distrtypes.InitialFeePool()))
+
+	params := types.DefaultParams()
+	params.BurnRatio = math.LegacyMustNewDecFromStr("0.4")
+	params.StakerRatio = math.LegacyMustNewDecFromStr("0.3")
+	params.CommunityPoolRatio = math.LegacyMustNewDecFromStr("0.2")
+	params.ExtraFeeRecipients = []types.FeeRecipient{{Module: minttypes.ModuleName, Ratio: math.LegacyMustNewDecFromStr("0.1")}}
+
+	dfd := post.NewFeeMarketDeductDecorator(s.AccountKeeper, s.BankKeeper, s.FeeMarketKeeper, s.StakingKeeper, s.DistrKeeper)
+	require.NoError(t, dfd.DistributeFee(s.Ctx, fee, params))
+	require.True(t, s.BankKeeper.GetAllBalances(s.Ctx, feeCollector).IsZero())
+
+	require.Equal(t, math.NewInt(60), s.BankKeeper.GetSupply(s.Ctx, "test").Amount)
+	require.Equal(t, math.NewInt(30), s.BankKeeper.GetBalance(s.Ctx, s.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName), "test").Amount)
+	require.Equal(t, math.NewInt(10), s.BankKeeper.GetBalance(s.Ctx, s.AccountKeeper.GetModuleAddress(minttypes.ModuleName), "test").Amount)
+
+	feePool, err := s.DistrKeeper.FeePool.Get(s.Ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinsFromCoins(sdk.NewInt64Coin("test", 20)), feePool.CommunityPool)
+
+	var destinations []string
+	for _, event := range s.Ctx.EventManager().Events() {
+		if event.Type != types.EventTypeFeeSplit {
+			continue
+		}
+
+		destination, ok := event.GetAttribute(types.AttributeKeyDestination)
+		require.True(t, ok)
+		destinations = append(destinations, destination.Value)
+	}
+	require.Equal(t, []string{
+		types.FeeSplitDestinationBurn,
+		types.FeeSplitDestinationStakers,
+		types.FeeSplitDestinationCommunityPool,
+		minttypes.ModuleName,
+	}, destinations)
+}
+
+func TestSplitFee(t *testing.T) {
+	tests := []struct {
+		name           string
+		gasPrice       sdk.DecCoin
+		providedFee    sdk.Coin
+		gasLimit       int64
+		gasConsumed    int64
+		extOption      *types.FeeMarketExtensionOption
+		expectedFee    sdk.Coin
+		expectedTip    sdk.Coin
+		expectedRefund sdk.Coin
+	}{
+		{
+			name:           "all gas consumed, no tip",
+			gasPrice:       sdk.NewDecCoin("test", math.NewInt(2)),
+			providedFee:    sdk.NewInt64Coin("test", 200),
+			gasLimit:       100,
+			gasConsumed:    100,
+			expectedFee:    sdk.NewInt64Coin("test", 200),
+			expectedTip:    sdk.NewInt64Coin("test", 0),
+			expectedRefund: sdk.NewInt64Coin("test", 0),
+		},
+		{
+			name:           "unused gas is refunded",
+			gasPrice:       sdk.NewDecCoin("test", math.NewInt(2)),
+			providedFee:    sdk.NewInt64Coin("test", 200),
+			gasLimit:       100,
+			gasConsumed:    40,
+			expectedFee:    sdk.NewInt64Coin("test", 80),
+			expectedTip:    sdk.NewInt64Coin("test", 0),
+			expectedRefund: sdk.NewInt64Coin("test", 120),
+		},
+		{
+			name:           "tip is paid for the gas consumed",
+			gasPrice:       sdk.NewDecCoin("test", math.NewInt(2)),
+			providedFee:    sdk.NewInt64Coin("test", 300),
+			gasLimit:       100,
+			gasConsumed:    40,
+			expectedFee:    sdk.NewInt64Coin("test", 80),
+			expectedTip:    sdk.NewInt64Coin("test", 40),
+			expectedRefund: sdk.NewInt64Coin("test", 180),
+		},
+		{
+			name:           "fee is rounded up and tip is rounded down",
+			gasPrice:       sdk.NewDecCoinFromDec("test", math.LegacyMustNewDecFromStr("1.5")),
+			providedFee:    sdk.NewInt64Coin("test", 175),
+			gasLimit:       100,
+			gasConsumed:    51,
+			expectedFee:    sdk.NewInt64Coin("test", 77),
+			expectedTip:    sdk.NewInt64Coin("test", 12),
+			expectedRefund: sdk.NewInt64Coin("test", 86),
+		},
+		{
+			name:        "tip is capped by the max priority fee per gas",
+			gasPrice:    sdk.NewDecCoin("test", math.NewInt(2)),
+			providedFee: sdk.NewInt64Coin("test", 500),
+			gasLimit:    100,
+			gasConsumed: 40,
+			extOption: &types.FeeMarketExtensionOption{
+				MaxFeePerGas:         math.LegacyNewDec(5),
+				MaxPriorityFeePerGas: math.LegacyNewDec(1),
+			},
+			expectedFee:    sdk.NewInt64Coin("test", 80),
+			expectedTip:    sdk.NewInt64Coin("test", 40),
+			expectedRefund: sdk.NewInt64Coin("test", 380),
+		},
+		{
+			name:        "tip is capped by the max fee per gas",
+			gasPrice:    sdk.NewDecCoin("test", math.NewInt(2)),
+			providedFee: sdk.NewInt64Coin("test", 500),
+			gasLimit:    100,
+			gasConsumed: 40,
+			extOption: &types.FeeMarketExtensionOption{
+				MaxFeePerGas:         math.LegacyMustNewDecFromStr("2.5"),
+				MaxPriorityFeePerGas: math.LegacyNewDec(1),
+			},
+			expectedFee:    sdk.NewInt64Coin("test", 80),
+			expectedTip:    sdk.NewInt64Coin("test", 20),
+			expectedRefund: sdk.NewInt64Coin("test", 400),
+		},
+		{
+			name:        "extension option does not raise the tip",
+			gasPrice:    sdk.NewDecCoin("test", math.NewInt(2)),
+			providedFee: sdk.NewInt64Coin("test", 300),
+			gasLimit:    100,
+			gasConsumed: 40,
+			extOption: &types.FeeMarketExtensionOption{
+				MaxFeePerGas:         math.LegacyNewDec(10),
+				MaxPriorityFeePerGas: math.LegacyNewDec(5),
+			},
+			expectedFee:    sdk.NewInt64Coin("test", 80),
+			expectedTip:    sdk.NewInt64Coin("test", 40),
+			expectedRefund: sdk.NewInt64Coin("test", 180),
+		},
+		{
+			name:           "zero gas price",
+			gasPrice:       sdk.NewDecCoin("test", math.ZeroInt()),
+			providedFee:    sdk.NewInt64Coin("test", 100),
+			gasLimit:       100,
+			gasConsumed:    50,
+			expectedFee:    sdk.NewInt64Coin("test", 0),
+			expectedTip:    sdk.NewInt64Coin("test", 50),
+			expectedRefund: sdk.NewInt64Coin("test", 50),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("Case %s", tc.name), func(t *testing.T) {
+			fee, tip, refund := post.SplitFee(tc.gasPrice, tc.providedFee, tc.gasLimit, tc.gasConsumed, tc.extOption)
+			require.Equal(t, tc.expectedFee, fee)
+			require.Equal(t, tc.expectedTip, tip)
+			require.Equal(t, tc.expectedRefund, refund)
+			require.Equal(t, tc.providedFee, fee.Add(tip).Add(refund))
+		})
+	}
+}
+
+func TestSplitFees(t *testing.T) {
+	tests := []struct {
+		name            string
+		gasPrice        sdk.DecCoin
+		feeCoins        sdk.Coins
+		values          []math.LegacyDec
+		gasLimit        int64
+		gasConsumed     int64
+		extOption       *types.FeeMarketExtensionOption
+		expectedFees    sdk.Coins
+		expectedTips    sdk.Coins
+		expectedRefunds sdk.Coins
+	}{
+		{
+			name:            "fee is deducted in sorted order",
+			gasPrice:        sdk.NewDecCoin("test", math.NewInt(2)),
+			feeCoins:        sdk.NewCoins(sdk.NewInt64Coin("atest", 100), sdk.NewInt64Coin("btest", 100)),
+			values:          []math.LegacyDec{math.LegacyNewDec(100), math.LegacyNewDec(200)},
+			gasLimit:        100,
+			gasConsumed:     40,
+			expectedFees:    sdk.NewCoins(sdk.NewInt64Coin("atest", 80)),
+			expectedTips:    sdk.NewCoins(sdk.NewInt64Coin("atest", 3), sdk.NewInt64Coin("btest", 18)),
+			expectedRefunds: sdk.NewCoins(sdk.NewInt64Coin("atest", 17), sdk.NewInt64Coin("btest", 82)),
+		},
+		{
+			name:            "fee spans multiple coins",
+			gasPrice:        sdk.NewDecCoin("test", math.NewInt(2)),
+			feeCoins:        sdk.NewCoins(sdk.NewInt64Coin("atest", 50), sdk.NewInt64Coin("btest", 100)),
+			values:          []math.LegacyDec{math.LegacyNewDec(50), math.LegacyNewDec(200)},
+			gasLimit:        100,
+			gasConsumed:     100,
+			expectedFees:    sdk.NewCoins(sdk.NewInt64Coin("atest", 50), sdk.NewInt64Coin("btest", 75)),
+			expectedTips:    sdk.NewCoins(sdk.NewInt64Coin("btest", 25)),
+			expectedRefunds: sdk.NewCoins(),
+		},
+		{
+			name:        "tip is capped by the max priority fee per gas",
+			gasPrice:    sdk.NewDecCoin("test", math.NewInt(2)),
+			feeCoins:    sdk.NewCoins(sdk.NewInt64Coin("atest", 100), sdk.NewInt64Coin("btest", 100)),
+			values:      []math.LegacyDec{math.LegacyNewDec(100), math.LegacyNewDec(200)},
+			gasLimit:    100,
+			gasConsumed: 40,
+			extOption: &types.FeeMarketExtensionOption{
+				MaxFeePerGas:         math.LegacyNewDec(5),
+				MaxPriorityFeePerGas: math.LegacyMustNewDecFromStr("0.5"),
+			},
+			expectedFees:    sdk.NewCoins(sdk.NewInt64Coin("atest", 80)),
+			expectedTips:    sdk.NewCoins(sdk.NewInt64Coin("atest", 1), sdk.NewInt64Coin("btest", 9)),
+			expectedRefunds: sdk.NewCoins(sdk.NewInt64Coin("atest", 19), sdk.NewInt64Coin("btest", 91)),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("Case %s", tc.name), func(t *testing.T) {
+			fees, tips, refunds := post.SplitFees(tc.gasPrice, tc.feeCoins, tc.values, tc.gasLimit, tc.gasConsumed, tc.extOption)
+			require.True(t, tc.expectedFees.Equal(fees), "expected fees %s, got %s", tc.expectedFees, fees)
+			require.True(t, tc.expectedTips.Equal(tips), "expected tips %s, got %s", tc.expectedTips, tips)
+			require.True(t, tc.expectedRefunds.Equal(refunds), "expected refunds %s, got %s", tc.expectedRefunds, refunds)
+			require.True(t, tc.feeCoins.Equal(fees.Add(tips...).Add(refunds...)))
+		})
+	}
+}
+
 func TestPostHandleMock(t *testing.T) {
 	// Same data for every test case
 	const (
 		baseDenom              = "stake"
 		resolvableDenom        = "atom"
-		expectedConsumedGas    = 10631
-		expectedConsumedSimGas = expectedConsumedGas + post.BankSendGasConsumption
+		expectedConsumedGas    = 11180
+		expectedConsumedSimGas = expectedConsumedGas + post.BankSendGasConsumption + post.RefundGasConsumption + post.TipGasConsumption
 		gasLimit               = expectedConsumedSimGas
+
+		// extra gas consumed to resolve the proposer the tip is paid to
+		expectedConsumedGasWithTip = 13831
 	)
 
 	validFeeAmount := types.DefaultMinBaseGasPrice.MulInt64(int64(gasLimit))
@@ -230,7 +527,6 @@ func TestPostHandleMock(t *testing.T) {
 				accs := s.CreateTestAccounts(1)
 				s.MockBankKeeper.On("SendCoinsFromAccountToModule", mock.Anything, accs[0].Account.GetAddress(),
 					types.FeeCollectorName, mock.Anything).Return(nil).Once()
-				s.MockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, types.FeeCollectorName, mock.Anything, mock.Anything).Return(nil).Once()
 
 				return antesuite.TestCaseArgs{
 					Msgs:      []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
@@ -274,7 +570,7 @@ func TestPostHandleMock(t *testing.T) {
 				accs := s.CreateTestAccounts(1)
 				s.MockBankKeeper.On("SendCoinsFromAccountToModule", mock.Anything, accs[0].Account.GetAddress(),
 					types.FeeCollectorName, mock.Anything).Return(nil).Once()
-				s.MockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, types.FeeCollectorName, mock.Anything, mock.Anything).Return(nil).Once()
+				s.MockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, types.FeeCollectorName, mock.Anything, mock.Anything).Return(nil).Twice()
 
 				return antesuite.TestCaseArgs{
 					Msgs:      []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
@@ -287,7 +583,7 @@ func TestPostHandleMock(t *testing.T) {
 			Simulate:          false,
 			ExpPass:           true,
 			ExpErr:            nil,
-			ExpectConsumedGas: expectedConsumedGas,
+			ExpectConsumedGas: expectedConsumedGasWithTip,
 			Mock:              true,
 		},
 		{
@@ -296,7 +592,6 @@ func TestPostHandleMock(t *testing.T) {
 				accs := s.CreateTestAccounts(1)
 				s.MockBankKeeper.On("SendCoinsFromAccountToModule", mock.Anything, accs[0].Account.GetAddress(),
 					types.FeeCollectorName, mock.Anything).Return(nil).Once()
-				s.MockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, types.FeeCollectorName, mock.Anything, mock.Anything).Return(nil).Once()
 
 				return antesuite.TestCaseArgs{
 					Msgs:      []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
@@ -353,7 +648,7 @@ func TestPostHandleMock(t *testing.T) {
 			Simulate:          false,
 			ExpPass:           true,
 			ExpErr:            nil,
-			ExpectConsumedGas: 15340, // extra gas consumed because msg server is run, but deduction is skipped
+			ExpectConsumedGas: 18815, // extra gas consumed because msg server is run, but deduction is skipped
 			Mock:              true,
 		},
 		{
@@ -385,7 +680,6 @@ func TestPostHandleMock(t *testing.T) {
 				accs := s.CreateTestAccounts(1)
 				s.MockBankKeeper.On("SendCoinsFromAccountToModule", mock.Anything, accs[0].Account.GetAddress(),
 					types.FeeCollectorName, mock.Anything).Return(nil).Once()
-				s.MockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, types.FeeCollectorName, mock.Anything, mock.Anything).Return(nil).Once()
 
 				return antesuite.TestCaseArgs{
 					Msgs:      []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
@@ -407,7 +701,7 @@ func TestPostHandleMock(t *testing.T) {
 				accs := s.CreateTestAccounts(1)
 				s.MockBankKeeper.On("SendCoinsFromAccountToModule", mock.Anything, accs[0].Account.GetAddress(),
 					types.FeeCollectorName, mock.Anything).Return(nil).Once()
-				s.MockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, types.FeeCollectorName, mock.Anything, mock.Anything).Return(nil).Once()
+				s.MockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, types.FeeCollectorName, mock.Anything, mock.Anything).Return(nil).Twice()
 
 				return antesuite.TestCaseArgs{
 					Msgs:      []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
@@ -420,7 +714,7 @@ func TestPostHandleMock(t *testing.T) {
 			Simulate:          false,
 			ExpPass:           true,
 			ExpErr:            nil,
-			ExpectConsumedGas: expectedConsumedGas,
+			ExpectConsumedGas: expectedConsumedGasWithTip,
 			Mock:              true,
 		},
 		{
@@ -429,7 +723,6 @@ func TestPostHandleMock(t *testing.T) {
 				accs := s.CreateTestAccounts(1)
 				s.MockBankKeeper.On("SendCoinsFromAccountToModule", mock.Anything, accs[0].Account.GetAddress(),
 					types.FeeCollectorName, mock.Anything).Return(nil).Once()
-				s.MockBankKeeper.On("SendCoinsFromModuleToAccount", mock.Anything, types.FeeCollectorName, mock.Anything, mock.Anything).Return(nil).Once()
 
 				return antesuite.TestCaseArgs{
 					Msgs:      []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
@@ -539,9 +832,19 @@ func TestPostHandle(t *testing.T) {
 	const (
 		baseDenom           = "stake"
 		resolvableDenom     = "atom"
-		expectedConsumedGas = 36650
+		expectedConsumedGas = 11180 + post.BankSendGasConsumption + post.RefundGasConsumption + post.TipGasConsumption
+
+		// gas consumed when the unused fee is refunded, and when a tip is additionally
+		// paid to the proposer
+		expectedConsumedGasRefund        = 27359
+		expectedConsumedGasRefundWithTip = 59899
 
-		expectedConsumedGasResolve = 36524 // slight difference due to denom resolver
+		// slight difference due to denom resolver
+		expectedConsumedGasResolveRefund        = 27233
+		expectedConsumedGasResolveRefundWithTip = 59647
+
+		// gas consumed when the fee is paid with multiple denoms
+		expectedConsumedGasMultipleDenomsWithTip = 83245
 
 		gasLimit = 100000
 	)
@@ -552,6 +855,10 @@ func TestPostHandle(t *testing.T) {
 	validFeeWithTip := sdk.NewCoins(sdk.NewCoin(baseDenom, validFeeAmountWithTip.TruncateInt()))
 	validResolvableFee := sdk.NewCoins(sdk.NewCoin(resolvableDenom, validFeeAmount.TruncateInt()))
 	validResolvableFeeWithTip := sdk.NewCoins(sdk.NewCoin(resolvableDenom, validFeeAmountWithTip.TruncateInt()))
+	validMultipleDenomsFeeWithTip := sdk.NewCoins(
+		sdk.NewCoin(baseDenom, validFeeAmountWithTip.QuoInt64(2).TruncateInt()),
+		sdk.NewCoin(resolvableDenom, validFeeAmountWithTip.QuoInt64(2).Ceil().TruncateInt()),
+	)
 
 	testCases := []antesuite.TestCase{
 		{
@@ -650,7 +957,7 @@ func TestPostHandle(t *testing.T) {
 			Simulate:          false,
 			ExpPass:           true,
 			ExpErr:            nil,
-			ExpectConsumedGas: 36650,
+			ExpectConsumedGas: expectedConsumedGasRefund,
 			Mock:              false,
 		},
 		{
@@ -699,7 +1006,36 @@ func TestPostHandle(t *testing.T) {
 			Simulate:          false,
 			ExpPass:           true,
 			ExpErr:            nil,
-			ExpectConsumedGas: 36650,
+			ExpectConsumedGas: expectedConsumedGasRefundWithTip,
+			Mock:              false,
+		},
+		{
+			Name: "signer has enough funds, should pass with tip capped by extension option",
+			Malleate: func(s *antesuite.TestSuite) antesuite.TestCaseArgs {
+				accs := s.CreateTestAccounts(1)
+
+				balance := antesuite.TestAccountBalance{
+					TestAccount: accs[0],
+					Coins:       validFeeWithTip,
+				}
+				s.SetAccountBalances([]antesuite.TestAccountBalance{balance})
+
+				return antesuite.TestCaseArgs{
+					Msgs:      []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
+					GasLimit:  gasLimit,
+					FeeAmount: validFeeWithTip,
+					ExtensionOptions: antesuite.NewTestExtensionOption(
+						types.DefaultMinBaseGasPrice.MulInt64(2),
+						math.LegacyZeroDec(),
+					),
+				}
+			},
+			RunAnte:           true,
+			RunPost:           true,
+			Simulate:          false,
+			ExpPass:           true,
+			ExpErr:            nil,
+			ExpectConsumedGas: expectedConsumedGasRefund, // no tip is paid to the proposer
 			Mock:              false,
 		},
 		{
@@ -765,7 +1101,7 @@ func TestPostHandle(t *testing.T) {
 			Simulate:          false,
 			ExpPass:           true,
 			ExpErr:            nil,
-			ExpectConsumedGas: 15340, // extra gas consumed because msg server is run, but bank keepers are skipped
+			ExpectConsumedGas: 18815, // extra gas consumed because msg server is run, but bank keepers are skipped
 			Mock:              false,
 		},
 		{
@@ -790,7 +1126,7 @@ func TestPostHandle(t *testing.T) {
 			Simulate:          false,
 			ExpPass:           true,
 			ExpErr:            nil,
-			ExpectConsumedGas: expectedConsumedGasResolve,
+			ExpectConsumedGas: expectedConsumedGasResolveRefund,
 			Mock:              false,
 		},
 		{
@@ -883,7 +1219,32 @@ func TestPostHandle(t *testing.T) {
 			Simulate:          false,
 			ExpPass:           true,
 			ExpErr:            nil,
-			ExpectConsumedGas: expectedConsumedGasResolve,
+			ExpectConsumedGas: expectedConsumedGasResolveRefundWithTip,
+			Mock:              false,
+		},
+		{
+			Name: "signer has enough funds, should pass with tip - multiple denoms",
+			Malleate: func(s *antesuite.TestSuite) antesuite.TestCaseArgs {
+				accs := s.CreateTestAccounts(1)
+
+				balance := antesuite.TestAccountBalance{
+					TestAccount: accs[0],
+					Coins:       validMultipleDenomsFeeWithTip,
+				}
+				s.SetAccountBalances([]antesuite.TestAccountBalance{balance})
+
+				return antesuite.TestCaseArgs{
+					Msgs:      []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
+					GasLimit:  gasLimit,
+					FeeAmount: validMultipleDenomsFeeWithTip,
+				}
+			},
+			RunAnte:           true,
+			RunPost:           true,
+			Simulate:          false,
+			ExpPass:           true,
+			ExpErr:            nil,
+			ExpectConsumedGas: expectedConsumedGasMultipleDenomsWithTip,
 			Mock:              false,
 		},
 		{
@@ -991,3 +1352,187 @@ func TestPostHandle(t *testing.T) {
 		})
 	}
 }
+
+func TestPostHandleEnableHeight(t *testing.T) {
+	const (
+		baseDenom = "stake"
+		gasLimit  = 100000
+
+		// gas consumed by the tx enabling the fee market, when the escrowed fee is refunded,
+		// when the fee market charges the fee and by the tx disabling the fee market
+		expectedConsumedGasEnable       = 17788
+		expectedConsumedGasRefundEscrow = 14985
+		expectedConsumedGas             = 27407
+		expectedConsumedGasDisable      = 30688
+	)
+
+	fee := sdk.NewCoins(sdk.NewCoin(baseDenom, types.DefaultMinBaseGasPrice.MulInt64(gasLimit).TruncateInt()))
+
+	s := antesuite.SetupTestSuite(t, false)
+	s.TxBuilder = s.ClientCtx.TxConfig.NewTxBuilder()
+
+	accs := s.CreateTestAccounts(2)
+	s.SetAccountBalances([]antesuite.TestAccountBalance{
+		{TestAccount: accs[0], Coins: fee},
+		{TestAccount: accs[1], Coins: fee.MulInt(math.NewInt(2))},
+	})
+
+	// the fee market is disabled until it is enabled mid-chain through MsgParams
+	s.Ctx = s.Ctx.WithBlockHeight(10)
+	disabledParams := types.DefaultParams()
+	disabledParams.Enabled = false
+	require.NoError(t, s.FeeMarketKeeper.SetParams(s.Ctx, disabledParams))
+
+	feeCollector := s.AccountKeeper.GetModuleAddress(types.FeeCollectorName)
+	authFeeCollector := s.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	totalBalance := func() sdk.Coins {
+		var total sdk.Coins
+		for _, addr := range []sdk.AccAddress{accs[0].Account.GetAddress(), accs[1].Account.GetAddress(), feeCollector, authFeeCollector} {
+			total = total.Add(s.BankKeeper.GetAllBalances(s.Ctx, addr)...)
+		}
+		return total
+	}
+	expectedTotal := totalBalance()
+
+	args := func(acc antesuite.TestAccount) antesuite.TestCaseArgs {
+		return antesuite.TestCaseArgs{
+			Msgs:      []sdk.Msg{testdata.NewTestMsg(acc.Account.GetAddress())},
+			GasLimit:  gasLimit,
+			FeeAmount: fee,
+		}
+	}
+
+	// the tx enabling the fee market pays its fee through the fallback decorator and
+	// nothing is escrowed
+	s.RunTestCase(t, antesuite.TestCase{
+		StateUpdate: func(s *antesuite.TestSuite) {
+			req := &types.MsgParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    types.DefaultParams(),
+			}
+
+			_, err := s.MsgServer.Params(s.Ctx, req)
+			s.Require().NoError(err)
+		},
+		RunAnte:           true,
+		RunPost:           true,
+		ExpPass:           true,
+		ExpectConsumedGas: expectedConsumedGasEnable,
+	}, args(accs[0]))
+	require.True(t, s.BankKeeper.GetAllBalances(s.Ctx, accs[0].Account.GetAddress()).IsZero())
+	require.True(t, s.BankKeeper.GetAllBalances(s.Ctx, feeCollector).IsZero())
+
+	// a following tx in the enabling block escrows its fee, which is refunded
+	s.RunTestCase(t, antesuite.TestCase{
+		RunAnte:           true,
+		RunPost:           true,
+		ExpPass:           true,
+		ExpectConsumedGas: expectedConsumedGasRefundEscrow,
+	}, args(accs[1]))
+	require.Equal(t, fee.MulInt(math.NewInt(2)), s.BankKeeper.GetAllBalances(s.Ctx, accs[1].Account.GetAddress()))
+	require.True(t, s.BankKeeper.GetAllBalances(s.Ctx, feeCollector).IsZero())
+	require.Equal(t, expectedTotal, totalBalance())
+
+	// from the next block on, the fee market charges the fee
+	s.Ctx = s.Ctx.WithBlockHeight(11)
+	s.RunTestCase(t, antesuite.TestCase{
+		RunAnte:           true,
+		RunPost:           true,
+		ExpPass:           true,
+		ExpectConsumedGas: expectedConsumedGas,
+	}, args(accs[1]))
+	charged := fee.MulInt(math.NewInt(2)).Sub(s.BankKeeper.GetAllBalances(s.Ctx, accs[1].Account.GetAddress())...)
+	require.True(t, charged.IsAllPositive())
+	require.Equal(t, charged, s.BankKeeper.GetAllBalances(s.Ctx, feeCollector))
+	require.Equal(t, expectedTotal, totalBalance())
+
+	// a tx disabling the fee market gets its escrowed fee refunded
+	s.Ctx = s.Ctx.WithBlockHeight(12)
+	balance := s.BankKeeper.GetAllBalances(s.Ctx, accs[1].Account.GetAddress())
+	s.RunTestCase(t, antesuite.TestCase{
+		StateUpdate: func(s *antesuite.TestSuite) {
+			req := &types.MsgParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    disabledParams,
+			}
+
+			_, err := s.MsgServer.Params(s.Ctx, req)
+			s.Require().NoError(err)
+		},
+		RunAnte:           true,
+		RunPost:           true,
+		ExpPass:           true,
+		ExpectConsumedGas: expectedConsumedGasDisable,
+	}, args(accs[1]))
+	require.Equal(t, balance, s.BankKeeper.GetAllBalances(s.Ctx, accs[1].Account.GetAddress()))
+	require.Equal(t, charged, s.BankKeeper.GetAllBalances(s.Ctx, feeCollector))
+	require.Equal(t, expectedTotal, totalBalance())
+}
+
+func TestPostHandleSimulateGas(t *testing.T) {
+	const gasLimit = 100000
+
+	feeAmount := types.DefaultMinBaseGasPrice.MulInt64(gasLimit).Add(math.LegacyNewDec(100)).TruncateInt()
+
+	tests := []struct {
+		name        string
+		destination string
+		fee         sdk.Coins
+	}{
+		{
+			name:        "tip is sent to the operator",
+			destination: types.TipDestinationOperator,
+			fee:         sdk.NewCoins(sdk.NewCoin("stake", feeAmount)),
+		},
+		{
+			name:        "tip is allocated to the validator",
+			destination: types.TipDestinationDistribution,
+			fee:         sdk.NewCoins(sdk.NewCoin("stake", feeAmount)),
+		},
+		{
+			name:        "fee is paid in a resolvable denom",
+			destination: types.TipDestinationOperator,
+			fee:         sdk.NewCoins(sdk.NewCoin("atom", feeAmount)),
+		},
+	}
+
+	// consumedGas runs the tx through the ante and post handlers of a new chain and
+	// returns the gas consumed.
+	consumedGas := func(t *testing.T, destination string, fee sdk.Coins, simulate bool) uint64 {
+		s := antesuite.SetupTestSuite(t, false)
+
+		params, err := s.FeeMarketKeeper.GetParams(s.Ctx)
+		require.NoError(t, err)
+		params.TipDestination = destination
+		params.HistoryRetention = 10
+		require.NoError(t, s.FeeMarketKeeper.SetParams(s.Ctx, params))
+
+		accs := s.CreateTestAccounts(1)
+		s.SetAccountBalances([]antesuite.TestAccountBalance{{TestAccount: accs[0], Coins: fee}})
+
+		s.TxBuilder = s.ClientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, s.TxBuilder.SetMsgs(testdata.NewTestMsg(accs[0].Account.GetAddress())))
+		s.TxBuilder.SetFeeAmount(fee)
+		s.TxBuilder.SetGasLimit(gasLimit)
+
+		tx, err := s.CreateTestTx(nil, nil, nil, "")
+		require.NoError(t, err)
+
+		ctx := s.Ctx.WithGasMeter(storetypes.NewGasMeter(antesuite.NewTestGasLimit()))
+		newCtx, err := s.AnteHandler(ctx, tx, simulate)
+		require.NoError(t, err)
+
+		newCtx, err = s.PostHandler(ctx.WithContext(newCtx.Context()), tx, simulate, true)
+		require.NoError(t, err)
+
+		return newCtx.GasMeter().GasConsumed()
+	}
+
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("Case %s", tc.name), func(t *testing.T) {
+			simulated := consumedGas(t, tc.destination, tc.fee, true)
+			delivered := consumedGas(t, tc.destination, tc.fee, false)
+			require.GreaterOrEqual(t, simulated, delivered)
+		})
+	}
+}
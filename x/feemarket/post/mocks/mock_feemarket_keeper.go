@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	context "context"
+
 	mock "github.com/stretchr/testify/mock"
 
 	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
@@ -16,7 +18,7 @@ type FeeMarketKeeper struct {
 }
 
 // GetEnabledHeight provides a mock function with given fields: ctx
-func (_m *FeeMarketKeeper) GetEnabledHeight(ctx types.Context) (int64, error) {
+func (_m *FeeMarketKeeper) GetEnabledHeight(ctx context.Context) (int64, error) {
 	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
@@ -25,16 +27,16 @@ func (_m *FeeMarketKeeper) GetEnabledHeight(ctx types.Context) (int64, error) {
 
 	var r0 int64
 	var r1 error
-	if rf, ok := ret.Get(0).(func(types.Context) (int64, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
 		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(types.Context) int64); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
 		r0 = rf(ctx)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
-	if rf, ok := ret.Get(1).(func(types.Context) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
 		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
@@ -44,7 +46,7 @@ func (_m *FeeMarketKeeper) GetEnabledHeight(ctx types.Context) (int64, error) {
 }
 
 // GetMinGasPrice provides a mock function with given fields: ctx, denom
-func (_m *FeeMarketKeeper) GetMinGasPrice(ctx types.Context, denom string) (types.DecCoin, error) {
+func (_m *FeeMarketKeeper) GetMinGasPrice(ctx context.Context, denom string) (types.DecCoin, error) {
 	ret := _m.Called(ctx, denom)
 
 	if len(ret) == 0 {
@@ -53,16 +55,16 @@ func (_m *FeeMarketKeeper) GetMinGasPrice(ctx types.Context, denom string) (type
 
 	var r0 types.DecCoin
 	var r1 error
-	if rf, ok := ret.Get(0).(func(types.Context, string) (types.DecCoin, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) (types.DecCoin, error)); ok {
 		return rf(ctx, denom)
 	}
-	if rf, ok := ret.Get(0).(func(types.Context, string) types.DecCoin); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, string) types.DecCoin); ok {
 		r0 = rf(ctx, denom)
 	} else {
 		r0 = ret.Get(0).(types.DecCoin)
 	}
 
-	if rf, ok := ret.Get(1).(func(types.Context, string) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
 		r1 = rf(ctx, denom)
 	} else {
 		r1 = ret.Error(1)
@@ -72,7 +74,7 @@ func (_m *FeeMarketKeeper) GetMinGasPrice(ctx types.Context, denom string) (type
 }
 
 // GetParams provides a mock function with given fields: ctx
-func (_m *FeeMarketKeeper) GetParams(ctx types.Context) (feemarkettypes.Params, error) {
+func (_m *FeeMarketKeeper) GetParams(ctx context.Context) (feemarkettypes.Params, error) {
 	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
@@ -81,16 +83,16 @@ func (_m *FeeMarketKeeper) GetParams(ctx types.Context) (feemarkettypes.Params,
 
 	var r0 feemarkettypes.Params
 	var r1 error
-	if rf, ok := ret.Get(0).(func(types.Context) (feemarkettypes.Params, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) (feemarkettypes.Params, error)); ok {
 		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(types.Context) feemarkettypes.Params); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) feemarkettypes.Params); ok {
 		r0 = rf(ctx)
 	} else {
 		r0 = ret.Get(0).(feemarkettypes.Params)
 	}
 
-	if rf, ok := ret.Get(1).(func(types.Context) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
 		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
@@ -100,7 +102,7 @@ func (_m *FeeMarketKeeper) GetParams(ctx types.Context) (feemarkettypes.Params,
 }
 
 // GetState provides a mock function with given fields: ctx
-func (_m *FeeMarketKeeper) GetState(ctx types.Context) (feemarkettypes.State, error) {
+func (_m *FeeMarketKeeper) GetState(ctx context.Context) (feemarkettypes.State, error) {
 	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
@@ -109,16 +111,16 @@ func (_m *FeeMarketKeeper) GetState(ctx types.Context) (feemarkettypes.State, er
 
 	var r0 feemarkettypes.State
 	var r1 error
-	if rf, ok := ret.Get(0).(func(types.Context) (feemarkettypes.State, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) (feemarkettypes.State, error)); ok {
 		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(types.Context) feemarkettypes.State); ok {
+	if rf, ok := ret.Get(0).(func(context.Context) feemarkettypes.State); ok {
 		r0 = rf(ctx)
 	} else {
 		r0 = ret.Get(0).(feemarkettypes.State)
 	}
 
-	if rf, ok := ret.Get(1).(func(types.Context) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
 		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
@@ -127,8 +129,26 @@ func (_m *FeeMarketKeeper) GetState(ctx types.Context) (feemarkettypes.State, er
 	return r0, r1
 }
 
+// RecordTip provides a mock function with given fields: ctx, tips, gasUsed
+func (_m *FeeMarketKeeper) RecordTip(ctx context.Context, tips types.Coins, gasUsed uint64) error {
+	ret := _m.Called(ctx, tips, gasUsed)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordTip")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.Coins, uint64) error); ok {
+		r0 = rf(ctx, tips, gasUsed)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ResolveToDenom provides a mock function with given fields: ctx, coin, denom
-func (_m *FeeMarketKeeper) ResolveToDenom(ctx types.Context, coin types.DecCoin, denom string) (types.DecCoin, error) {
+func (_m *FeeMarketKeeper) ResolveToDenom(ctx context.Context, coin types.DecCoin, denom string) (types.DecCoin, error) {
 	ret := _m.Called(ctx, coin, denom)
 
 	if len(ret) == 0 {
@@ -137,16 +157,16 @@ func (_m *FeeMarketKeeper) ResolveToDenom(ctx types.Context, coin types.DecCoin,
 
 	var r0 types.DecCoin
 	var r1 error
-	if rf, ok := ret.Get(0).(func(types.Context, types.DecCoin, string) (types.DecCoin, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, types.DecCoin, string) (types.DecCoin, error)); ok {
 		return rf(ctx, coin, denom)
 	}
-	if rf, ok := ret.Get(0).(func(types.Context, types.DecCoin, string) types.DecCoin); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, types.DecCoin, string) types.DecCoin); ok {
 		r0 = rf(ctx, coin, denom)
 	} else {
 		r0 = ret.Get(0).(types.DecCoin)
 	}
 
-	if rf, ok := ret.Get(1).(func(types.Context, types.DecCoin, string) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, types.DecCoin, string) error); ok {
 		r1 = rf(ctx, coin, denom)
 	} else {
 		r1 = ret.Error(1)
@@ -156,7 +176,7 @@ func (_m *FeeMarketKeeper) ResolveToDenom(ctx types.Context, coin types.DecCoin,
 }
 
 // SetParams provides a mock function with given fields: ctx, params
-func (_m *FeeMarketKeeper) SetParams(ctx types.Context, params feemarkettypes.Params) error {
+func (_m *FeeMarketKeeper) SetParams(ctx context.Context, params feemarkettypes.Params) error {
 	ret := _m.Called(ctx, params)
 
 	if len(ret) == 0 {
@@ -164,7 +184,7 @@ func (_m *FeeMarketKeeper) SetParams(ctx types.Context, params feemarkettypes.Pa
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(types.Context, feemarkettypes.Params) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, feemarkettypes.Params) error); ok {
 		r0 = rf(ctx, params)
 	} else {
 		r0 = ret.Error(0)
@@ -174,7 +194,7 @@ func (_m *FeeMarketKeeper) SetParams(ctx types.Context, params feemarkettypes.Pa
 }
 
 // SetState provides a mock function with given fields: ctx, state
-func (_m *FeeMarketKeeper) SetState(ctx types.Context, state feemarkettypes.State) error {
+func (_m *FeeMarketKeeper) SetState(ctx context.Context, state feemarkettypes.State) error {
 	ret := _m.Called(ctx, state)
 
 	if len(ret) == 0 {
@@ -182,7 +202,7 @@ func (_m *FeeMarketKeeper) SetState(ctx types.Context, state feemarkettypes.Stat
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(types.Context, feemarkettypes.State) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, feemarkettypes.State) error); ok {
 		r0 = rf(ctx, state)
 	} else {
 		r0 = ret.Error(0)
@@ -0,0 +1,141 @@
+package types
+
+import (
+	"context"
+	"sync"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CachedDenomResolver is a DenomResolver that caches its results until the cache is
+// reset. The keeper resets the cache of its resolver at the end of every block.
+type CachedDenomResolver interface {
+	DenomResolver
+	// ResetCache drops all cached results.
+	ResetCache()
+}
+
+// cacheReferenceAmount is the amount of a denom that the CachingDenomResolver converts
+// with the wrapped resolver. Converting a whole LegacyDec unit of precision keeps the
+// precision of the cached rates of denoms with many decimals.
+var cacheReferenceAmount = math.LegacyNewDecFromInt(math.NewIntWithDecimal(1, math.LegacyPrecision))
+
+// cacheScope is the block and execution mode that a cached result is valid for. Results
+// are never shared across execution modes, so that conversions in FinalizeBlock, and the
+// gas they consume, do not depend on whether a node has run CheckTx or ProcessProposal.
+// Results are not shared across blocks of the same height either, so that a FinalizeBlock
+// run of a proposal that is aborted by optimistic execution does not leak into the run of
+// the block that is actually finalized.
+type cacheScope struct {
+	height     int64
+	headerHash string
+	mode       sdk.ExecMode
+}
+
+type conversionKey struct {
+	scope     cacheScope
+	fromDenom string
+	toDenom   string
+}
+
+type cachedConversion struct {
+	value math.LegacyDec
+	err   error
+}
+
+type cachedExtraDenoms struct {
+	denoms []string
+	err    error
+}
+
+var _ CachedDenomResolver = (*CachingDenomResolver)(nil)
+
+// CachingDenomResolver is a DenomResolver decorator that converts each pair of denoms with
+// the wrapped resolver at most once per block and execution mode, so that expensive
+// resolvers, such as the OracleDenomResolver or the TwapDenomResolver, run once per block
+// instead of once per transaction. The wrapped resolver must convert amounts linearly. Both
+// conversions and failures are cached, and they do not reflect state changes made later in
+// the same block.
+type CachingDenomResolver struct {
+	resolver DenomResolver
+
+	mu          sync.Mutex
+	conversions map[conversionKey]cachedConversion
+	extraDenoms map[cacheScope]cachedExtraDenoms
+}
+
+// NewCachingDenomResolver returns a new CachingDenomResolver that caches the results of
+// the given resolver.
+func NewCachingDenomResolver(resolver DenomResolver) *CachingDenomResolver {
+	return &CachingDenomResolver{
+		resolver:    resolver,
+		conversions: make(map[conversionKey]cachedConversion),
+		extraDenoms: make(map[cacheScope]cachedExtraDenoms),
+	}
+}
+
+// ConvertToDenom converts the given coin into the equivalent amount of denom with the
+// cached rate of the denom pair in the current block.
+func (r *CachingDenomResolver) ConvertToDenom(ctx context.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error) {
+	if coin.Denom == denom {
+		return coin, nil
+	}
+
+	key := conversionKey{scope: newCacheScope(ctx), fromDenom: coin.Denom, toDenom: denom}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cached, ok := r.conversions[key]
+	if !ok {
+		converted, err := r.resolver.ConvertToDenom(ctx, sdk.NewDecCoinFromDec(coin.Denom, cacheReferenceAmount), denom)
+		cached = cachedConversion{value: converted.Amount, err: err}
+		r.conversions[key] = cached
+	}
+
+	if cached.err != nil {
+		return sdk.DecCoin{}, cached.err
+	}
+
+	return sdk.NewDecCoinFromDec(denom, coin.Amount.Mul(cached.value).Quo(cacheReferenceAmount)), nil
+}
+
+// ExtraDenoms returns the cached extra denoms of the wrapped resolver in the current block.
+func (r *CachingDenomResolver) ExtraDenoms(ctx context.Context) ([]string, error) {
+	scope := newCacheScope(ctx)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cached, ok := r.extraDenoms[scope]
+	if !ok {
+		denoms, err := r.resolver.ExtraDenoms(ctx)
+		cached = cachedExtraDenoms{denoms: denoms, err: err}
+		r.extraDenoms[scope] = cached
+	}
+
+	if cached.err != nil {
+		return nil, cached.err
+	}
+
+	return append([]string(nil), cached.denoms...), nil
+}
+
+// ResetCache drops all cached results.
+func (r *CachingDenomResolver) ResetCache() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.conversions = make(map[conversionKey]cachedConversion)
+	r.extraDenoms = make(map[cacheScope]cachedExtraDenoms)
+}
+
+func newCacheScope(ctx context.Context) cacheScope {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return cacheScope{
+		height:     sdkCtx.BlockHeight(),
+		headerHash: string(sdkCtx.HeaderHash()),
+		mode:       sdkCtx.ExecMode(),
+	}
+}
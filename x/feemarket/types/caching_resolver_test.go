@@ -0,0 +1,125 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+	"github.com/skip-mev/feemarket/x/feemarket/types/mocks"
+)
+
+func TestCachingDenomResolver(t *testing.T) {
+	ctx := sdk.Context{}.WithBlockHeight(10).WithExecMode(sdk.ExecModeFinalize)
+	price := func(p string) types.OraclePrice {
+		return types.OraclePrice{Price: math.LegacyMustNewDecFromStr(p)}
+	}
+
+	newResolver := func(oracle *mocks.OracleKeeper) *types.CachingDenomResolver {
+		config := oracleResolverConfig(types.OracleFallbackReject)
+		config.MaxPriceAge = 0
+
+		resolver, err := types.NewOracleDenomResolver(oracle, config)
+		require.NoError(t, err)
+
+		return types.NewCachingDenomResolver(resolver)
+	}
+
+	t.Run("converts each denom pair once per block", func(t *testing.T) {
+		oracle := mocks.NewOracleKeeper(t)
+		oracle.On("GetPrice", mock.Anything, "STAKE/USD").Return(price("2.5"), nil).Once()
+		oracle.On("GetPrice", mock.Anything, "ATOM/USD").Return(price("10"), nil).Once()
+
+		resolver := newResolver(oracle)
+		for _, amount := range []int64{100, 4, 1} {
+			got, err := resolver.ConvertToDenom(ctx, sdk.NewInt64DecCoin("ustake", amount), "uatom")
+			require.NoError(t, err)
+			require.Equal(t, sdk.NewDecCoinFromDec("uatom", math.LegacyNewDec(amount).QuoInt64(4)), got)
+		}
+	})
+
+	t.Run("keeps the precision of denoms with many decimals", func(t *testing.T) {
+		oracle := mocks.NewOracleKeeper(t)
+		oracle.On("GetPrice", mock.Anything, "ATOM/USD").Return(price("10"), nil).Once()
+		oracle.On("GetPrice", mock.Anything, "EVMOS/USD").Return(price("0.3"), nil).Once()
+
+		resolver := newResolver(oracle)
+		got, err := resolver.ConvertToDenom(ctx, sdk.NewInt64DecCoin("aevmos", 3_000_000_000_000), "uatom")
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDecCoinFromDec("uatom", math.LegacyMustNewDecFromStr("0.09")), got)
+	})
+
+	t.Run("does not share conversions across blocks or execution modes", func(t *testing.T) {
+		oracle := mocks.NewOracleKeeper(t)
+		oracle.On("GetPrice", mock.Anything, "STAKE/USD").Return(price("2.5"), nil).Times(3)
+		oracle.On("GetPrice", mock.Anything, "ATOM/USD").Return(price("10"), nil).Times(3)
+
+		resolver := newResolver(oracle)
+		for _, c := range []sdk.Context{ctx, ctx.WithBlockHeight(11), ctx.WithExecMode(sdk.ExecModeCheck)} {
+			_, err := resolver.ConvertToDenom(c, sdk.NewInt64DecCoin("ustake", 100), "uatom")
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("does not reuse conversions of an aborted run of the same height", func(t *testing.T) {
+		oracle := mocks.NewOracleKeeper(t)
+		oracle.On("GetPrice", mock.Anything, "STAKE/USD").Return(price("2.5"), nil)
+		oracle.On("GetPrice", mock.Anything, "ATOM/USD").Return(types.OraclePrice{}, fmt.Errorf("no price")).Once()
+
+		resolver := newResolver(oracle)
+
+		// an optimistic execution of a proposal that is not finalized fails to convert
+		aborted := ctx.WithHeaderHash([]byte("aborted proposal"))
+		_, err := resolver.ConvertToDenom(aborted, sdk.NewInt64DecCoin("ustake", 100), "uatom")
+		require.ErrorIs(t, err, types.ErrPriceUnavailable)
+
+		// the finalized block of the same height converts with the wrapped resolver again
+		oracle.On("GetPrice", mock.Anything, "ATOM/USD").Return(price("10"), nil).Once()
+		finalized := ctx.WithHeaderHash([]byte("finalized block"))
+		got, err := resolver.ConvertToDenom(finalized, sdk.NewInt64DecCoin("ustake", 100), "uatom")
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewInt64DecCoin("uatom", 25), got)
+	})
+
+	t.Run("caches failed conversions", func(t *testing.T) {
+		oracle := mocks.NewOracleKeeper(t)
+		oracle.On("GetPrice", mock.Anything, "STAKE/USD").Return(price("2.5"), nil).Once()
+		oracle.On("GetPrice", mock.Anything, "ATOM/USD").Return(types.OraclePrice{}, fmt.Errorf("no price")).Once()
+
+		resolver := newResolver(oracle)
+		for i := 0; i < 2; i++ {
+			_, err := resolver.ConvertToDenom(ctx, sdk.NewInt64DecCoin("ustake", 100), "uatom")
+			require.ErrorIs(t, err, types.ErrPriceUnavailable)
+		}
+	})
+
+	t.Run("converts again after a reset", func(t *testing.T) {
+		oracle := mocks.NewOracleKeeper(t)
+		oracle.On("GetPrice", mock.Anything, "STAKE/USD").Return(price("2.5"), nil).Once()
+		oracle.On("GetPrice", mock.Anything, "ATOM/USD").Return(price("10"), nil).Once()
+
+		resolver := newResolver(oracle)
+		_, err := resolver.ConvertToDenom(ctx, sdk.NewInt64DecCoin("ustake", 100), "uatom")
+		require.NoError(t, err)
+
+		resolver.ResetCache()
+		oracle.On("GetPrice", mock.Anything, "STAKE/USD").Return(price("5"), nil).Once()
+		oracle.On("GetPrice", mock.Anything, "ATOM/USD").Return(price("10"), nil).Once()
+
+		got, err := resolver.ConvertToDenom(ctx, sdk.NewInt64DecCoin("ustake", 100), "uatom")
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewInt64DecCoin("uatom", 50), got)
+	})
+
+	t.Run("returns the extra denoms of the wrapped resolver", func(t *testing.T) {
+		resolver := newResolver(mocks.NewOracleKeeper(t))
+
+		denoms, err := resolver.ExtraDenoms(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []string{"aevmos", "uatom", "ustake"}, denoms)
+	})
+}
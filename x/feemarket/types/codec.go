@@ -6,12 +6,22 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 // RegisterLegacyAminoCodec registers the necessary x/feemarket interfaces (messages) on the
 // provided LegacyAmino codec.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgParams{}, "feemarket/MsgParams")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParamsPartial{}, "feemarket/MsgUpdateParamsPartial")
+	legacy.RegisterAminoMsg(cdc, &MsgScheduleParams{}, "feemarket/MsgScheduleParams")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelScheduledParams{}, "feemarket/MsgCancelScheduledParams")
+	legacy.RegisterAminoMsg(cdc, &MsgSetBaseGasPrice{}, "feemarket/MsgSetBaseGasPrice")
+	legacy.RegisterAminoMsg(cdc, &MsgFreezeFeeMarket{}, "feemarket/MsgFreezeFeeMarket")
+	legacy.RegisterAminoMsg(cdc, &MsgUnfreezeFeeMarket{}, "feemarket/MsgUnfreezeFeeMarket")
+	legacy.RegisterAminoMsg(cdc, &MsgSweepFeeCollector{}, "feemarket/MsgSweepFeeCollector")
+	legacy.RegisterAminoMsg(cdc, &MsgSetDenomRate{}, "feemarket/MsgSetDenomRate")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveDenom{}, "feemarket/MsgRemoveDenom")
 }
 
 // RegisterInterfaces registers the x/feemarket interfaces (messages + msg server) on the
@@ -19,6 +29,19 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgParams{},
+		&MsgUpdateParamsPartial{},
+		&MsgScheduleParams{},
+		&MsgCancelScheduledParams{},
+		&MsgSetBaseGasPrice{},
+		&MsgFreezeFeeMarket{},
+		&MsgUnfreezeFeeMarket{},
+		&MsgSweepFeeCollector{},
+		&MsgSetDenomRate{},
+		&MsgRemoveDenom{},
+	)
+
+	registry.RegisterImplementations((*tx.TxExtensionOptionI)(nil),
+		&FeeMarketExtensionOption{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
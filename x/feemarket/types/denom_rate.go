@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateBasic performs basic validation on the denom rate.
+func (r DenomRate) ValidateBasic() error {
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return err
+	}
+
+	if r.Rate.IsNil() || !r.Rate.IsPositive() {
+		return fmt.Errorf("rate of denom %s must be positive", r.Denom)
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic validation on the denom gas price.
+func (p DenomGasPrice) ValidateBasic() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return err
+	}
+
+	if p.Price.IsNil() || p.Price.IsNegative() {
+		return fmt.Errorf("last gas price of denom %s cannot be nil or negative", p.Denom)
+	}
+
+	if p.Height < 0 {
+		return fmt.Errorf("height of the last gas price of denom %s cannot be negative", p.Denom)
+	}
+
+	return nil
+}
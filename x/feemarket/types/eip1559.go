@@ -42,13 +42,34 @@ var (
 
 	// DefaultFeeDenom is the Cosmos SDK default bond denom.
 	DefaultFeeDenom = sdk.DefaultBondDenom
+
+	// DefaultTargetBlockUtilizationRatio is the default fraction of the maximum block
+	// utilization that is targeted. This is the default on Ethereum.
+	DefaultTargetBlockUtilizationRatio = math.LegacyMustNewDecFromStr("0.5")
+
+	// DefaultMaxBaseGasPrice is the default maximum base gas price. A value of zero
+	// means the base gas price is not capped.
+	DefaultMaxBaseGasPrice = math.LegacyZeroDec()
+
+	// DefaultMaxChangePerBlock is the default maximum fraction by which the base fee
+	// can change in a single block. A value of zero means the change is not limited.
+	DefaultMaxChangePerBlock = math.LegacyZeroDec()
+
+	// DefaultHistoryRetention is the default number of blocks for which a gas price
+	// record is kept. A value of zero means no gas price history is recorded.
+	DefaultHistoryRetention uint64 = 0
+
+	// DefaultFeeSplitRatio is the default fraction of the base fees that is sent to
+	// each fee split destination. If all ratios are zero, the base fees are handled
+	// as determined by DistributeFees.
+	DefaultFeeSplitRatio = math.LegacyZeroDec()
 )
 
 // DefaultParams returns a default set of parameters that implements
 // the EIP-1559 fee market implementation without the AIMD learning
 // rate adjustment algorithm.
 func DefaultParams() Params {
-	return NewParams(
+	params := NewParams(
 		DefaultWindow,
 		DefaultAlpha,
 		DefaultBeta,
@@ -61,6 +82,17 @@ func DefaultParams() Params {
 		DefaultFeeDenom,
 		true,
 	)
+	params.PricingAlgorithm = EIP1559PricingAlgorithmName
+	params.TargetBlockUtilizationRatio = DefaultTargetBlockUtilizationRatio
+	params.MaxBaseGasPrice = DefaultMaxBaseGasPrice
+	params.MaxChangePerBlock = DefaultMaxChangePerBlock
+	params.HistoryRetention = DefaultHistoryRetention
+	params.TipDestination = DefaultTipDestination
+	params.BurnRatio = DefaultFeeSplitRatio
+	params.StakerRatio = DefaultFeeSplitRatio
+	params.CommunityPoolRatio = DefaultFeeSplitRatio
+
+	return params
 }
 
 // DefaultState returns the default state for the EIP-1559 fee market
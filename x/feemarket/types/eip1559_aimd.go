@@ -49,6 +49,27 @@ var (
 
 	// DefaultAIMDFeeDenom is the Cosmos SDK default bond denom.
 	DefaultAIMDFeeDenom = DefaultFeeDenom
+
+	// DefaultAIMDTargetBlockUtilizationRatio is the default fraction of the maximum
+	// block utilization that is targeted.
+	DefaultAIMDTargetBlockUtilizationRatio = DefaultTargetBlockUtilizationRatio
+
+	// DefaultAIMDMaxBaseGasPrice is the default maximum base gas price. A value of zero
+	// means the base gas price is not capped.
+	DefaultAIMDMaxBaseGasPrice = math.LegacyZeroDec()
+
+	// DefaultAIMDMaxChangePerBlock is the default maximum fraction by which the base
+	// fee can change in a single block. A value of zero means the change is not limited.
+	DefaultAIMDMaxChangePerBlock = math.LegacyZeroDec()
+
+	// DefaultAIMDHistoryRetention is the default number of blocks for which a gas
+	// price record is kept. A value of zero means no gas price history is recorded.
+	DefaultAIMDHistoryRetention = DefaultHistoryRetention
+
+	// DefaultAIMDFeeSplitRatio is the default fraction of the base fees that is sent
+	// to each fee split destination. If all ratios are zero, the base fees are handled
+	// as determined by DistributeFees.
+	DefaultAIMDFeeSplitRatio = DefaultFeeSplitRatio
 )
 
 // DefaultAIMDParams returns a default set of parameters that implements
@@ -56,7 +77,7 @@ var (
 // the learning rate to be dynamically adjusted based on the block utilization
 // within the window.
 func DefaultAIMDParams() Params {
-	return NewParams(
+	params := NewParams(
 		DefaultAIMDWindow,
 		DefaultAIMDAlpha,
 		DefaultAIMDBeta,
@@ -69,6 +90,17 @@ func DefaultAIMDParams() Params {
 		DefaultAIMDFeeDenom,
 		true,
 	)
+	params.PricingAlgorithm = AIMDPricingAlgorithmName
+	params.TargetBlockUtilizationRatio = DefaultAIMDTargetBlockUtilizationRatio
+	params.MaxBaseGasPrice = DefaultAIMDMaxBaseGasPrice
+	params.MaxChangePerBlock = DefaultAIMDMaxChangePerBlock
+	params.HistoryRetention = DefaultAIMDHistoryRetention
+	params.TipDestination = DefaultTipDestination
+	params.BurnRatio = DefaultAIMDFeeSplitRatio
+	params.StakerRatio = DefaultAIMDFeeSplitRatio
+	params.CommunityPoolRatio = DefaultAIMDFeeSplitRatio
+
+	return params
 }
 
 // DefaultAIMDState returns the default state for the AIMD EIP-1559 fee market
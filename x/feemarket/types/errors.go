@@ -8,4 +8,15 @@ var (
 	ErrNoFeeCoins      = sdkerrors.New(ModuleName, 1, "no fee coin provided. Must provide one.")
 	ErrTooManyFeeCoins = sdkerrors.New(ModuleName, 2, "too many fee coins provided.  Only one fee coin may be provided")
 	ErrResolverNotSet  = sdkerrors.New(ModuleName, 3, "denom resolver interface not set.  Only the feemarket base fee denomination can be used")
+
+	ErrUnknownPricingAlgorithm = sdkerrors.New(ModuleName, 4, "unknown pricing algorithm")
+	ErrInvalidParamsField      = sdkerrors.New(ModuleName, 5, "invalid params field")
+	ErrGasPriceRecordNotFound  = sdkerrors.New(ModuleName, 6, "gas price record not found")
+	ErrInvalidFeeHistory       = sdkerrors.New(ModuleName, 7, "invalid fee history request")
+	ErrInvalidProjection       = sdkerrors.New(ModuleName, 8, "invalid gas price projection request")
+	ErrInvalidExtensionOption  = sdkerrors.New(ModuleName, 9, "invalid fee market extension option")
+	ErrUnknownFeeRecipient     = sdkerrors.New(ModuleName, 10, "unknown fee recipient module account")
+	ErrUnknownResolverDenom    = sdkerrors.New(ModuleName, 11, "denom is not configured in the denom resolver")
+	ErrPriceUnavailable        = sdkerrors.New(ModuleName, 12, "oracle price is missing or stale")
+	ErrPoolPriceRejected       = sdkerrors.New(ModuleName, 13, "pool price is unavailable or rejected by a guard")
 )
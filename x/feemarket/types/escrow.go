@@ -0,0 +1,23 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// escrowedFeesKey is the context key of the fees escrowed by the transaction being executed.
+type escrowedFeesKey struct{}
+
+// ContextWithEscrowedFees returns a copy of the context that holds the fees that the
+// transaction being executed escrowed in the feemarket fee collector.
+func ContextWithEscrowedFees(ctx sdk.Context, fees sdk.Coins) sdk.Context {
+	return ctx.WithValue(escrowedFeesKey{}, fees)
+}
+
+// EscrowedFeesFromContext returns the fees that the transaction being executed escrowed
+// in the feemarket fee collector. Outside of a transaction, no fees are escrowed.
+func EscrowedFeesFromContext(ctx context.Context) sdk.Coins {
+	fees, _ := ctx.Value(escrowedFeesKey{}).(sdk.Coins)
+	return fees
+}
@@ -2,7 +2,9 @@ package types
 
 import (
 	"context"
+	"time"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -14,3 +16,37 @@ type AccountKeeper interface {
 	GetModuleAddress(name string) sdk.AccAddress
 	GetModuleAccount(ctx context.Context, name string) sdk.ModuleAccountI
 }
+
+// BankKeeper defines the expected bank keeper (noalias)
+//
+//go:generate mockery --name BankKeeper --filename mock_bank_keeper.go
+type BankKeeper interface {
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+}
+
+// DistributionKeeper defines the expected distribution keeper (noalias)
+//
+//go:generate mockery --name DistributionKeeper --filename mock_distribution_keeper.go
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// OracleKeeper defines the expected price feed of the OracleDenomResolver, e.g. an
+// adapter of the Slinky x/oracle keeper (noalias)
+//
+//go:generate mockery --name OracleKeeper --filename mock_oracle_keeper.go
+type OracleKeeper interface {
+	GetPrice(ctx context.Context, currencyPair string) (OraclePrice, error)
+}
+
+// PoolKeeper defines the expected AMM pool keeper of the TwapDenomResolver, e.g. an
+// adapter of the Osmosis x/poolmanager and x/twap keepers (noalias)
+//
+//go:generate mockery --name PoolKeeper --filename mock_pool_keeper.go
+type PoolKeeper interface {
+	GetArithmeticTwapToNow(ctx context.Context, poolID uint64, baseDenom, quoteDenom string, startTime time.Time) (math.LegacyDec, error)
+	GetSpotPrice(ctx context.Context, poolID uint64, baseDenom, quoteDenom string) (math.LegacyDec, error)
+	GetPoolLiquidity(ctx context.Context, poolID uint64) (sdk.Coins, error)
+}
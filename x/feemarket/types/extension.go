@@ -0,0 +1,45 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var _ tx.TxExtensionOptionI = &FeeMarketExtensionOption{}
+
+// NewFeeMarketExtensionOption returns a new tx extension option that caps the gas
+// price and the priority tip paid by a transaction.
+func NewFeeMarketExtensionOption(maxFeePerGas, maxPriorityFeePerGas math.LegacyDec) FeeMarketExtensionOption {
+	return FeeMarketExtensionOption{
+		MaxFeePerGas:         maxFeePerGas,
+		MaxPriorityFeePerGas: maxPriorityFeePerGas,
+	}
+}
+
+// ValidateBasic determines whether the caps of the extension option are set, not
+// negative and whether the priority tip cap does not exceed the gas price cap.
+func (o *FeeMarketExtensionOption) ValidateBasic() error {
+	if o.MaxFeePerGas.IsNil() || o.MaxFeePerGas.IsNegative() {
+		return ErrInvalidExtensionOption.Wrapf("max fee per gas must be set and not negative, got %s", o.MaxFeePerGas)
+	}
+
+	if o.MaxPriorityFeePerGas.IsNil() || o.MaxPriorityFeePerGas.IsNegative() {
+		return ErrInvalidExtensionOption.Wrapf("max priority fee per gas must be set and not negative, got %s", o.MaxPriorityFeePerGas)
+	}
+
+	if o.MaxPriorityFeePerGas.GT(o.MaxFeePerGas) {
+		return ErrInvalidExtensionOption.Wrapf(
+			"max priority fee per gas %s cannot be greater than max fee per gas %s",
+			o.MaxPriorityFeePerGas,
+			o.MaxFeePerGas,
+		)
+	}
+
+	return nil
+}
+
+// MaxTipPerGas returns the maximum priority tip per gas that the transaction pays on
+// top of the given gas price, i.e. min(MaxPriorityFeePerGas, MaxFeePerGas - gasPrice).
+func (o *FeeMarketExtensionOption) MaxTipPerGas(gasPrice math.LegacyDec) math.LegacyDec {
+	return math.LegacyMinDec(o.MaxPriorityFeePerGas, o.MaxFeePerGas.Sub(gasPrice))
+}
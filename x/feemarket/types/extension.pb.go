@@ -0,0 +1,377 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: feemarket/feemarket/v1/extension.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FeeMarketExtensionOption is a tx extension option that caps the gas price
+// and the priority tip that a transaction pays, independently of the fee it
+// provides. Both caps are denominated in the denom of the provided fee.
+type FeeMarketExtensionOption struct {
+	// MaxFeePerGas is the maximum total gas price, i.e. the base gas price plus
+	// the priority tip, that the transaction pays per unit of gas consumed.
+	MaxFeePerGas cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=max_fee_per_gas,json=maxFeePerGas,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_fee_per_gas"`
+	// MaxPriorityFeePerGas is the maximum priority tip that the transaction pays
+	// per unit of gas consumed on top of the base gas price.
+	MaxPriorityFeePerGas cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=max_priority_fee_per_gas,json=maxPriorityFeePerGas,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_priority_fee_per_gas"`
+}
+
+func (m *FeeMarketExtensionOption) Reset()         { *m = FeeMarketExtensionOption{} }
+func (m *FeeMarketExtensionOption) String() string { return proto.CompactTextString(m) }
+func (*FeeMarketExtensionOption) ProtoMessage()    {}
+func (*FeeMarketExtensionOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6532874dbab8c394, []int{0}
+}
+func (m *FeeMarketExtensionOption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeMarketExtensionOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeMarketExtensionOption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeMarketExtensionOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeMarketExtensionOption.Merge(m, src)
+}
+func (m *FeeMarketExtensionOption) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeMarketExtensionOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeMarketExtensionOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeMarketExtensionOption proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*FeeMarketExtensionOption)(nil), "feemarket.feemarket.v1.FeeMarketExtensionOption")
+}
+
+func init() {
+	proto.RegisterFile("feemarket/feemarket/v1/extension.proto", fileDescriptor_6532874dbab8c394)
+}
+
+var fileDescriptor_6532874dbab8c394 = []byte{
+	// 284 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4b, 0x4b, 0x4d, 0xcd,
+	0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xd1, 0x47, 0xb0, 0xca, 0x0c, 0xf5, 0x53, 0x2b, 0x4a, 0x52, 0xf3,
+	0x8a, 0x33, 0xf3, 0xf3, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xc4, 0xe0, 0xb2, 0x7a, 0x08,
+	0x56, 0x99, 0xa1, 0x94, 0x64, 0x72, 0x7e, 0x71, 0x6e, 0x7e, 0x71, 0x3c, 0x58, 0x95, 0x3e, 0x84,
+	0x03, 0xd1, 0x22, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x11, 0x07, 0xb1, 0x20, 0xa2, 0x4a, 0xf7,
+	0x19, 0xb9, 0x24, 0xdc, 0x52, 0x53, 0x7d, 0xc1, 0x26, 0xb8, 0xc2, 0x6c, 0xf1, 0x2f, 0x28, 0xc9,
+	0xcc, 0xcf, 0x13, 0x8a, 0xe0, 0xe2, 0xcf, 0x4d, 0xac, 0x88, 0x4f, 0x4b, 0x4d, 0x8d, 0x2f, 0x48,
+	0x2d, 0x8a, 0x4f, 0x4f, 0x2c, 0x96, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x74, 0x32, 0x3c, 0x71, 0x4f,
+	0x9e, 0xe1, 0xd6, 0x3d, 0x79, 0x69, 0x88, 0x0d, 0xc5, 0x29, 0xd9, 0x7a, 0x99, 0xf9, 0xfa, 0xb9,
+	0x89, 0x25, 0x19, 0x7a, 0x3e, 0xa9, 0xe9, 0x89, 0xc9, 0x95, 0x2e, 0xa9, 0xc9, 0x97, 0xb6, 0xe8,
+	0x72, 0x41, 0x1d, 0xe0, 0x92, 0x9a, 0x1c, 0xc4, 0x93, 0x9b, 0x58, 0xe1, 0x96, 0x9a, 0x1a, 0x90,
+	0x5a, 0xe4, 0x9e, 0x58, 0x2c, 0x94, 0xc9, 0x25, 0x01, 0x32, 0xb9, 0xa0, 0x28, 0x33, 0xbf, 0x28,
+	0xb3, 0xa4, 0x12, 0xc5, 0x0a, 0x26, 0x72, 0xad, 0x10, 0xc9, 0x4d, 0xac, 0x08, 0x80, 0x9a, 0x08,
+	0xb7, 0xca, 0xc9, 0xf3, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63,
+	0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xf4, 0xd3,
+	0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x8b, 0xb3, 0x33, 0x0b, 0x74, 0x73,
+	0x53, 0xcb, 0x90, 0x82, 0xbd, 0x02, 0x89, 0x5d, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e,
+	0x33, 0x63, 0xc0, 0x00, 0xbf, 0x0b, 0x0a, 0xac, 0xa6, 0x01, 0x00, 0x00,
+}
+
+func (m *FeeMarketExtensionOption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeMarketExtensionOption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeMarketExtensionOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxPriorityFeePerGas.Size()
+		i -= size
+		if _, err := m.MaxPriorityFeePerGas.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExtension(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxFeePerGas.Size()
+		i -= size
+		if _, err := m.MaxFeePerGas.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExtension(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintExtension(dAtA []byte, offset int, v uint64) int {
+	offset -= sovExtension(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FeeMarketExtensionOption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxFeePerGas.Size()
+	n += 1 + l + sovExtension(uint64(l))
+	l = m.MaxPriorityFeePerGas.Size()
+	n += 1 + l + sovExtension(uint64(l))
+	return n
+}
+
+func sovExtension(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozExtension(x uint64) (n int) {
+	return sovExtension(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeeMarketExtensionOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExtension
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeMarketExtensionOption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeMarketExtensionOption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeePerGas", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExtension
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFeePerGas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriorityFeePerGas", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExtension
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriorityFeePerGas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExtension(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExtension
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipExtension(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowExtension
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthExtension
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupExtension
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthExtension
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthExtension        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowExtension          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupExtension = fmt.Errorf("proto: unexpected end of group")
+)
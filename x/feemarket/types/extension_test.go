@@ -0,0 +1,68 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func TestFeeMarketExtensionOption_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name        string
+		option      types.FeeMarketExtensionOption
+		expectedErr bool
+	}{
+		{
+			name:        "valid option",
+			option:      types.NewFeeMarketExtensionOption(math.LegacyNewDec(2), math.LegacyNewDec(1)),
+			expectedErr: false,
+		},
+		{
+			name:        "valid option with zero caps",
+			option:      types.NewFeeMarketExtensionOption(math.LegacyZeroDec(), math.LegacyZeroDec()),
+			expectedErr: false,
+		},
+		{
+			name:        "nil max fee per gas",
+			option:      types.FeeMarketExtensionOption{MaxPriorityFeePerGas: math.LegacyZeroDec()},
+			expectedErr: true,
+		},
+		{
+			name:        "nil max priority fee per gas",
+			option:      types.FeeMarketExtensionOption{MaxFeePerGas: math.LegacyZeroDec()},
+			expectedErr: true,
+		},
+		{
+			name:        "negative max fee per gas",
+			option:      types.NewFeeMarketExtensionOption(math.LegacyNewDec(-1), math.LegacyZeroDec()),
+			expectedErr: true,
+		},
+		{
+			name:        "max priority fee per gas greater than max fee per gas",
+			option:      types.NewFeeMarketExtensionOption(math.LegacyNewDec(1), math.LegacyNewDec(2)),
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.option.ValidateBasic()
+			if tc.expectedErr {
+				require.ErrorIs(t, err, types.ErrInvalidExtensionOption)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFeeMarketExtensionOption_MaxTipPerGas(t *testing.T) {
+	option := types.NewFeeMarketExtensionOption(math.LegacyNewDec(10), math.LegacyNewDec(3))
+
+	require.Equal(t, math.LegacyNewDec(3), option.MaxTipPerGas(math.LegacyNewDec(5)))
+	require.Equal(t, math.LegacyNewDec(2), option.MaxTipPerGas(math.LegacyNewDec(8)))
+	require.Equal(t, math.LegacyNewDec(-1), option.MaxTipPerGas(math.LegacyNewDec(11)))
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"sort"
+
+	"cosmossdk.io/math"
+)
+
+// MaxFeeHistoryBlockCount is the maximum number of blocks that can be returned by a
+// single fee history query.
+const MaxFeeHistoryBlockCount uint64 = 1024
+
+// ValidateRewardPercentiles checks that the given percentiles are in [0, 100] and
+// monotonically increasing.
+func ValidateRewardPercentiles(percentiles []math.LegacyDec) error {
+	hundred := math.LegacyNewDec(100)
+
+	for i, p := range percentiles {
+		if p.IsNil() || p.IsNegative() || p.GT(hundred) {
+			return ErrInvalidFeeHistory.Wrapf("reward percentile %s must be between [0, 100]", p)
+		}
+
+		if i > 0 && p.LT(percentiles[i-1]) {
+			return ErrInvalidFeeHistory.Wrapf("reward percentiles must be monotonically increasing")
+		}
+	}
+
+	return nil
+}
+
+// GasUsedRatio returns the fraction of the max block utilization in effect during the
+// block of the record that the block consumed. Records written before the max block
+// utilization was recorded use the given max block utilization instead.
+func (r GasPriceRecord) GasUsedRatio(maxBlockUtilization uint64) math.LegacyDec {
+	if r.MaxBlockUtilization > 0 {
+		maxBlockUtilization = r.MaxBlockUtilization
+	}
+
+	return math.LegacyNewDecFromInt(math.NewIntFromUint64(r.BlockUtilization)).QuoInt(math.NewIntFromUint64(maxBlockUtilization))
+}
+
+// RewardPercentiles returns the tips per gas at the given percentiles of the given
+// tips, weighted by the gas used by each transaction. If there are no tips, the
+// tips at all percentiles are zero.
+func RewardPercentiles(tips []TipRecord, percentiles []math.LegacyDec) []math.LegacyDec {
+	rewards := make([]math.LegacyDec, len(percentiles))
+	for i := range rewards {
+		rewards[i] = math.LegacyZeroDec()
+	}
+
+	if len(tips) == 0 {
+		return rewards
+	}
+
+	sorted := make([]TipRecord, len(tips))
+	copy(sorted, tips)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].TipPerGas.LT(sorted[j].TipPerGas)
+	})
+
+	var totalGasUsed uint64
+	for _, tip := range sorted {
+		totalGasUsed += tip.GasUsed
+	}
+
+	var (
+		index   int
+		sumUsed = sorted[0].GasUsed
+	)
+	for i, p := range percentiles {
+		threshold := math.LegacyNewDecFromInt(math.NewIntFromUint64(totalGasUsed)).Mul(p).QuoInt64(100)
+		for math.LegacyNewDecFromInt(math.NewIntFromUint64(sumUsed)).LT(threshold) && index < len(sorted)-1 {
+			index++
+			sumUsed += sorted[index].GasUsed
+		}
+
+		rewards[i] = sorted[index].TipPerGas
+	}
+
+	return rewards
+}
@@ -0,0 +1,103 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func TestValidateRewardPercentiles(t *testing.T) {
+	testCases := []struct {
+		name        string
+		percentiles []math.LegacyDec
+		expectedErr bool
+	}{
+		{
+			name:        "empty percentiles",
+			percentiles: nil,
+			expectedErr: false,
+		},
+		{
+			name:        "valid percentiles",
+			percentiles: []math.LegacyDec{math.LegacyZeroDec(), math.LegacyNewDec(50), math.LegacyNewDec(50), OneHundred},
+			expectedErr: false,
+		},
+		{
+			name:        "negative percentile",
+			percentiles: []math.LegacyDec{math.LegacyNewDec(-1)},
+			expectedErr: true,
+		},
+		{
+			name:        "percentile above 100",
+			percentiles: []math.LegacyDec{math.LegacyMustNewDecFromStr("100.1")},
+			expectedErr: true,
+		},
+		{
+			name:        "decreasing percentiles",
+			percentiles: []math.LegacyDec{math.LegacyNewDec(50), math.LegacyNewDec(25)},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateRewardPercentiles(tc.percentiles)
+			if tc.expectedErr {
+				require.ErrorIs(t, err, types.ErrInvalidFeeHistory)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGasPriceRecord_GasUsedRatio(t *testing.T) {
+	t.Run("uses the recorded max block utilization", func(t *testing.T) {
+		record := types.GasPriceRecord{BlockUtilization: 50, MaxBlockUtilization: 100}
+		require.Equal(t, math.LegacyMustNewDecFromStr("0.5"), record.GasUsedRatio(200))
+	})
+
+	t.Run("falls back to the given max block utilization", func(t *testing.T) {
+		record := types.GasPriceRecord{BlockUtilization: 50}
+		require.Equal(t, math.LegacyMustNewDecFromStr("0.25"), record.GasUsedRatio(200))
+	})
+}
+
+func TestRewardPercentiles(t *testing.T) {
+	percentiles := []math.LegacyDec{
+		math.LegacyZeroDec(),
+		math.LegacyNewDec(25),
+		math.LegacyNewDec(50),
+		OneHundred,
+	}
+
+	t.Run("returns zero tips for an empty block", func(t *testing.T) {
+		rewards := types.RewardPercentiles(nil, percentiles)
+		require.Len(t, rewards, len(percentiles))
+		for _, reward := range rewards {
+			require.True(t, reward.IsZero())
+		}
+	})
+
+	t.Run("weights tips by gas used", func(t *testing.T) {
+		tips := []types.TipRecord{
+			{TipPerGas: math.LegacyNewDec(1), GasUsed: 100},
+			{TipPerGas: math.LegacyNewDec(3), GasUsed: 300},
+			{TipPerGas: math.LegacyNewDec(2), GasUsed: 100},
+		}
+
+		rewards := types.RewardPercentiles(tips, percentiles)
+		require.Equal(t, []math.LegacyDec{
+			math.LegacyNewDec(1),
+			math.LegacyNewDec(2),
+			math.LegacyNewDec(3),
+			math.LegacyNewDec(3),
+		}, rewards)
+
+		// The given tips are not reordered.
+		require.Equal(t, math.LegacyNewDec(3), tips[1].TipPerGas)
+	})
+}
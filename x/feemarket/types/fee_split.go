@@ -0,0 +1,124 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// FeeSplitDestinationBurn is the fee split destination of the burned base fees.
+	FeeSplitDestinationBurn = "burn"
+
+	// FeeSplitDestinationStakers is the fee split destination of the base fees that
+	// are distributed to all stakers.
+	FeeSplitDestinationStakers = "stakers"
+
+	// FeeSplitDestinationCommunityPool is the fee split destination of the base fees
+	// that are sent to the community pool.
+	FeeSplitDestinationCommunityPool = "community_pool"
+)
+
+// FeeShare is the part of the base fees that is sent to a fee split destination. The
+// destination is either one of the FeeSplitDestination constants or the name of an
+// extra fee recipient module account.
+type FeeShare struct {
+	Destination string
+	Coins       sdk.Coins
+}
+
+// HasFeeSplit returns true if the base fees are split between the destinations given
+// by BurnRatio, StakerRatio, CommunityPoolRatio and ExtraFeeRecipients. Otherwise, the
+// base fees are handled as determined by DistributeFees.
+func (p *Params) HasFeeSplit() bool {
+	return isPositive(p.BurnRatio) ||
+		isPositive(p.StakerRatio) ||
+		isPositive(p.CommunityPoolRatio) ||
+		len(p.ExtraFeeRecipients) > 0
+}
+
+// FeeShares splits the given base fees between the fee split destinations. Every share
+// is rounded down, the rounding remainder is kept in the feemarket fee collector.
+// Destinations that receive no coins are omitted.
+func (p *Params) FeeShares(fee sdk.Coins) []FeeShare {
+	var shares []FeeShare
+
+	appendShare := func(destination string, ratio math.LegacyDec) {
+		if !isPositive(ratio) {
+			return
+		}
+
+		var coins sdk.Coins
+		for _, coin := range fee {
+			coins = coins.Add(sdk.NewCoin(coin.Denom, ratio.MulInt(coin.Amount).TruncateInt()))
+		}
+
+		if !coins.IsZero() {
+			shares = append(shares, FeeShare{Destination: destination, Coins: coins})
+		}
+	}
+
+	appendShare(FeeSplitDestinationBurn, p.BurnRatio)
+	appendShare(FeeSplitDestinationStakers, p.StakerRatio)
+	appendShare(FeeSplitDestinationCommunityPool, p.CommunityPoolRatio)
+	for _, recipient := range p.ExtraFeeRecipients {
+		appendShare(recipient.Module, recipient.Ratio)
+	}
+
+	return shares
+}
+
+// validateFeeSplit returns an error if a fee split ratio is not between [0, 1], an
+// extra fee recipient is invalid or the ratios of a fee split do not sum to 1.
+func (p *Params) validateFeeSplit() error {
+	total := math.LegacyZeroDec()
+
+	ratios := []struct {
+		name  string
+		ratio math.LegacyDec
+	}{
+		{"burn ratio", p.BurnRatio},
+		{"staker ratio", p.StakerRatio},
+		{"community pool ratio", p.CommunityPoolRatio},
+	}
+	for _, r := range ratios {
+		if r.ratio.IsNil() {
+			continue
+		}
+
+		if r.ratio.IsNegative() || r.ratio.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("%s must be between [0, 1]", r.name)
+		}
+
+		total = total.Add(r.ratio)
+	}
+
+	seen := make(map[string]struct{}, len(p.ExtraFeeRecipients))
+	for _, recipient := range p.ExtraFeeRecipients {
+		if recipient.Module == "" {
+			return fmt.Errorf("extra fee recipient module cannot be empty")
+		}
+
+		if _, ok := seen[recipient.Module]; ok {
+			return fmt.Errorf("duplicate extra fee recipient %s", recipient.Module)
+		}
+		seen[recipient.Module] = struct{}{}
+
+		if !isPositive(recipient.Ratio) || recipient.Ratio.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("ratio of extra fee recipient %s must be between (0, 1]", recipient.Module)
+		}
+
+		total = total.Add(recipient.Ratio)
+	}
+
+	if p.HasFeeSplit() && !total.Equal(math.LegacyOneDec()) {
+		return fmt.Errorf("fee split ratios must sum to 1, got %s", total)
+	}
+
+	return nil
+}
+
+func isPositive(d math.LegacyDec) bool {
+	return !d.IsNil() && d.IsPositive()
+}
@@ -2,18 +2,21 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 )
 
-// NewGenesisState returns a new genesis state for the module.
+// NewGenesisState returns a new genesis state for the module. The fee market is not
+// marked as enabled after genesis.
 func NewGenesisState(
 	params Params,
 	state State,
 ) *GenesisState {
 	return &GenesisState{
-		Params: params,
-		State:  state,
+		Params:        params,
+		State:         state,
+		EnabledHeight: -1,
 	}
 }
 
@@ -23,7 +26,102 @@ func (gs *GenesisState) ValidateBasic() error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
-	return gs.State.ValidateBasic()
+
+	if err := gs.State.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if gs.EnabledHeight < -1 {
+		return fmt.Errorf("enabled height must be -1 or greater, got %d", gs.EnabledHeight)
+	}
+
+	var prevHeight int64
+	for _, scheduled := range gs.ScheduledParams {
+		if scheduled.Height <= prevHeight {
+			return fmt.Errorf("scheduled params must have positive and strictly increasing heights, got %d", scheduled.Height)
+		}
+		prevHeight = scheduled.Height
+
+		if err := scheduled.Params.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid params scheduled at height %d: %w", scheduled.Height, err)
+		}
+	}
+
+	prevHeight = 0
+	for _, record := range gs.GasPriceHistory {
+		if record.Height <= prevHeight {
+			return fmt.Errorf("gas price records must have positive and strictly increasing heights, got %d", record.Height)
+		}
+		prevHeight = record.Height
+
+		if record.BaseGasPrice.IsNil() || record.BaseGasPrice.IsNegative() {
+			return fmt.Errorf("base gas price of the gas price record at height %d must be non-negative", record.Height)
+		}
+
+		if record.LearningRate.IsNil() || record.LearningRate.IsNegative() {
+			return fmt.Errorf("learning rate of the gas price record at height %d must be non-negative", record.Height)
+		}
+	}
+
+	prevHeight = 0
+	for _, records := range gs.TipRecords {
+		if records.Height <= prevHeight {
+			return fmt.Errorf("tip records must have positive and strictly increasing heights, got %d", records.Height)
+		}
+		prevHeight = records.Height
+
+		for _, tip := range records.Tips {
+			if tip.TipPerGas.IsNil() || tip.TipPerGas.IsNegative() {
+				return fmt.Errorf("tip per gas of the tip records at height %d must be non-negative", records.Height)
+			}
+		}
+	}
+
+	destinations := make(map[string]struct{}, len(gs.SweptFees))
+	for _, swept := range gs.SweptFees {
+		if swept.Destination == "" {
+			return fmt.Errorf("destination of swept fees cannot be empty")
+		}
+
+		if _, ok := destinations[swept.Destination]; ok {
+			return fmt.Errorf("duplicate swept fees destination %s", swept.Destination)
+		}
+		destinations[swept.Destination] = struct{}{}
+
+		if err := swept.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid fees swept to %s: %w", swept.Destination, err)
+		}
+	}
+
+	denoms := make(map[string]struct{}, len(gs.DenomRates))
+	for _, rate := range gs.DenomRates {
+		if err := rate.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if rate.Denom == gs.Params.FeeDenom {
+			return fmt.Errorf("the fee denom %s cannot have a denom rate", rate.Denom)
+		}
+
+		if _, ok := denoms[rate.Denom]; ok {
+			return fmt.Errorf("duplicate denom rate for %s", rate.Denom)
+		}
+		denoms[rate.Denom] = struct{}{}
+	}
+
+	gasPriceDenoms := make(map[string]struct{}, len(gs.LastDenomGasPrices))
+	for _, price := range gs.LastDenomGasPrices {
+		if err := price.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if _, ok := gasPriceDenoms[price.Denom]; ok {
+			return fmt.Errorf("duplicate last gas price for %s", price.Denom)
+		}
+		gasPriceDenoms[price.Denom] = struct{}{}
+	}
+
+	return nil
 }
 
 // GetGenesisStateFromAppState returns x/feemarket GenesisState given raw application
@@ -7,6 +7,9 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -33,6 +36,30 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// State contains the current state of the AIMD fee market.
 	State State `protobuf:"bytes,2,opt,name=state,proto3" json:"state"`
+	// EnabledHeight is the height at which the fee market was enabled. Fees are
+	// not charged up to and including this height. If -1, the fee market was not
+	// enabled after genesis.
+	EnabledHeight int64 `protobuf:"varint,3,opt,name=enabled_height,json=enabledHeight,proto3" json:"enabled_height,omitempty"`
+	// ScheduledParams are the parameter changes that are scheduled at future
+	// heights.
+	ScheduledParams []ScheduledParams `protobuf:"bytes,4,rep,name=scheduled_params,json=scheduledParams,proto3" json:"scheduled_params"`
+	// Frozen determines whether the learning rate and base gas price adjustments
+	// of the fee market are frozen.
+	Frozen bool `protobuf:"varint,5,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	// GasPriceHistory contains the retained gas price records, ordered by height.
+	GasPriceHistory []GasPriceRecord `protobuf:"bytes,6,rep,name=gas_price_history,json=gasPriceHistory,proto3" json:"gas_price_history"`
+	// TipRecords contains the retained tip records, grouped by height and
+	// ordered by height.
+	TipRecords []BlockTipRecords `protobuf:"bytes,7,rep,name=tip_records,json=tipRecords,proto3" json:"tip_records"`
+	// SweptFees contains the cumulative fees swept from the feemarket fee
+	// collector, ordered by destination.
+	SweptFees []SweptFees `protobuf:"bytes,8,rep,name=swept_fees,json=sweptFees,proto3" json:"swept_fees"`
+	// DenomRates contains the static conversion rates of the denoms that fees
+	// can be paid in, ordered by denom.
+	DenomRates []DenomRate `protobuf:"bytes,9,rep,name=denom_rates,json=denomRates,proto3" json:"denom_rates"`
+	// LastDenomGasPrices contains the last gas prices that were resolved for the
+	// extra denoms of the denom resolver, ordered by denom.
+	LastDenomGasPrices []DenomGasPrice `protobuf:"bytes,10,rep,name=last_denom_gas_prices,json=lastDenomGasPrices,proto3" json:"last_denom_gas_prices"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -82,6 +109,62 @@ func (m *GenesisState) GetState() State {
 	return State{}
 }
 
+func (m *GenesisState) GetEnabledHeight() int64 {
+	if m != nil {
+		return m.EnabledHeight
+	}
+	return 0
+}
+
+func (m *GenesisState) GetScheduledParams() []ScheduledParams {
+	if m != nil {
+		return m.ScheduledParams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
+func (m *GenesisState) GetGasPriceHistory() []GasPriceRecord {
+	if m != nil {
+		return m.GasPriceHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTipRecords() []BlockTipRecords {
+	if m != nil {
+		return m.TipRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSweptFees() []SweptFees {
+	if m != nil {
+		return m.SweptFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomRates() []DenomRate {
+	if m != nil {
+		return m.DenomRates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastDenomGasPrices() []DenomGasPrice {
+	if m != nil {
+		return m.LastDenomGasPrices
+	}
+	return nil
+}
+
 // State is utilized to track the current state of the fee market. This includes
 // the current base fee, learning rate, and block utilization within the
 // specified AIMD window.
@@ -146,9 +229,354 @@ func (m *State) GetIndex() uint64 {
 	return 0
 }
 
+// GasPriceRecord is a record of the fee market state at a given height.
+type GasPriceRecord struct {
+	// Height is the height of the block the record was taken at.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// BaseGasPrice is the base gas price that was in effect during the block.
+	BaseGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=base_gas_price,json=baseGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_gas_price"`
+	// LearningRate is the learning rate that was in effect during the block.
+	LearningRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=learning_rate,json=learningRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"learning_rate"`
+	// BlockUtilization is the number of units of gas consumed in the block.
+	BlockUtilization uint64 `protobuf:"varint,4,opt,name=block_utilization,json=blockUtilization,proto3" json:"block_utilization,omitempty"`
+	// MaxBlockUtilization is the max block utilization that was in effect during
+	// the block. It is zero for records written before it was recorded.
+	MaxBlockUtilization uint64 `protobuf:"varint,5,opt,name=max_block_utilization,json=maxBlockUtilization,proto3" json:"max_block_utilization,omitempty"`
+}
+
+func (m *GasPriceRecord) Reset()         { *m = GasPriceRecord{} }
+func (m *GasPriceRecord) String() string { return proto.CompactTextString(m) }
+func (*GasPriceRecord) ProtoMessage()    {}
+func (*GasPriceRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2180652c84279298, []int{2}
+}
+func (m *GasPriceRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPriceRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPriceRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPriceRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPriceRecord.Merge(m, src)
+}
+func (m *GasPriceRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPriceRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPriceRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPriceRecord proto.InternalMessageInfo
+
+func (m *GasPriceRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GasPriceRecord) GetBlockUtilization() uint64 {
+	if m != nil {
+		return m.BlockUtilization
+	}
+	return 0
+}
+
+func (m *GasPriceRecord) GetMaxBlockUtilization() uint64 {
+	if m != nil {
+		return m.MaxBlockUtilization
+	}
+	return 0
+}
+
+// TipRecord is the tip paid by a single transaction in a block.
+type TipRecord struct {
+	// TipPerGas is the tip paid per unit of gas consumed, denominated in the fee
+	// denom.
+	TipPerGas cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=tip_per_gas,json=tipPerGas,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"tip_per_gas"`
+	// GasUsed is the number of units of gas consumed by the transaction.
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (m *TipRecord) Reset()         { *m = TipRecord{} }
+func (m *TipRecord) String() string { return proto.CompactTextString(m) }
+func (*TipRecord) ProtoMessage()    {}
+func (*TipRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2180652c84279298, []int{3}
+}
+func (m *TipRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TipRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TipRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TipRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TipRecord.Merge(m, src)
+}
+func (m *TipRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TipRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TipRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TipRecord proto.InternalMessageInfo
+
+func (m *TipRecord) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+// BlockTipRecords are the tips recorded in the block with the given height.
+type BlockTipRecords struct {
+	// Height is the height of the block the tips were recorded in.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// Tips are the tips recorded in the block, in order of execution.
+	Tips []TipRecord `protobuf:"bytes,2,rep,name=tips,proto3" json:"tips"`
+}
+
+func (m *BlockTipRecords) Reset()         { *m = BlockTipRecords{} }
+func (m *BlockTipRecords) String() string { return proto.CompactTextString(m) }
+func (*BlockTipRecords) ProtoMessage()    {}
+func (*BlockTipRecords) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2180652c84279298, []int{4}
+}
+func (m *BlockTipRecords) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockTipRecords) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockTipRecords.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockTipRecords) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockTipRecords.Merge(m, src)
+}
+func (m *BlockTipRecords) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockTipRecords) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockTipRecords.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockTipRecords proto.InternalMessageInfo
+
+func (m *BlockTipRecords) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockTipRecords) GetTips() []TipRecord {
+	if m != nil {
+		return m.Tips
+	}
+	return nil
+}
+
+// SweptFees is the cumulative amount of fees that was swept from the feemarket
+// fee collector to a destination.
+type SweptFees struct {
+	// Destination is where the fees were swept to, i.e. "burn",
+	// "community_pool" or the name of a module account.
+	Destination string `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	// Amount is the cumulative amount of fees swept to the destination.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *SweptFees) Reset()         { *m = SweptFees{} }
+func (m *SweptFees) String() string { return proto.CompactTextString(m) }
+func (*SweptFees) ProtoMessage()    {}
+func (*SweptFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2180652c84279298, []int{5}
+}
+func (m *SweptFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SweptFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SweptFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SweptFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SweptFees.Merge(m, src)
+}
+func (m *SweptFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *SweptFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_SweptFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SweptFees proto.InternalMessageInfo
+
+func (m *SweptFees) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *SweptFees) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// DenomRate is the static conversion rate of a denom that fees can be paid in.
+// It is used by the keeper-backed denom resolver.
+type DenomRate struct {
+	// Denom is the denom that fees can be paid in.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Rate is the amount of the fee denom that one unit of the denom is worth.
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+}
+
+func (m *DenomRate) Reset()         { *m = DenomRate{} }
+func (m *DenomRate) String() string { return proto.CompactTextString(m) }
+func (*DenomRate) ProtoMessage()    {}
+func (*DenomRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2180652c84279298, []int{6}
+}
+func (m *DenomRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomRate.Merge(m, src)
+}
+func (m *DenomRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomRate proto.InternalMessageInfo
+
+func (m *DenomRate) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// DenomGasPrice is the last gas price that was resolved for an extra denom of
+// the denom resolver at the end of a block.
+type DenomGasPrice struct {
+	// Denom is the extra denom of the denom resolver.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Price is the gas price in the denom.
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+	// Height is the height of the first block that the gas price applies to, i.e.
+	// the block after the one at the end of which the gas price was resolved. It
+	// is not updated while the gas price of the denom stays the same.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *DenomGasPrice) Reset()         { *m = DenomGasPrice{} }
+func (m *DenomGasPrice) String() string { return proto.CompactTextString(m) }
+func (*DenomGasPrice) ProtoMessage()    {}
+func (*DenomGasPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2180652c84279298, []int{7}
+}
+func (m *DenomGasPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomGasPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomGasPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomGasPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomGasPrice.Merge(m, src)
+}
+func (m *DenomGasPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomGasPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomGasPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomGasPrice proto.InternalMessageInfo
+
+func (m *DenomGasPrice) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomGasPrice) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "feemarket.feemarket.v1.GenesisState")
 	proto.RegisterType((*State)(nil), "feemarket.feemarket.v1.State")
+	proto.RegisterType((*GasPriceRecord)(nil), "feemarket.feemarket.v1.GasPriceRecord")
+	proto.RegisterType((*TipRecord)(nil), "feemarket.feemarket.v1.TipRecord")
+	proto.RegisterType((*BlockTipRecords)(nil), "feemarket.feemarket.v1.BlockTipRecords")
+	proto.RegisterType((*SweptFees)(nil), "feemarket.feemarket.v1.SweptFees")
+	proto.RegisterType((*DenomRate)(nil), "feemarket.feemarket.v1.DenomRate")
+	proto.RegisterType((*DenomGasPrice)(nil), "feemarket.feemarket.v1.DenomGasPrice")
 }
 
 func init() {
@@ -156,30 +584,61 @@ func init() {
 }
 
 var fileDescriptor_2180652c84279298 = []byte{
-	// 364 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x51, 0x4d, 0x4b, 0x3a, 0x41,
-	0x18, 0xdf, 0xf9, 0xbb, 0x0a, 0xff, 0xc9, 0x3a, 0x2c, 0x22, 0x9b, 0xd1, 0x2a, 0xd6, 0xc1, 0x8b,
-	0xbb, 0x58, 0xa7, 0xa0, 0x93, 0x08, 0x12, 0x74, 0x90, 0x0d, 0x0a, 0xba, 0xc8, 0xb8, 0x3e, 0xad,
-	0x83, 0xed, 0xce, 0xb2, 0x33, 0xf9, 0xf2, 0x09, 0xba, 0xf6, 0x61, 0xfa, 0x10, 0x1e, 0xa5, 0x53,
-	0x74, 0x90, 0x50, 0xe8, 0x73, 0xc4, 0xec, 0xac, 0xe9, 0x21, 0x2f, 0xdd, 0x7e, 0x0f, 0xcf, 0xef,
-	0x6d, 0xe6, 0xc1, 0xa7, 0x0f, 0x00, 0x01, 0x89, 0x87, 0x20, 0x9c, 0x0d, 0x1a, 0x35, 0x1c, 0x1f,
-	0x42, 0xe0, 0x94, 0xdb, 0x51, 0xcc, 0x04, 0x33, 0x8a, 0x3f, 0x3b, 0x7b, 0x83, 0x46, 0x8d, 0x52,
-	0xc1, 0x67, 0x3e, 0x4b, 0x28, 0x8e, 0x44, 0x8a, 0x5d, 0x3a, 0xf4, 0x18, 0x0f, 0x18, 0xef, 0xaa,
-	0x85, 0x1a, 0xd2, 0xd5, 0xc9, 0x8e, 0xb8, 0x88, 0xc4, 0x24, 0x48, 0x49, 0xd5, 0x67, 0x84, 0xf3,
-	0x6d, 0x95, 0x7f, 0x23, 0x88, 0x00, 0xe3, 0x12, 0xe7, 0x14, 0xc1, 0x44, 0x15, 0x54, 0xdb, 0x3b,
-	0xb3, 0xec, 0xdf, 0xfb, 0xd8, 0x9d, 0x84, 0xd5, 0xd4, 0x67, 0x8b, 0xb2, 0xe6, 0xa6, 0x1a, 0xe3,
-	0x02, 0x67, 0xb9, 0xb4, 0x31, 0xff, 0x25, 0xe2, 0xe3, 0x5d, 0xe2, 0x24, 0x2b, 0xd5, 0x2a, 0x45,
-	0xf5, 0x0b, 0xe1, 0xac, 0xaa, 0x70, 0x87, 0x0f, 0x7a, 0x84, 0x43, 0xd7, 0x27, 0xf2, 0x5d, 0xd4,
-	0x83, 0xa4, 0xca, 0xff, 0x66, 0x43, 0xd2, 0x3f, 0x16, 0xe5, 0x23, 0xf5, 0x4c, 0xde, 0x1f, 0xda,
-	0x94, 0x39, 0x01, 0x11, 0x03, 0xfb, 0x1a, 0x7c, 0xe2, 0x4d, 0x5b, 0xe0, 0xbd, 0xbd, 0xd6, 0x71,
-	0xfa, 0x0b, 0x2d, 0xf0, 0xdc, 0xbc, 0x34, 0x6a, 0x13, 0xde, 0x91, 0x36, 0xc6, 0x2d, 0xde, 0x7f,
-	0x04, 0x12, 0x87, 0x34, 0xf4, 0xbb, 0xf1, 0xba, 0xe5, 0xdf, 0x7c, 0xd7, 0x3e, 0xae, 0x2c, 0x5c,
-	0xc4, 0xb9, 0x31, 0x0d, 0xfb, 0x6c, 0x6c, 0x66, 0x2a, 0x99, 0x9a, 0xee, 0xa6, 0x93, 0x51, 0xc0,
-	0x59, 0x1a, 0xf6, 0x61, 0x62, 0xea, 0x15, 0x54, 0xd3, 0x5d, 0x35, 0x34, 0xaf, 0x66, 0x4b, 0x0b,
-	0xcd, 0x97, 0x16, 0xfa, 0x5c, 0x5a, 0xe8, 0x65, 0x65, 0x69, 0xf3, 0x95, 0xa5, 0xbd, 0xaf, 0x2c,
-	0xed, 0xde, 0xf1, 0xa9, 0x18, 0x3c, 0xf5, 0x6c, 0x8f, 0x05, 0x0e, 0x1f, 0xd2, 0xa8, 0x1e, 0xc0,
-	0x68, 0xeb, 0x76, 0x93, 0x2d, 0x2c, 0xa6, 0x11, 0xf0, 0x5e, 0x2e, 0x39, 0xe2, 0xf9, 0x77, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xd7, 0xb3, 0xeb, 0x34, 0x5a, 0x02, 0x00, 0x00,
+	// 863 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xce, 0xc6, 0x1f, 0x8d, 0xdf, 0x34, 0x69, 0x33, 0xb4, 0xd5, 0xa6, 0x08, 0xc7, 0x18, 0x0a,
+	0x16, 0x28, 0xbb, 0x72, 0x10, 0x07, 0x04, 0x27, 0x13, 0x9a, 0x20, 0x21, 0x14, 0x16, 0x0a, 0x15,
+	0x07, 0x56, 0xe3, 0xdd, 0x37, 0xeb, 0x91, 0xbd, 0x3b, 0xab, 0x9d, 0x71, 0x3e, 0xfa, 0x03, 0x38,
+	0x73, 0xe3, 0x2f, 0x20, 0x4e, 0x1c, 0x38, 0x21, 0x7e, 0x40, 0x8f, 0x15, 0x27, 0xc4, 0xa1, 0xa0,
+	0x44, 0x82, 0xbf, 0x81, 0xe6, 0x63, 0xfd, 0x51, 0x6a, 0x1f, 0x5a, 0x7a, 0xb1, 0xe7, 0xfd, 0x7a,
+	0xde, 0x99, 0x67, 0xde, 0x67, 0x07, 0x5e, 0x3f, 0x46, 0x4c, 0x69, 0x31, 0x44, 0xe9, 0x4f, 0x57,
+	0x27, 0x5d, 0x3f, 0xc1, 0x0c, 0x05, 0x13, 0x5e, 0x5e, 0x70, 0xc9, 0xc9, 0xad, 0x49, 0xcc, 0x9b,
+	0xae, 0x4e, 0xba, 0xb7, 0x6f, 0x24, 0x3c, 0xe1, 0x3a, 0xc5, 0x57, 0x2b, 0x93, 0x7d, 0x7b, 0x3b,
+	0xe2, 0x22, 0xe5, 0x22, 0x34, 0x01, 0x63, 0xd8, 0x50, 0xd3, 0x58, 0x7e, 0x9f, 0x0a, 0xf4, 0x4f,
+	0xba, 0x7d, 0x94, 0xb4, 0xeb, 0x47, 0x9c, 0x65, 0x36, 0xbe, 0x45, 0x53, 0x96, 0x71, 0x5f, 0xff,
+	0x5a, 0xd7, 0x6b, 0x0b, 0x76, 0x98, 0xd3, 0x82, 0xa6, 0x16, 0xb7, 0xfd, 0x6b, 0x0d, 0xae, 0x1e,
+	0x98, 0x2d, 0x7f, 0x2e, 0xa9, 0x44, 0xf2, 0x01, 0xd4, 0x4d, 0x82, 0xeb, 0xb4, 0x9c, 0xce, 0xfa,
+	0x5e, 0xd3, 0x7b, 0xfa, 0x11, 0xbc, 0x23, 0x9d, 0xd5, 0xab, 0x3e, 0x7c, 0xbc, 0xb3, 0x12, 0xd8,
+	0x1a, 0xf2, 0x1e, 0xd4, 0x84, 0x82, 0x71, 0x57, 0x75, 0xf1, 0x2b, 0x8b, 0x8a, 0x75, 0x2f, 0x5b,
+	0x6b, 0x2a, 0xc8, 0x1d, 0xd8, 0xc4, 0x8c, 0xf6, 0x47, 0x18, 0x87, 0x03, 0x64, 0xc9, 0x40, 0xba,
+	0x95, 0x96, 0xd3, 0xa9, 0x04, 0x1b, 0xd6, 0x7b, 0xa8, 0x9d, 0xe4, 0x3e, 0x5c, 0x17, 0xd1, 0x00,
+	0xe3, 0xb1, 0x4a, 0xb4, 0x3b, 0xad, 0xb6, 0x2a, 0x9d, 0xf5, 0xbd, 0x37, 0x17, 0x36, 0x2b, 0xf3,
+	0xe7, 0xb6, 0x7c, 0x4d, 0xcc, 0xbb, 0xc9, 0x2d, 0xa8, 0x1f, 0x17, 0xfc, 0x01, 0x66, 0x6e, 0xad,
+	0xe5, 0x74, 0xd6, 0x02, 0x6b, 0x91, 0xfb, 0xb0, 0x95, 0x50, 0x75, 0x29, 0x2c, 0xc2, 0x70, 0xc0,
+	0x84, 0xe4, 0xc5, 0xb9, 0x5b, 0xd7, 0x2d, 0xdf, 0x58, 0xd4, 0xf2, 0x80, 0x8a, 0x23, 0x95, 0x1f,
+	0x60, 0xc4, 0x8b, 0xb8, 0xec, 0x98, 0x58, 0xef, 0xa1, 0x01, 0x21, 0x9f, 0xc2, 0xba, 0x64, 0x79,
+	0x58, 0xe8, 0x24, 0xe1, 0x5e, 0x59, 0x7e, 0x8c, 0xde, 0x88, 0x47, 0xc3, 0x2f, 0x58, 0x6e, 0x30,
+	0xcb, 0x63, 0x80, 0x9c, 0x78, 0xc8, 0x5d, 0x00, 0x71, 0x8a, 0xb9, 0x0c, 0x8f, 0x11, 0x85, 0xbb,
+	0xa6, 0xe1, 0x5e, 0x5d, 0xc8, 0x8a, 0xca, 0xbc, 0x8b, 0x58, 0x02, 0x35, 0x44, 0xe9, 0x20, 0x87,
+	0xb0, 0x1e, 0x63, 0xc6, 0xd3, 0xb0, 0xa0, 0x12, 0x85, 0xdb, 0x58, 0x0e, 0xb4, 0xaf, 0x52, 0x83,
+	0xe9, 0x7d, 0x42, 0x5c, 0x3a, 0x04, 0xf9, 0x06, 0x6e, 0x8e, 0xa8, 0x90, 0xa1, 0x81, 0x9b, 0xd0,
+	0x28, 0x5c, 0xd0, 0x98, 0x77, 0x96, 0x62, 0x96, 0x24, 0x5a, 0x5c, 0xa2, 0x90, 0xe6, 0x02, 0xa2,
+	0xfd, 0xb7, 0x03, 0x35, 0x33, 0xb7, 0x5f, 0xc1, 0xa6, 0xd2, 0xc6, 0xb4, 0x87, 0x9e, 0xdf, 0x46,
+	0xaf, 0xab, 0x6a, 0xff, 0x78, 0xbc, 0xf3, 0xb2, 0x11, 0x90, 0x88, 0x87, 0x1e, 0xe3, 0x7e, 0x4a,
+	0xe5, 0xc0, 0xfb, 0x04, 0x13, 0x1a, 0x9d, 0xef, 0x63, 0xf4, 0xdb, 0xcf, 0xbb, 0x60, 0xc2, 0xde,
+	0x3e, 0x46, 0xc1, 0x55, 0x05, 0x54, 0xf6, 0x20, 0x5f, 0xc2, 0xc6, 0x08, 0x69, 0x91, 0xb1, 0x2c,
+	0x09, 0x8b, 0x72, 0xb4, 0x9f, 0x0d, 0xb7, 0xc4, 0x51, 0xdc, 0xa8, 0x71, 0x3b, 0x65, 0x59, 0xcc,
+	0x4f, 0xdd, 0x4a, 0xab, 0xd2, 0xa9, 0x06, 0xd6, 0x22, 0x37, 0xa0, 0xc6, 0xb2, 0x18, 0xcf, 0xdc,
+	0x6a, 0xcb, 0xe9, 0x54, 0x03, 0x63, 0xb4, 0x7f, 0x59, 0x85, 0xcd, 0xf9, 0xa1, 0x52, 0x00, 0x56,
+	0x28, 0x8e, 0x16, 0x8a, 0xb5, 0x9e, 0xc2, 0xc4, 0xea, 0x0b, 0x62, 0xa2, 0xf2, 0xff, 0x30, 0xf1,
+	0x36, 0x6c, 0xf5, 0xd5, 0x6c, 0x87, 0x63, 0xc9, 0x46, 0xec, 0x01, 0x95, 0x8c, 0x67, 0xf6, 0xf4,
+	0xd7, 0x75, 0xe0, 0xde, 0xd4, 0x4f, 0xf6, 0xe0, 0x66, 0x4a, 0xcf, 0xc2, 0xff, 0x16, 0xd4, 0x74,
+	0xc1, 0x4b, 0x29, 0x3d, 0xeb, 0x3d, 0x51, 0xd3, 0x3e, 0x87, 0xc6, 0x44, 0x37, 0xe4, 0x33, 0x23,
+	0xba, 0x1c, 0x0b, 0xc5, 0xd0, 0xb3, 0x4f, 0x49, 0x43, 0xb2, 0xfc, 0x08, 0x8b, 0x03, 0x2a, 0xc8,
+	0x36, 0xac, 0x29, 0xb2, 0xc7, 0x02, 0x63, 0xcd, 0x75, 0x35, 0xb8, 0x92, 0x50, 0x71, 0x4f, 0x60,
+	0xdc, 0x3e, 0x86, 0x6b, 0x4f, 0xe8, 0x76, 0xe1, 0xbd, 0xbd, 0x0f, 0x55, 0xc9, 0x72, 0xe1, 0xae,
+	0x2e, 0x97, 0xdb, 0x04, 0xc9, 0xca, 0x42, 0x17, 0xb5, 0xbf, 0x77, 0xa0, 0x31, 0x51, 0x34, 0x69,
+	0x29, 0x01, 0x0b, 0xc9, 0x32, 0x43, 0x8d, 0x3e, 0x63, 0x30, 0xeb, 0x22, 0x03, 0xa8, 0xd3, 0x94,
+	0x8f, 0x33, 0x69, 0xdb, 0x6d, 0x7b, 0xf6, 0x68, 0xea, 0xc6, 0x3d, 0xfb, 0xc0, 0x78, 0x1f, 0x72,
+	0x96, 0xf5, 0xde, 0x55, 0x6d, 0x7e, 0xfc, 0x73, 0xa7, 0x93, 0x30, 0x39, 0x18, 0xf7, 0xbd, 0x88,
+	0xa7, 0xf6, 0x6d, 0xb2, 0x7f, 0xbb, 0x22, 0x1e, 0xfa, 0xf2, 0x3c, 0x47, 0xa1, 0x0b, 0xc4, 0x0f,
+	0xff, 0xfc, 0xf4, 0x96, 0x13, 0x58, 0xfc, 0xf6, 0x00, 0x1a, 0x93, 0x2f, 0x84, 0x1a, 0x6e, 0xfd,
+	0x29, 0xb0, 0x5b, 0x32, 0x06, 0xf9, 0x08, 0xaa, 0xcf, 0xa7, 0x2c, 0x5d, 0xde, 0xfe, 0xd6, 0x81,
+	0x8d, 0xb9, 0xef, 0xc3, 0x82, 0x76, 0x07, 0x50, 0x7b, 0x4e, 0x5d, 0x98, 0xfa, 0x99, 0x9b, 0xac,
+	0xcc, 0xde, 0x64, 0xef, 0xe3, 0x87, 0x17, 0x4d, 0xe7, 0xd1, 0x45, 0xd3, 0xf9, 0xeb, 0xa2, 0xe9,
+	0x7c, 0x77, 0xd9, 0x5c, 0x79, 0x74, 0xd9, 0x5c, 0xf9, 0xfd, 0xb2, 0xb9, 0xf2, 0xb5, 0x3f, 0xc3,
+	0xa1, 0x18, 0xb2, 0x7c, 0x37, 0xc5, 0x93, 0x99, 0xd7, 0xf9, 0x6c, 0x66, 0xad, 0x09, 0xed, 0xd7,
+	0xf5, 0x33, 0xfd, 0xce, 0xbf, 0x03, 0x00, 0xad, 0x62, 0x99, 0xfd, 0x6f, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -202,6 +661,105 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LastDenomGasPrices) > 0 {
+		for iNdEx := len(m.LastDenomGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastDenomGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.DenomRates) > 0 {
+		for iNdEx := len(m.DenomRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.SweptFees) > 0 {
+		for iNdEx := len(m.SweptFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SweptFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.TipRecords) > 0 {
+		for iNdEx := len(m.TipRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TipRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.GasPriceHistory) > 0 {
+		for iNdEx := len(m.GasPriceHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasPriceHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ScheduledParams) > 0 {
+		for iNdEx := len(m.ScheduledParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.EnabledHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EnabledHeight))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -291,15 +849,282 @@ func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
+func (m *GasPriceRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPriceRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPriceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxBlockUtilization != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxBlockUtilization))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BlockUtilization != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockUtilization))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.LearningRate.Size()
+		i -= size
+		if _, err := m.LearningRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BaseGasPrice.Size()
+		i -= size
+		if _, err := m.BaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TipRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TipRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TipRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.TipPerGas.Size()
+		i -= size
+		if _, err := m.TipPerGas.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockTipRecords) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockTipRecords) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockTipRecords) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tips) > 0 {
+		for iNdEx := len(m.Tips) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tips[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SweptFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SweptFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SweptFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomGasPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomGasPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomGasPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
 	return base
 }
 func (m *GenesisState) Size() (n int) {
@@ -312,6 +1137,48 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.State.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.EnabledHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.EnabledHeight))
+	}
+	if len(m.ScheduledParams) > 0 {
+		for _, e := range m.ScheduledParams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Frozen {
+		n += 2
+	}
+	if len(m.GasPriceHistory) > 0 {
+		for _, e := range m.GasPriceHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TipRecords) > 0 {
+		for _, e := range m.TipRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SweptFees) > 0 {
+		for _, e := range m.SweptFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomRates) > 0 {
+		for _, e := range m.DenomRates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LastDenomGasPrices) > 0 {
+		for _, e := range m.LastDenomGasPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -330,21 +1197,1080 @@ func (m *State) Size() (n int) {
 		for _, e := range m.Window {
 			l += sovGenesis(uint64(e))
 		}
-		n += 1 + sovGenesis(uint64(l)) + l
-	}
-	if m.Index != 0 {
-		n += 1 + sovGenesis(uint64(m.Index))
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if m.Index != 0 {
+		n += 1 + sovGenesis(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *GasPriceRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = m.BaseGasPrice.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.LearningRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.BlockUtilization != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockUtilization))
+	}
+	if m.MaxBlockUtilization != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxBlockUtilization))
+	}
+	return n
+}
+
+func (m *TipRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TipPerGas.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.GasUsed != 0 {
+		n += 1 + sovGenesis(uint64(m.GasUsed))
+	}
+	return n
+}
+
+func (m *BlockTipRecords) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	if len(m.Tips) > 0 {
+		for _, e := range m.Tips {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SweptFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *DenomGasPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnabledHeight", wireType)
+			}
+			m.EnabledHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EnabledHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledParams = append(m.ScheduledParams, ScheduledParams{})
+			if err := m.ScheduledParams[len(m.ScheduledParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasPriceHistory = append(m.GasPriceHistory, GasPriceRecord{})
+			if err := m.GasPriceHistory[len(m.GasPriceHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TipRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TipRecords = append(m.TipRecords, BlockTipRecords{})
+			if err := m.TipRecords[len(m.TipRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SweptFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SweptFees = append(m.SweptFees, SweptFees{})
+			if err := m.SweptFees[len(m.SweptFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomRates = append(m.DenomRates, DenomRate{})
+			if err := m.DenomRates[len(m.DenomRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDenomGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastDenomGasPrices = append(m.LastDenomGasPrices, DenomGasPrice{})
+			if err := m.LastDenomGasPrices[len(m.LastDenomGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *State) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: State: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LearningRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Window = append(m.Window, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Window) == 0 {
+					m.Window = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Window = append(m.Window, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPriceRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPriceRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPriceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LearningRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockUtilization", wireType)
+			}
+			m.BlockUtilization = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockUtilization |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockUtilization", wireType)
+			}
+			m.MaxBlockUtilization = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockUtilization |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TipRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TipRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TipRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TipPerGas", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TipPerGas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockTipRecords) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockTipRecords: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockTipRecords: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tips", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tips = append(m.Tips, TipRecord{})
+			if err := m.Tips[len(m.Tips)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *SweptFees) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -367,17 +2293,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: SweptFees: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SweptFees: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -387,28 +2313,27 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -435,7 +2360,8 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -460,7 +2386,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *State) Unmarshal(dAtA []byte) error {
+func (m *DenomRate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -483,15 +2409,15 @@ func (m *State) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: State: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomRate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomRate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseGasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -519,13 +2445,11 @@ func (m *State) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LearningRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -553,91 +2477,131 @@ func (m *State) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomGasPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomGasPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomGasPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
 				}
-				m.Window = append(m.Window, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenesis
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthGenesis
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthGenesis
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Window) == 0 {
-					m.Window = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenesis
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Window = append(m.Window, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.Index = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -647,7 +2611,7 @@ func (m *State) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3,6 +3,8 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/skip-mev/feemarket/x/feemarket/types"
@@ -18,4 +20,143 @@ func TestGenesis(t *testing.T) {
 		gs := types.DefaultAIMDGenesisState()
 		require.NoError(t, gs.ValidateBasic())
 	})
+	t.Run("can accept a genesis state with keeper data", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.EnabledHeight = 5
+		gs.ScheduledParams = []types.ScheduledParams{{Height: 10, Params: types.DefaultParams()}}
+		gs.GasPriceHistory = []types.GasPriceRecord{{Height: 4, BaseGasPrice: math.LegacyOneDec(), LearningRate: math.LegacyOneDec()}}
+		gs.TipRecords = []types.BlockTipRecords{{Height: 4, Tips: []types.TipRecord{{TipPerGas: math.LegacyOneDec(), GasUsed: 1}}}}
+		gs.SweptFees = []types.SweptFees{{Destination: types.FeeSplitDestinationBurn, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 1))}}
+		gs.DenomRates = []types.DenomRate{{Denom: "atom", Rate: math.LegacyMustNewDecFromStr("0.5")}}
+		gs.LastDenomGasPrices = []types.DenomGasPrice{{Denom: "atom", Price: math.LegacyOneDec(), Height: 4}}
+		require.NoError(t, gs.ValidateBasic())
+	})
+
+	t.Run("rejects invalid keeper data", func(t *testing.T) {
+		testCases := []struct {
+			name     string
+			malleate func(*types.GenesisState)
+		}{
+			{
+				name: "enabled height below -1",
+				malleate: func(gs *types.GenesisState) {
+					gs.EnabledHeight = -2
+				},
+			},
+			{
+				name: "unordered scheduled params",
+				malleate: func(gs *types.GenesisState) {
+					gs.ScheduledParams = []types.ScheduledParams{
+						{Height: 10, Params: types.DefaultParams()},
+						{Height: 10, Params: types.DefaultParams()},
+					}
+				},
+			},
+			{
+				name: "invalid scheduled params",
+				malleate: func(gs *types.GenesisState) {
+					params := types.DefaultParams()
+					params.Window = 0
+					gs.ScheduledParams = []types.ScheduledParams{{Height: 10, Params: params}}
+				},
+			},
+			{
+				name: "gas price record without height",
+				malleate: func(gs *types.GenesisState) {
+					gs.GasPriceHistory = []types.GasPriceRecord{{BaseGasPrice: math.LegacyOneDec(), LearningRate: math.LegacyOneDec()}}
+				},
+			},
+			{
+				name: "gas price record without base gas price",
+				malleate: func(gs *types.GenesisState) {
+					gs.GasPriceHistory = []types.GasPriceRecord{{Height: 1, LearningRate: math.LegacyOneDec()}}
+				},
+			},
+			{
+				name: "unordered tip records",
+				malleate: func(gs *types.GenesisState) {
+					gs.TipRecords = []types.BlockTipRecords{{Height: 2}, {Height: 1}}
+				},
+			},
+			{
+				name: "negative tip per gas",
+				malleate: func(gs *types.GenesisState) {
+					gs.TipRecords = []types.BlockTipRecords{{Height: 1, Tips: []types.TipRecord{{TipPerGas: math.LegacyNewDec(-1)}}}}
+				},
+			},
+			{
+				name: "duplicate swept fees destination",
+				malleate: func(gs *types.GenesisState) {
+					gs.SweptFees = []types.SweptFees{
+						{Destination: types.FeeSplitDestinationBurn},
+						{Destination: types.FeeSplitDestinationBurn},
+					}
+				},
+			},
+			{
+				name: "invalid swept fees",
+				malleate: func(gs *types.GenesisState) {
+					gs.SweptFees = []types.SweptFees{
+						{Destination: types.FeeSplitDestinationBurn, Amount: sdk.Coins{sdk.Coin{Denom: "stake", Amount: math.NewInt(-1)}}},
+					}
+				},
+			},
+			{
+				name: "duplicate denom rate",
+				malleate: func(gs *types.GenesisState) {
+					gs.DenomRates = []types.DenomRate{
+						{Denom: "atom", Rate: math.LegacyOneDec()},
+						{Denom: "atom", Rate: math.LegacyNewDec(2)},
+					}
+				},
+			},
+			{
+				name: "non-positive denom rate",
+				malleate: func(gs *types.GenesisState) {
+					gs.DenomRates = []types.DenomRate{{Denom: "atom", Rate: math.LegacyZeroDec()}}
+				},
+			},
+			{
+				name: "invalid denom rate denom",
+				malleate: func(gs *types.GenesisState) {
+					gs.DenomRates = []types.DenomRate{{Denom: "!", Rate: math.LegacyOneDec()}}
+				},
+			},
+			{
+				name: "denom rate for the fee denom",
+				malleate: func(gs *types.GenesisState) {
+					gs.DenomRates = []types.DenomRate{{Denom: gs.Params.FeeDenom, Rate: math.LegacyOneDec()}}
+				},
+			},
+			{
+				name: "duplicate last denom gas price",
+				malleate: func(gs *types.GenesisState) {
+					gs.LastDenomGasPrices = []types.DenomGasPrice{
+						{Denom: "atom", Price: math.LegacyOneDec()},
+						{Denom: "atom", Price: math.LegacyOneDec()},
+					}
+				},
+			},
+			{
+				name: "negative last denom gas price",
+				malleate: func(gs *types.GenesisState) {
+					gs.LastDenomGasPrices = []types.DenomGasPrice{{Denom: "atom", Price: math.LegacyNewDec(-1)}}
+				},
+			},
+			{
+				name: "negative last denom gas price height",
+				malleate: func(gs *types.GenesisState) {
+					gs.LastDenomGasPrices = []types.DenomGasPrice{{Denom: "atom", Price: math.LegacyOneDec(), Height: -1}}
+				},
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				gs := types.DefaultGenesisState()
+				tc.malleate(gs)
+				require.Error(t, gs.ValidateBasic())
+			})
+		}
+	})
 }
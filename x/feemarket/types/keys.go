@@ -1,5 +1,9 @@
 package types
 
+import (
+	"cosmossdk.io/collections"
+)
+
 const (
 	// ModuleName is the name of the feemarket module.
 	ModuleName = "feemarket"
@@ -13,22 +17,87 @@ const (
 const (
 	prefixParams = iota + 1
 	prefixState
-	prefixEnableHeight = 3
+	prefixEnableHeight    = 3
+	prefixScheduledParams = 4
+	prefixFrozen          = 5
+	prefixGasPriceHistory = 6
+	prefixTipRecords      = 7
+	prefixSweptFees       = 8
+	prefixDenomRates      = 9
+	prefixLastGasPrices   = 10
 )
 
 var (
-	// KeyParams is the store key for the feemarket module's parameters.
-	KeyParams = []byte{prefixParams}
+	// ParamsKey is the store key for the feemarket module's parameters.
+	ParamsKey = collections.NewPrefix(prefixParams)
+
+	// StateKey is the store key for the feemarket module's data.
+	StateKey = collections.NewPrefix(prefixState)
+
+	// EnabledHeightKey is the store key for the feemarket module's enabled height.
+	EnabledHeightKey = collections.NewPrefix(prefixEnableHeight)
+
+	// ScheduledParamsPrefix is the store key prefix for the feemarket module's
+	// scheduled parameters, keyed by height.
+	ScheduledParamsPrefix = collections.NewPrefix(prefixScheduledParams)
+
+	// FrozenKey is the store key that is set while the feemarket module is frozen.
+	FrozenKey = collections.NewPrefix(prefixFrozen)
+
+	// GasPriceHistoryPrefix is the store key prefix for the feemarket module's gas
+	// price records, keyed by height.
+	GasPriceHistoryPrefix = collections.NewPrefix(prefixGasPriceHistory)
+
+	// TipRecordsPrefix is the store key prefix for the feemarket module's tip
+	// records, keyed by height and the index of the tip in the block.
+	TipRecordsPrefix = collections.NewPrefix(prefixTipRecords)
+
+	// SweptFeesPrefix is the store key prefix for the cumulative fees swept from the
+	// feemarket fee collector, keyed by destination.
+	SweptFeesPrefix = collections.NewPrefix(prefixSweptFees)
 
-	// KeyState is the store key for the feemarket module's data.
-	KeyState = []byte{prefixState}
+	// DenomRatesPrefix is the store key prefix for the static conversion rates of the
+	// denoms that fees can be paid in, keyed by denom.
+	DenomRatesPrefix = collections.NewPrefix(prefixDenomRates)
 
-	// KeyEnabledHeight is the store key for the feemarket module's enabled height.
-	KeyEnabledHeight = []byte{prefixEnableHeight}
+	// LastDenomGasPricesPrefix is the store key prefix for the last gas prices that were
+	// resolved for the extra denoms of the denom resolver, keyed by denom.
+	LastDenomGasPricesPrefix = collections.NewPrefix(prefixLastGasPrices)
+)
 
+var (
 	EventTypeFeePay      = "fee_pay"
 	EventTypeTipPay      = "tip_pay"
 	AttributeKeyTip      = "tip"
 	AttributeKeyTipPayer = "tip_payer"
 	AttributeKeyTipPayee = "tip_payee"
+
+	EventTypeBaseGasPriceClamped     = "base_gas_price_clamped"
+	AttributeKeyPreviousBaseGasPrice = "previous_base_gas_price"
+	AttributeKeyComputedBaseGasPrice = "computed_base_gas_price"
+	AttributeKeyBaseGasPrice         = "base_gas_price"
+
+	EventTypeScheduledParamsApplied   = "scheduled_params_applied"
+	EventTypeScheduledParamsFailed    = "scheduled_params_failed"
+	EventTypeScheduledParamsCancelled = "scheduled_params_cancelled"
+	AttributeKeyHeight                = "height"
+	AttributeKeyError                 = "error"
+
+	EventTypeBaseGasPriceSet   = "base_gas_price_set"
+	EventTypeFeeMarketFrozen   = "fee_market_frozen"
+	EventTypeFeeMarketUnfrozen = "fee_market_unfrozen"
+
+	EventTypeFeeRefund   = "fee_refund"
+	AttributeKeyRefund   = "refund"
+	AttributeKeyRefundee = "refundee"
+
+	EventTypeFeeSplit       = "fee_split"
+	AttributeKeyDestination = "destination"
+
+	EventTypeFeeCollectorSwept = "fee_collector_swept"
+
+	EventTypeDenomRateSet = "denom_rate_set"
+	EventTypeDenomRemoved = "denom_removed"
+	AttributeKeyDenom     = "denom"
+	AttributeKeyRate      = "rate"
 )
@@ -1,10 +1,24 @@
 package types
 
 import (
+	fmt "fmt"
+
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var _ sdk.Msg = &MsgParams{}
+var (
+	_ sdk.Msg = &MsgParams{}
+	_ sdk.Msg = &MsgUpdateParamsPartial{}
+	_ sdk.Msg = &MsgScheduleParams{}
+	_ sdk.Msg = &MsgCancelScheduledParams{}
+	_ sdk.Msg = &MsgSetBaseGasPrice{}
+	_ sdk.Msg = &MsgFreezeFeeMarket{}
+	_ sdk.Msg = &MsgUnfreezeFeeMarket{}
+	_ sdk.Msg = &MsgSweepFeeCollector{}
+	_ sdk.Msg = &MsgSetDenomRate{}
+	_ sdk.Msg = &MsgRemoveDenom{}
+)
 
 // NewMsgParams returns a new message to update the x/feemarket module's parameters.
 func NewMsgParams(authority string, params Params) MsgParams {
@@ -31,3 +45,255 @@ func (m *MsgParams) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgUpdateParamsPartial returns a new message to update the given fields of the
+// x/feemarket module's parameters.
+func NewMsgUpdateParamsPartial(authority string, params Params, fields []string) MsgUpdateParamsPartial {
+	return MsgUpdateParamsPartial{
+		Authority: authority,
+		Params:    params,
+		Fields:    fields,
+	}
+}
+
+// GetSigners implements GetSigners for the msg.
+func (m *MsgUpdateParamsPartial) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the authority is a valid acc-address and all fields name a parameter.
+func (m *MsgUpdateParamsPartial) ValidateBasic() error {
+	// validate authority address
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return err
+	}
+
+	return ValidateParamsFields(m.Fields)
+}
+
+// NewMsgScheduleParams returns a new message to schedule a change of the x/feemarket
+// module's parameters at the given height.
+func NewMsgScheduleParams(authority string, height int64, params Params) MsgScheduleParams {
+	return MsgScheduleParams{
+		Authority: authority,
+		Height:    height,
+		Params:    params,
+	}
+}
+
+// GetSigners implements GetSigners for the msg.
+func (m *MsgScheduleParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the authority is a valid acc-address, the height is positive and the params are valid.
+func (m *MsgScheduleParams) ValidateBasic() error {
+	// validate authority address
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return err
+	}
+
+	if m.Height <= 0 {
+		return fmt.Errorf("height must be positive")
+	}
+
+	return m.Params.ValidateBasic()
+}
+
+// NewMsgCancelScheduledParams returns a new message to cancel the change of the
+// x/feemarket module's parameters scheduled at the given height.
+func NewMsgCancelScheduledParams(authority string, height int64) MsgCancelScheduledParams {
+	return MsgCancelScheduledParams{
+		Authority: authority,
+		Height:    height,
+	}
+}
+
+// GetSigners implements GetSigners for the msg.
+func (m *MsgCancelScheduledParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the authority is a valid acc-address and the height is positive.
+func (m *MsgCancelScheduledParams) ValidateBasic() error {
+	// validate authority address
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return err
+	}
+
+	if m.Height <= 0 {
+		return fmt.Errorf("height must be positive")
+	}
+
+	return nil
+}
+
+// NewMsgSetBaseGasPrice returns a new message to override the base gas price of the
+// x/feemarket module.
+func NewMsgSetBaseGasPrice(authority string, baseGasPrice math.LegacyDec) MsgSetBaseGasPrice {
+	return MsgSetBaseGasPrice{
+		Authority:    authority,
+		BaseGasPrice: baseGasPrice,
+	}
+}
+
+// GetSigners implements GetSigners for the msg.
+func (m *MsgSetBaseGasPrice) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the authority is a valid acc-address and the base gas price is positive.
+func (m *MsgSetBaseGasPrice) ValidateBasic() error {
+	// validate authority address
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return err
+	}
+
+	if m.BaseGasPrice.IsNil() || !m.BaseGasPrice.IsPositive() {
+		return fmt.Errorf("base gas price must be positive")
+	}
+
+	return nil
+}
+
+// NewMsgFreezeFeeMarket returns a new message to freeze the x/feemarket module.
+func NewMsgFreezeFeeMarket(authority string) MsgFreezeFeeMarket {
+	return MsgFreezeFeeMarket{
+		Authority: authority,
+	}
+}
+
+// GetSigners implements GetSigners for the msg.
+func (m *MsgFreezeFeeMarket) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the authority is a valid acc-address.
+func (m *MsgFreezeFeeMarket) ValidateBasic() error {
+	// validate authority address
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	return err
+}
+
+// NewMsgUnfreezeFeeMarket returns a new message to unfreeze the x/feemarket module.
+func NewMsgUnfreezeFeeMarket(authority string) MsgUnfreezeFeeMarket {
+	return MsgUnfreezeFeeMarket{
+		Authority: authority,
+	}
+}
+
+// GetSigners implements GetSigners for the msg.
+func (m *MsgUnfreezeFeeMarket) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the authority is a valid acc-address.
+func (m *MsgUnfreezeFeeMarket) ValidateBasic() error {
+	// validate authority address
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	return err
+}
+
+// NewMsgSweepFeeCollector returns a new message to sweep the given amount of fees from the
+// feemarket fee collector to the given destination.
+func NewMsgSweepFeeCollector(authority, destination string, amount sdk.Coins) MsgSweepFeeCollector {
+	return MsgSweepFeeCollector{
+		Authority:   authority,
+		Destination: destination,
+		Amount:      amount,
+	}
+}
+
+// GetSigners implements GetSigners for the msg.
+func (m *MsgSweepFeeCollector) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the authority is a valid acc-address, the destination is set and the amount is valid.
+func (m *MsgSweepFeeCollector) ValidateBasic() error {
+	// validate authority address
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return err
+	}
+
+	if m.Destination == "" {
+		return fmt.Errorf("destination cannot be empty")
+	}
+
+	return m.Amount.Validate()
+}
+
+// NewMsgSetDenomRate returns a new message to set the static conversion rate of the given
+// denom.
+func NewMsgSetDenomRate(authority, denom string, rate math.LegacyDec) MsgSetDenomRate {
+	return MsgSetDenomRate{
+		Authority: authority,
+		Denom:     denom,
+		Rate:      rate,
+	}
+}
+
+// GetSigners implements GetSigners for the msg.
+func (m *MsgSetDenomRate) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the authority is a valid acc-address, the denom is valid and the rate is positive.
+func (m *MsgSetDenomRate) ValidateBasic() error {
+	// validate authority address
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return err
+	}
+
+	rate := DenomRate{Denom: m.Denom, Rate: m.Rate}
+	return rate.ValidateBasic()
+}
+
+// NewMsgRemoveDenom returns a new message to remove the given denom and its static
+// conversion rate.
+func NewMsgRemoveDenom(authority, denom string) MsgRemoveDenom {
+	return MsgRemoveDenom{
+		Authority: authority,
+		Denom:     denom,
+	}
+}
+
+// GetSigners implements GetSigners for the msg.
+func (m *MsgRemoveDenom) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the authority is a valid acc-address and the denom is valid.
+func (m *MsgRemoveDenom) ValidateBasic() error {
+	// validate authority address
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return err
+	}
+
+	return sdk.ValidateDenom(m.Denom)
+}
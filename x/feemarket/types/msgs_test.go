@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
@@ -22,3 +23,182 @@ func TestMsgParams(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestMsgUpdateParamsPartial(t *testing.T) {
+	t.Run("should reject a message with an invalid authority address", func(t *testing.T) {
+		msg := types.NewMsgUpdateParamsPartial("invalid", types.DefaultParams(), []string{"alpha"})
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should reject a message without fields", func(t *testing.T) {
+		msg := types.NewMsgUpdateParamsPartial(sdk.AccAddress("test").String(), types.DefaultParams(), nil)
+		err := msg.ValidateBasic()
+		require.ErrorIs(t, err, types.ErrInvalidParamsField)
+	})
+
+	t.Run("should reject a message with an unknown field", func(t *testing.T) {
+		msg := types.NewMsgUpdateParamsPartial(sdk.AccAddress("test").String(), types.DefaultParams(), []string{"unknown"})
+		err := msg.ValidateBasic()
+		require.ErrorIs(t, err, types.ErrInvalidParamsField)
+	})
+
+	t.Run("should accept a message with known fields", func(t *testing.T) {
+		msg := types.NewMsgUpdateParamsPartial(sdk.AccAddress("test").String(), types.DefaultParams(), []string{"alpha", "fee_denom"})
+		err := msg.ValidateBasic()
+		require.NoError(t, err)
+	})
+}
+
+func TestMsgScheduleParams(t *testing.T) {
+	t.Run("should reject a message with an invalid authority address", func(t *testing.T) {
+		msg := types.NewMsgScheduleParams("invalid", 10, types.DefaultParams())
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should reject a message with a non-positive height", func(t *testing.T) {
+		msg := types.NewMsgScheduleParams(sdk.AccAddress("test").String(), 0, types.DefaultParams())
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should reject a message with invalid params", func(t *testing.T) {
+		msg := types.NewMsgScheduleParams(sdk.AccAddress("test").String(), 10, types.Params{})
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should accept a valid message", func(t *testing.T) {
+		msg := types.NewMsgScheduleParams(sdk.AccAddress("test").String(), 10, types.DefaultParams())
+		err := msg.ValidateBasic()
+		require.NoError(t, err)
+	})
+}
+
+func TestMsgCancelScheduledParams(t *testing.T) {
+	t.Run("should reject a message with an invalid authority address", func(t *testing.T) {
+		msg := types.NewMsgCancelScheduledParams("invalid", 10)
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should reject a message with a non-positive height", func(t *testing.T) {
+		msg := types.NewMsgCancelScheduledParams(sdk.AccAddress("test").String(), 0)
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should accept a valid message", func(t *testing.T) {
+		msg := types.NewMsgCancelScheduledParams(sdk.AccAddress("test").String(), 10)
+		err := msg.ValidateBasic()
+		require.NoError(t, err)
+	})
+}
+
+func TestMsgSetBaseGasPrice(t *testing.T) {
+	t.Run("should reject a message with an invalid authority address", func(t *testing.T) {
+		msg := types.NewMsgSetBaseGasPrice("invalid", math.LegacyOneDec())
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should reject a message with a non-positive base gas price", func(t *testing.T) {
+		msg := types.NewMsgSetBaseGasPrice(sdk.AccAddress("test").String(), math.LegacyZeroDec())
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should accept a valid message", func(t *testing.T) {
+		msg := types.NewMsgSetBaseGasPrice(sdk.AccAddress("test").String(), math.LegacyOneDec())
+		err := msg.ValidateBasic()
+		require.NoError(t, err)
+	})
+}
+
+func TestMsgFreezeFeeMarket(t *testing.T) {
+	t.Run("should reject a message with an invalid authority address", func(t *testing.T) {
+		freeze := types.NewMsgFreezeFeeMarket("invalid")
+		require.Error(t, freeze.ValidateBasic())
+
+		unfreeze := types.NewMsgUnfreezeFeeMarket("invalid")
+		require.Error(t, unfreeze.ValidateBasic())
+	})
+
+	t.Run("should accept a valid message", func(t *testing.T) {
+		freeze := types.NewMsgFreezeFeeMarket(sdk.AccAddress("test").String())
+		require.NoError(t, freeze.ValidateBasic())
+
+		unfreeze := types.NewMsgUnfreezeFeeMarket(sdk.AccAddress("test").String())
+		require.NoError(t, unfreeze.ValidateBasic())
+	})
+}
+
+func TestMsgSweepFeeCollector(t *testing.T) {
+	t.Run("should reject a message with an invalid authority address", func(t *testing.T) {
+		msg := types.NewMsgSweepFeeCollector("invalid", types.FeeSplitDestinationBurn, nil)
+		require.Error(t, msg.ValidateBasic())
+	})
+
+	t.Run("should reject a message without destination", func(t *testing.T) {
+		msg := types.NewMsgSweepFeeCollector(sdk.AccAddress("test").String(), "", nil)
+		require.Error(t, msg.ValidateBasic())
+	})
+
+	t.Run("should reject a message with an invalid amount", func(t *testing.T) {
+		amount := sdk.Coins{sdk.Coin{Denom: "stake", Amount: math.NewInt(-1)}}
+		msg := types.NewMsgSweepFeeCollector(sdk.AccAddress("test").String(), types.FeeSplitDestinationBurn, amount)
+		require.Error(t, msg.ValidateBasic())
+	})
+
+	t.Run("should accept a valid message", func(t *testing.T) {
+		msg := types.NewMsgSweepFeeCollector(sdk.AccAddress("test").String(), types.FeeSplitDestinationBurn, nil)
+		require.NoError(t, msg.ValidateBasic())
+
+		amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+		msg = types.NewMsgSweepFeeCollector(sdk.AccAddress("test").String(), types.FeeSplitDestinationCommunityPool, amount)
+		require.NoError(t, msg.ValidateBasic())
+	})
+}
+
+func TestMsgSetDenomRate(t *testing.T) {
+	t.Run("should reject a message with an invalid authority address", func(t *testing.T) {
+		msg := types.NewMsgSetDenomRate("invalid", "atom", math.LegacyOneDec())
+		require.Error(t, msg.ValidateBasic())
+	})
+
+	t.Run("should reject a message with an invalid denom", func(t *testing.T) {
+		msg := types.NewMsgSetDenomRate(sdk.AccAddress("test").String(), "!", math.LegacyOneDec())
+		require.Error(t, msg.ValidateBasic())
+	})
+
+	t.Run("should reject a message with a non-positive rate", func(t *testing.T) {
+		msg := types.NewMsgSetDenomRate(sdk.AccAddress("test").String(), "atom", math.LegacyZeroDec())
+		require.Error(t, msg.ValidateBasic())
+
+		msg = types.NewMsgSetDenomRate(sdk.AccAddress("test").String(), "atom", math.LegacyDec{})
+		require.Error(t, msg.ValidateBasic())
+	})
+
+	t.Run("should accept a valid message", func(t *testing.T) {
+		msg := types.NewMsgSetDenomRate(sdk.AccAddress("test").String(), "atom", math.LegacyMustNewDecFromStr("0.5"))
+		require.NoError(t, msg.ValidateBasic())
+	})
+}
+
+func TestMsgRemoveDenom(t *testing.T) {
+	t.Run("should reject a message with an invalid authority address", func(t *testing.T) {
+		msg := types.NewMsgRemoveDenom("invalid", "atom")
+		require.Error(t, msg.ValidateBasic())
+	})
+
+	t.Run("should reject a message with an invalid denom", func(t *testing.T) {
+		msg := types.NewMsgRemoveDenom(sdk.AccAddress("test").String(), "")
+		require.Error(t, msg.ValidateBasic())
+	})
+
+	t.Run("should accept a valid message", func(t *testing.T) {
+		msg := types.NewMsgRemoveDenom(sdk.AccAddress("test").String(), "atom")
+		require.NoError(t, msg.ValidateBasic())
+	})
+}
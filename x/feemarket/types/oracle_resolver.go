@@ -0,0 +1,202 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// OraclePrice is a price reported by an OracleKeeper.
+type OraclePrice struct {
+	// Price is the amount of the quote currency per unit of the base currency of the
+	// currency pair.
+	Price math.LegacyDec
+	// Timestamp is the block time at which the price was last updated.
+	Timestamp time.Time
+}
+
+// OracleFallbackPolicy determines how the OracleDenomResolver converts a denom whose
+// oracle price is missing or stale.
+type OracleFallbackPolicy int
+
+const (
+	// OracleFallbackReject fails the conversion, so that fees cannot be paid in the denom
+	// until the oracle reports a fresh price again.
+	OracleFallbackReject OracleFallbackPolicy = iota
+
+	// OracleFallbackStatic converts with the fallback price of the denom's currency pair.
+	OracleFallbackStatic
+)
+
+// OracleCurrencyPair maps a denom to the oracle currency pair that prices it. The
+// currency pairs of all denoms must be quoted in the same currency, e.g. "ATOM/USD"
+// and "OSMO/USD".
+type OracleCurrencyPair struct {
+	// Denom is the denom priced by the currency pair, e.g. "uatom".
+	Denom string
+	// CurrencyPair is the oracle currency pair, e.g. "ATOM/USD".
+	CurrencyPair string
+	// Exponent is the number of decimals of the base currency of the pair in the
+	// denom, e.g. 6 if the pair prices ATOM and the denom is uatom.
+	Exponent uint32
+	// FallbackPrice is the price of the currency pair that is used with the
+	// OracleFallbackStatic policy.
+	FallbackPrice math.LegacyDec
+}
+
+// OracleDenomResolverConfig configures an OracleDenomResolver.
+type OracleDenomResolverConfig struct {
+	// CurrencyPairs are the currency pairs of the denoms that can be converted. The
+	// fee denom of the fee market must be included.
+	CurrencyPairs []OracleCurrencyPair
+	// MaxPriceAge is the maximum time since the last update of a price for which the
+	// price is used. If zero, prices do not go stale.
+	MaxPriceAge time.Duration
+	// FallbackPolicy determines how a denom is converted if its price is missing or
+	// stale.
+	FallbackPolicy OracleFallbackPolicy
+}
+
+// ValidateBasic performs basic validation on the config.
+func (c OracleDenomResolverConfig) ValidateBasic() error {
+	if c.MaxPriceAge < 0 {
+		return fmt.Errorf("max price age cannot be negative")
+	}
+
+	if c.FallbackPolicy != OracleFallbackReject && c.FallbackPolicy != OracleFallbackStatic {
+		return fmt.Errorf("unknown fallback policy %d", c.FallbackPolicy)
+	}
+
+	seen := make(map[string]struct{}, len(c.CurrencyPairs))
+	for _, pair := range c.CurrencyPairs {
+		if err := sdk.ValidateDenom(pair.Denom); err != nil {
+			return err
+		}
+
+		if _, ok := seen[pair.Denom]; ok {
+			return fmt.Errorf("duplicate currency pair for denom %s", pair.Denom)
+		}
+		seen[pair.Denom] = struct{}{}
+
+		if pair.CurrencyPair == "" {
+			return fmt.Errorf("currency pair of denom %s cannot be empty", pair.Denom)
+		}
+
+		if c.FallbackPolicy == OracleFallbackStatic && (pair.FallbackPrice.IsNil() || !pair.FallbackPrice.IsPositive()) {
+			return fmt.Errorf("fallback price of denom %s must be positive", pair.Denom)
+		}
+	}
+
+	return nil
+}
+
+var _ DenomResolver = (*OracleDenomResolver)(nil)
+
+// OracleDenomResolver is a DenomResolver that converts between denoms with the prices
+// of an oracle, such as Slinky's x/oracle. A coin is converted by valuing it in the
+// common quote currency of the configured currency pairs.
+type OracleDenomResolver struct {
+	oracle OracleKeeper
+	config OracleDenomResolverConfig
+	pairs  map[string]OracleCurrencyPair
+}
+
+// NewOracleDenomResolver returns a new OracleDenomResolver that reads prices from the
+// given oracle. An error is returned if the config is invalid.
+func NewOracleDenomResolver(oracle OracleKeeper, config OracleDenomResolverConfig) (*OracleDenomResolver, error) {
+	if err := config.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	pairs := make(map[string]OracleCurrencyPair, len(config.CurrencyPairs))
+	for _, pair := range config.CurrencyPairs {
+		pairs[pair.Denom] = pair
+	}
+
+	return &OracleDenomResolver{
+		oracle: oracle,
+		config: config,
+		pairs:  pairs,
+	}, nil
+}
+
+// ConvertToDenom converts the given coin into the equivalent amount of denom.
+func (r *OracleDenomResolver) ConvertToDenom(ctx context.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error) {
+	if coin.Denom == denom {
+		return coin, nil
+	}
+
+	fromPair, fromPrice, err := r.pairPrice(ctx, coin.Denom)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	toPair, toPrice, err := r.pairPrice(ctx, denom)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	// amount * (fromPrice / 10^fromExponent) / (toPrice / 10^toExponent), ordered so
+	// that no precision is lost on the unit prices of denoms with many decimals.
+	amount := coin.Amount.Mul(fromPrice).MulInt(math.NewIntWithDecimal(1, int(toPair.Exponent))).
+		Quo(toPrice.MulInt(math.NewIntWithDecimal(1, int(fromPair.Exponent))))
+
+	return sdk.NewDecCoinFromDec(denom, amount), nil
+}
+
+// ExtraDenoms returns the sorted denoms of all configured currency pairs.
+func (r *OracleDenomResolver) ExtraDenoms(_ context.Context) ([]string, error) {
+	denoms := make([]string, 0, len(r.pairs))
+	for denom := range r.pairs {
+		denoms = append(denoms, denom)
+	}
+
+	sort.Strings(denoms)
+	return denoms, nil
+}
+
+// pairPrice returns the currency pair of the given denom and its price. If the oracle
+// price is unavailable, the fallback policy applies.
+func (r *OracleDenomResolver) pairPrice(ctx context.Context, denom string) (OracleCurrencyPair, math.LegacyDec, error) {
+	pair, ok := r.pairs[denom]
+	if !ok {
+		return OracleCurrencyPair{}, math.LegacyDec{}, ErrUnknownResolverDenom.Wrapf("%s", denom)
+	}
+
+	price, err := r.price(ctx, pair)
+	if err != nil {
+		if r.config.FallbackPolicy != OracleFallbackStatic {
+			return OracleCurrencyPair{}, math.LegacyDec{}, err
+		}
+
+		price = pair.FallbackPrice
+	}
+
+	return pair, price, nil
+}
+
+// price returns the oracle price of the given currency pair. An error is returned if the
+// price is missing or older than the maximum price age.
+func (r *OracleDenomResolver) price(ctx context.Context, pair OracleCurrencyPair) (math.LegacyDec, error) {
+	price, err := r.oracle.GetPrice(ctx, pair.CurrencyPair)
+	if err != nil {
+		return math.LegacyDec{}, ErrPriceUnavailable.Wrapf("%s: %s", pair.CurrencyPair, err)
+	}
+
+	if price.Price.IsNil() || !price.Price.IsPositive() {
+		return math.LegacyDec{}, ErrPriceUnavailable.Wrapf("%s: price is not positive", pair.CurrencyPair)
+	}
+
+	if r.config.MaxPriceAge > 0 {
+		age := sdk.UnwrapSDKContext(ctx).BlockTime().Sub(price.Timestamp)
+		if age > r.config.MaxPriceAge {
+			return math.LegacyDec{}, ErrPriceUnavailable.Wrapf("%s: price is %s old", pair.CurrencyPair, age)
+		}
+	}
+
+	return price.Price, nil
+}
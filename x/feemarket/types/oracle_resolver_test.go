@@ -0,0 +1,185 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+	"github.com/skip-mev/feemarket/x/feemarket/types/mocks"
+)
+
+func oracleResolverConfig(policy types.OracleFallbackPolicy) types.OracleDenomResolverConfig {
+	return types.OracleDenomResolverConfig{
+		CurrencyPairs: []types.OracleCurrencyPair{
+			{Denom: "ustake", CurrencyPair: "STAKE/USD", Exponent: 6, FallbackPrice: math.LegacyNewDec(2)},
+			{Denom: "uatom", CurrencyPair: "ATOM/USD", Exponent: 6, FallbackPrice: math.LegacyNewDec(8)},
+			{Denom: "aevmos", CurrencyPair: "EVMOS/USD", Exponent: 18, FallbackPrice: math.LegacyOneDec()},
+		},
+		MaxPriceAge:    time.Minute,
+		FallbackPolicy: policy,
+	}
+}
+
+func TestOracleDenomResolverConfig(t *testing.T) {
+	testCases := []struct {
+		name   string
+		modify func(*types.OracleDenomResolverConfig)
+		valid  bool
+	}{
+		{"valid config", func(*types.OracleDenomResolverConfig) {}, true},
+		{
+			"valid config without fallback prices",
+			func(c *types.OracleDenomResolverConfig) {
+				c.FallbackPolicy = types.OracleFallbackReject
+				for i := range c.CurrencyPairs {
+					c.CurrencyPairs[i].FallbackPrice = math.LegacyDec{}
+				}
+			},
+			true,
+		},
+		{"negative max price age", func(c *types.OracleDenomResolverConfig) { c.MaxPriceAge = -time.Second }, false},
+		{"unknown fallback policy", func(c *types.OracleDenomResolverConfig) { c.FallbackPolicy = 2 }, false},
+		{"invalid denom", func(c *types.OracleDenomResolverConfig) { c.CurrencyPairs[0].Denom = "" }, false},
+		{"duplicate denom", func(c *types.OracleDenomResolverConfig) { c.CurrencyPairs[1].Denom = "ustake" }, false},
+		{"empty currency pair", func(c *types.OracleDenomResolverConfig) { c.CurrencyPairs[0].CurrencyPair = "" }, false},
+		{"missing fallback price", func(c *types.OracleDenomResolverConfig) { c.CurrencyPairs[0].FallbackPrice = math.LegacyDec{} }, false},
+		{"zero fallback price", func(c *types.OracleDenomResolverConfig) { c.CurrencyPairs[0].FallbackPrice = math.LegacyZeroDec() }, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := oracleResolverConfig(types.OracleFallbackStatic)
+			tc.modify(&config)
+
+			_, err := types.NewOracleDenomResolver(mocks.NewOracleKeeper(t), config)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestOracleDenomResolver(t *testing.T) {
+	now := time.Unix(1700000000, 0).UTC()
+	ctx := sdk.Context{}.WithBlockTime(now)
+
+	fresh := func(price string) types.OraclePrice {
+		return types.OraclePrice{Price: math.LegacyMustNewDecFromStr(price), Timestamp: now.Add(-time.Second)}
+	}
+	stale := func(price string) types.OraclePrice {
+		return types.OraclePrice{Price: math.LegacyMustNewDecFromStr(price), Timestamp: now.Add(-2 * time.Minute)}
+	}
+
+	t.Run("converts with the oracle prices", func(t *testing.T) {
+		oracle := mocks.NewOracleKeeper(t)
+		oracle.On("GetPrice", mock.Anything, "STAKE/USD").Return(fresh("2.5"), nil)
+		oracle.On("GetPrice", mock.Anything, "ATOM/USD").Return(fresh("10"), nil)
+		oracle.On("GetPrice", mock.Anything, "EVMOS/USD").Return(fresh("0.5"), nil)
+
+		resolver, err := types.NewOracleDenomResolver(oracle, oracleResolverConfig(types.OracleFallbackReject))
+		require.NoError(t, err)
+
+		got, err := resolver.ConvertToDenom(ctx, sdk.NewDecCoin("ustake", math.NewInt(100)), "uatom")
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDecCoin("uatom", math.NewInt(25)), got)
+
+		// the exponents of the denoms are accounted for
+		got, err = resolver.ConvertToDenom(ctx, sdk.NewDecCoin("uatom", math.NewInt(1)), "aevmos")
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDecCoin("aevmos", math.NewInt(20_000_000_000_000)), got)
+	})
+
+	t.Run("does not convert coins of the same denom", func(t *testing.T) {
+		resolver, err := types.NewOracleDenomResolver(mocks.NewOracleKeeper(t), oracleResolverConfig(types.OracleFallbackReject))
+		require.NoError(t, err)
+
+		coin := sdk.NewDecCoin("unknown", math.NewInt(100))
+		got, err := resolver.ConvertToDenom(ctx, coin, "unknown")
+		require.NoError(t, err)
+		require.Equal(t, coin, got)
+	})
+
+	t.Run("rejects unknown denoms", func(t *testing.T) {
+		oracle := mocks.NewOracleKeeper(t)
+		oracle.On("GetPrice", mock.Anything, "STAKE/USD").Return(fresh("2.5"), nil).Maybe()
+
+		resolver, err := types.NewOracleDenomResolver(oracle, oracleResolverConfig(types.OracleFallbackStatic))
+		require.NoError(t, err)
+
+		_, err = resolver.ConvertToDenom(ctx, sdk.NewDecCoin("ustake", math.NewInt(100)), "unknown")
+		require.ErrorIs(t, err, types.ErrUnknownResolverDenom)
+
+		_, err = resolver.ConvertToDenom(ctx, sdk.NewDecCoin("unknown", math.NewInt(100)), "ustake")
+		require.ErrorIs(t, err, types.ErrUnknownResolverDenom)
+	})
+
+	unavailable := []struct {
+		name  string
+		price types.OraclePrice
+		err   error
+	}{
+		{"stale price", stale("10"), nil},
+		{"missing price", types.OraclePrice{}, fmt.Errorf("no price for currency pair")},
+		{"zero price", fresh("0"), nil},
+	}
+
+	for _, tc := range unavailable {
+		t.Run(tc.name+" is rejected", func(t *testing.T) {
+			oracle := mocks.NewOracleKeeper(t)
+			oracle.On("GetPrice", mock.Anything, "STAKE/USD").Return(fresh("2.5"), nil)
+			oracle.On("GetPrice", mock.Anything, "ATOM/USD").Return(tc.price, tc.err)
+
+			resolver, err := types.NewOracleDenomResolver(oracle, oracleResolverConfig(types.OracleFallbackReject))
+			require.NoError(t, err)
+
+			_, err = resolver.ConvertToDenom(ctx, sdk.NewDecCoin("ustake", math.NewInt(100)), "uatom")
+			require.ErrorIs(t, err, types.ErrPriceUnavailable)
+		})
+
+		t.Run(tc.name+" falls back to the static price", func(t *testing.T) {
+			oracle := mocks.NewOracleKeeper(t)
+			oracle.On("GetPrice", mock.Anything, "STAKE/USD").Return(fresh("2.5"), nil)
+			oracle.On("GetPrice", mock.Anything, "ATOM/USD").Return(tc.price, tc.err)
+
+			resolver, err := types.NewOracleDenomResolver(oracle, oracleResolverConfig(types.OracleFallbackStatic))
+			require.NoError(t, err)
+
+			got, err := resolver.ConvertToDenom(ctx, sdk.NewDecCoin("ustake", math.NewInt(100)), "uatom")
+			require.NoError(t, err)
+			require.Equal(t, sdk.NewDecCoinFromDec("uatom", math.LegacyMustNewDecFromStr("31.25")), got)
+		})
+	}
+
+	t.Run("prices do not go stale without a max price age", func(t *testing.T) {
+		oracle := mocks.NewOracleKeeper(t)
+		oracle.On("GetPrice", mock.Anything, "STAKE/USD").Return(stale("2.5"), nil)
+		oracle.On("GetPrice", mock.Anything, "ATOM/USD").Return(stale("10"), nil)
+
+		config := oracleResolverConfig(types.OracleFallbackReject)
+		config.MaxPriceAge = 0
+
+		resolver, err := types.NewOracleDenomResolver(oracle, config)
+		require.NoError(t, err)
+
+		got, err := resolver.ConvertToDenom(ctx, sdk.NewDecCoin("ustake", math.NewInt(100)), "uatom")
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDecCoin("uatom", math.NewInt(25)), got)
+	})
+
+	t.Run("returns the configured denoms", func(t *testing.T) {
+		resolver, err := types.NewOracleDenomResolver(mocks.NewOracleKeeper(t), oracleResolverConfig(types.OracleFallbackReject))
+		require.NoError(t, err)
+
+		denoms, err := resolver.ExtraDenoms(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []string{"aevmos", "uatom", "ustake"}, denoms)
+	})
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	fmt "fmt"
+	"sort"
 
 	"cosmossdk.io/math"
 )
@@ -58,6 +59,11 @@ func (p *Params) ValidateBasic() error {
 		return fmt.Errorf("delta cannot be nil and must be between [0, inf)")
 	}
 
+	// the base EIP-1559 update rule has no additive adjustment, see EIP1559PricingAlgorithm
+	if p.PricingAlgorithm == EIP1559PricingAlgorithmName && !p.Delta.IsZero() {
+		return fmt.Errorf("delta must be zero for the %s pricing algorithm", EIP1559PricingAlgorithmName)
+	}
+
 	if p.MinBaseGasPrice.IsNil() || !p.MinBaseGasPrice.GTE(math.LegacyZeroDec()) {
 		return fmt.Errorf("min base gas price cannot be nil and must be greater than or equal to zero")
 	}
@@ -82,10 +88,149 @@ func (p *Params) ValidateBasic() error {
 		return fmt.Errorf("fee denom must be set")
 	}
 
+	// a zero ratio selects the default target, see TargetUtilizationRatio
+	if !p.TargetBlockUtilizationRatio.IsNil() {
+		if p.TargetBlockUtilizationRatio.IsNegative() || p.TargetBlockUtilizationRatio.GTE(math.LegacyOneDec()) {
+			return fmt.Errorf("target block utilization ratio must be between [0, 1)")
+		}
+	}
+
+	if p.TargetBlockUtilization() == 0 {
+		return fmt.Errorf("target block utilization cannot be zero")
+	}
+
+	if !p.MaxBaseGasPrice.IsNil() {
+		if p.MaxBaseGasPrice.IsNegative() {
+			return fmt.Errorf("max base gas price cannot be negative")
+		}
+
+		if p.MaxBaseGasPrice.IsPositive() && p.MaxBaseGasPrice.LT(p.MinBaseGasPrice) {
+			return fmt.Errorf("max base gas price cannot be less than min base gas price")
+		}
+	}
+
+	if !p.MaxChangePerBlock.IsNil() {
+		if p.MaxChangePerBlock.IsNegative() || p.MaxChangePerBlock.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("max change per block must be between [0, 1]")
+		}
+	}
+
+	if err := ValidateTipDestination(p.TipDestination); err != nil {
+		return err
+	}
+
+	if err := p.validateFeeSplit(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
-// TargetBlockUtilization returns 0.5 * MaxBlockUtilization.
+// TargetUtilizationRatio returns the fraction of MaxBlockUtilization that the fee
+// market targets. If TargetBlockUtilizationRatio is unset or zero, 0.5 is returned.
+func (p *Params) TargetUtilizationRatio() math.LegacyDec {
+	if p.TargetBlockUtilizationRatio.IsNil() || p.TargetBlockUtilizationRatio.IsZero() {
+		return DefaultTargetBlockUtilizationRatio
+	}
+
+	return p.TargetBlockUtilizationRatio
+}
+
+// TargetBlockUtilization returns TargetUtilizationRatio * MaxBlockUtilization.
 func (p *Params) TargetBlockUtilization() uint64 {
-	return p.MaxBlockUtilization / 2
+	maxUtilization := math.LegacyNewDecFromInt(math.NewIntFromUint64(p.MaxBlockUtilization))
+	return maxUtilization.Mul(p.TargetUtilizationRatio()).TruncateInt().Uint64()
+}
+
+// HasMaxBaseGasPrice returns true if the base gas price is capped by MaxBaseGasPrice.
+func (p *Params) HasMaxBaseGasPrice() bool {
+	return !p.MaxBaseGasPrice.IsNil() && p.MaxBaseGasPrice.IsPositive()
+}
+
+// HasMaxChangePerBlock returns true if the change of the base gas price in a single
+// block is limited by MaxChangePerBlock.
+func (p *Params) HasMaxChangePerBlock() bool {
+	return !p.MaxChangePerBlock.IsNil() && p.MaxChangePerBlock.IsPositive()
+}
+
+// LearningRateThresholds returns the average utilization thresholds outside of which
+// the AIMD learning rate is additively increased. The thresholds are 2 * gamma * target
+// and 1 - 2 * gamma * (1 - target), which is [gamma, 1 - gamma] for a 50% target.
+func (p *Params) LearningRateThresholds() (lower, upper math.LegacyDec) {
+	target := p.TargetUtilizationRatio()
+	two := math.LegacyNewDec(2)
+
+	lower = two.Mul(p.Gamma).Mul(target)
+	upper = math.LegacyOneDec().Sub(two.Mul(p.Gamma).Mul(math.LegacyOneDec().Sub(target)))
+
+	return lower, upper
+}
+
+// paramsFieldSetters maps the proto field name of every parameter to a function
+// that copies the parameter from src to dst.
+var paramsFieldSetters = map[string]func(dst *Params, src Params){
+	"alpha":                          func(dst *Params, src Params) { dst.Alpha = src.Alpha },
+	"beta":                           func(dst *Params, src Params) { dst.Beta = src.Beta },
+	"gamma":                          func(dst *Params, src Params) { dst.Gamma = src.Gamma },
+	"delta":                          func(dst *Params, src Params) { dst.Delta = src.Delta },
+	"min_base_gas_price":             func(dst *Params, src Params) { dst.MinBaseGasPrice = src.MinBaseGasPrice },
+	"min_learning_rate":              func(dst *Params, src Params) { dst.MinLearningRate = src.MinLearningRate },
+	"max_learning_rate":              func(dst *Params, src Params) { dst.MaxLearningRate = src.MaxLearningRate },
+	"max_block_utilization":          func(dst *Params, src Params) { dst.MaxBlockUtilization = src.MaxBlockUtilization },
+	"window":                         func(dst *Params, src Params) { dst.Window = src.Window },
+	"fee_denom":                      func(dst *Params, src Params) { dst.FeeDenom = src.FeeDenom },
+	"enabled":                        func(dst *Params, src Params) { dst.Enabled = src.Enabled },
+	"distribute_fees":                func(dst *Params, src Params) { dst.DistributeFees = src.DistributeFees },
+	"pricing_algorithm":              func(dst *Params, src Params) { dst.PricingAlgorithm = src.PricingAlgorithm },
+	"target_block_utilization_ratio": func(dst *Params, src Params) { dst.TargetBlockUtilizationRatio = src.TargetBlockUtilizationRatio },
+	"max_base_gas_price":             func(dst *Params, src Params) { dst.MaxBaseGasPrice = src.MaxBaseGasPrice },
+	"max_change_per_block":           func(dst *Params, src Params) { dst.MaxChangePerBlock = src.MaxChangePerBlock },
+	"history_retention":              func(dst *Params, src Params) { dst.HistoryRetention = src.HistoryRetention },
+	"tip_destination":                func(dst *Params, src Params) { dst.TipDestination = src.TipDestination },
+	"burn_ratio":                     func(dst *Params, src Params) { dst.BurnRatio = src.BurnRatio },
+	"staker_ratio":                   func(dst *Params, src Params) { dst.StakerRatio = src.StakerRatio },
+	"community_pool_ratio":           func(dst *Params, src Params) { dst.CommunityPoolRatio = src.CommunityPoolRatio },
+	"extra_fee_recipients":           func(dst *Params, src Params) { dst.ExtraFeeRecipients = src.ExtraFeeRecipients },
+}
+
+// ParamsFieldNames returns the sorted proto field names of all parameters that can
+// be updated with a partial parameter update.
+func ParamsFieldNames() []string {
+	names := make([]string, 0, len(paramsFieldSetters))
+	for name := range paramsFieldSetters {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// ValidateParamsFields returns an error if fields is empty or contains a name that
+// is not the proto field name of a parameter.
+func ValidateParamsFields(fields []string) error {
+	if len(fields) == 0 {
+		return ErrInvalidParamsField.Wrap("at least one field must be set")
+	}
+
+	for _, field := range fields {
+		if _, ok := paramsFieldSetters[field]; !ok {
+			return ErrInvalidParamsField.Wrapf("%s", field)
+		}
+	}
+
+	return nil
+}
+
+// MergeFields returns a copy of the params where the given fields, identified by
+// their proto field name, are replaced by the values in update.
+func (p Params) MergeFields(update Params, fields []string) (Params, error) {
+	if err := ValidateParamsFields(fields); err != nil {
+		return Params{}, err
+	}
+
+	for _, field := range fields {
+		paramsFieldSetters[field](&p, update)
+	}
+
+	return p, nil
 }
@@ -45,9 +45,9 @@ type Params struct {
 	//
 	// Must be [0, 0.5].
 	Gamma cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=gamma,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"gamma"`
-	// Delta is the amount we additively increase/decrease the base fee when the
+	// Delta is the amount we additively increase/decrease the gas price when the
 	// net block utilization difference in the window is above/below the target
-	// utilization.
+	// utilization. Must be zero for the eip1559 pricing algorithm.
 	Delta cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=delta,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"delta"`
 	// MinBaseGasPrice determines the initial gas price of the module and the
 	// global minimum for the network.
@@ -69,6 +69,53 @@ type Params struct {
 	// DistributeFees is a boolean that determines whether the fees are burned or
 	// distributed to all stakers.
 	DistributeFees bool `protobuf:"varint,12,opt,name=distribute_fees,json=distributeFees,proto3" json:"distribute_fees,omitempty"`
+	// PricingAlgorithm is the name of the registered pricing algorithm that is
+	// used to update the learning rate and base gas price at the end of every
+	// block. If empty, the AIMD pricing algorithm is used.
+	PricingAlgorithm string `protobuf:"bytes,13,opt,name=pricing_algorithm,json=pricingAlgorithm,proto3" json:"pricing_algorithm,omitempty"`
+	// TargetBlockUtilizationRatio is the fraction of MaxBlockUtilization that the
+	// fee market targets. Blocks above the target increase the base gas price and
+	// blocks below the target decrease it. If unset or zero, the target is 50% of
+	// MaxBlockUtilization.
+	//
+	// Must be [0, 1).
+	TargetBlockUtilizationRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,14,opt,name=target_block_utilization_ratio,json=targetBlockUtilizationRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"target_block_utilization_ratio"`
+	// MaxBaseGasPrice is the global maximum for the base gas price. If zero, the
+	// base gas price is not capped.
+	//
+	// Must be zero or >= MinBaseGasPrice.
+	MaxBaseGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,15,opt,name=max_base_gas_price,json=maxBaseGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_base_gas_price"`
+	// MaxChangePerBlock is the maximum fraction by which the base gas price can
+	// increase or decrease in a single block. If zero, the change per block is
+	// not limited.
+	//
+	// Must be [0, 1].
+	MaxChangePerBlock cosmossdk_io_math.LegacyDec `protobuf:"bytes,16,opt,name=max_change_per_block,json=maxChangePerBlock,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_change_per_block"`
+	// HistoryRetention is the number of most recent blocks for which a gas price
+	// record is kept. If zero, no gas price history is recorded.
+	HistoryRetention uint64 `protobuf:"varint,17,opt,name=history_retention,json=historyRetention,proto3" json:"history_retention,omitempty"`
+	// TipDestination determines where the priority tips are paid. If
+	// "operator", tips are sent to the account of the operator of the validator
+	// that proposed the block. If "distribution", tips are allocated to the
+	// proposing validator through x/distribution, so that its commission and
+	// delegator rewards apply. If empty, tips are sent to the operator.
+	TipDestination string `protobuf:"bytes,18,opt,name=tip_destination,json=tipDestination,proto3" json:"tip_destination,omitempty"`
+	// BurnRatio is the fraction of the base fees that is burned.
+	//
+	// If any of BurnRatio, StakerRatio, CommunityPoolRatio or ExtraFeeRecipients
+	// is set, the base fees are split between the destinations and the ratios
+	// must sum to 1. Otherwise, the base fees are handled as determined by
+	// DistributeFees.
+	BurnRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,19,opt,name=burn_ratio,json=burnRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"burn_ratio"`
+	// StakerRatio is the fraction of the base fees that is sent to the default
+	// fee collector and distributed to all stakers.
+	StakerRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,20,opt,name=staker_ratio,json=stakerRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"staker_ratio"`
+	// CommunityPoolRatio is the fraction of the base fees that is sent to the
+	// community pool.
+	CommunityPoolRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,21,opt,name=community_pool_ratio,json=communityPoolRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_pool_ratio"`
+	// ExtraFeeRecipients are additional module accounts that receive a fraction
+	// of the base fees.
+	ExtraFeeRecipients []FeeRecipient `protobuf:"bytes,22,rep,name=extra_fee_recipients,json=extraFeeRecipients,proto3" json:"extra_fee_recipients"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -139,8 +186,142 @@ func (m *Params) GetDistributeFees() bool {
 	return false
 }
 
+func (m *Params) GetPricingAlgorithm() string {
+	if m != nil {
+		return m.PricingAlgorithm
+	}
+	return ""
+}
+
+func (m *Params) GetHistoryRetention() uint64 {
+	if m != nil {
+		return m.HistoryRetention
+	}
+	return 0
+}
+
+func (m *Params) GetTipDestination() string {
+	if m != nil {
+		return m.TipDestination
+	}
+	return ""
+}
+
+func (m *Params) GetExtraFeeRecipients() []FeeRecipient {
+	if m != nil {
+		return m.ExtraFeeRecipients
+	}
+	return nil
+}
+
+// FeeRecipient is a module account that receives a fraction of the base fees.
+type FeeRecipient struct {
+	// Module is the name of the module account.
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// Ratio is the fraction of the base fees that is sent to the module account.
+	Ratio cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=ratio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"ratio"`
+}
+
+func (m *FeeRecipient) Reset()         { *m = FeeRecipient{} }
+func (m *FeeRecipient) String() string { return proto.CompactTextString(m) }
+func (*FeeRecipient) ProtoMessage()    {}
+func (*FeeRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3907de4df2e1c66e, []int{1}
+}
+func (m *FeeRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeRecipient.Merge(m, src)
+}
+func (m *FeeRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeRecipient proto.InternalMessageInfo
+
+func (m *FeeRecipient) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+// ScheduledParams is a set of parameters that is applied at the end of the
+// block with the given height.
+type ScheduledParams struct {
+	// Height is the block height at which the parameters are applied.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// Params are the parameters that are applied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *ScheduledParams) Reset()         { *m = ScheduledParams{} }
+func (m *ScheduledParams) String() string { return proto.CompactTextString(m) }
+func (*ScheduledParams) ProtoMessage()    {}
+func (*ScheduledParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3907de4df2e1c66e, []int{2}
+}
+func (m *ScheduledParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledParams.Merge(m, src)
+}
+func (m *ScheduledParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledParams proto.InternalMessageInfo
+
+func (m *ScheduledParams) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ScheduledParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "feemarket.feemarket.v1.Params")
+	proto.RegisterType((*FeeRecipient)(nil), "feemarket.feemarket.v1.FeeRecipient")
+	proto.RegisterType((*ScheduledParams)(nil), "feemarket.feemarket.v1.ScheduledParams")
 }
 
 func init() {
@@ -148,35 +329,54 @@ func init() {
 }
 
 var fileDescriptor_3907de4df2e1c66e = []byte{
-	// 447 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0x63, 0x48, 0xd2, 0x64, 0x41, 0x54, 0x2c, 0x50, 0x2d, 0xad, 0xe4, 0x46, 0x70, 0x20,
-	0x97, 0xda, 0x0a, 0xbc, 0x41, 0x14, 0xa8, 0x90, 0x7a, 0xa8, 0x2c, 0x71, 0x41, 0x02, 0x6b, 0x6c,
-	0x4f, 0x9c, 0x55, 0xbc, 0xbb, 0x96, 0x77, 0x93, 0xa6, 0x3c, 0x05, 0x0f, 0xc3, 0x43, 0xf4, 0x58,
-	0x71, 0x42, 0x1c, 0x2a, 0x94, 0xbc, 0x02, 0x0f, 0x80, 0x76, 0x1d, 0x48, 0xe1, 0x68, 0x6e, 0xff,
-	0x3f, 0x33, 0xff, 0xb7, 0xa3, 0x95, 0x86, 0x3c, 0x9f, 0x22, 0x0a, 0xa8, 0xe6, 0x68, 0xc2, 0x9d,
-	0x5a, 0x8e, 0xc2, 0x12, 0x2a, 0x10, 0x3a, 0x28, 0x2b, 0x65, 0x14, 0x3d, 0xf8, 0xd3, 0x0a, 0x76,
-	0x6a, 0x39, 0x3a, 0x7c, 0x9a, 0x2a, 0x2d, 0x94, 0x8e, 0xdd, 0x54, 0x58, 0x9b, 0x3a, 0x72, 0xf8,
-	0x38, 0x57, 0xb9, 0xaa, 0xeb, 0x56, 0xd5, 0xd5, 0x67, 0x3f, 0x3b, 0xa4, 0x7b, 0xee, 0xc8, 0xf4,
-	0x94, 0x74, 0xa0, 0x28, 0x67, 0xc0, 0xbc, 0x81, 0x37, 0xec, 0x8f, 0x47, 0x57, 0x37, 0xc7, 0xad,
-	0xef, 0x37, 0xc7, 0x47, 0x35, 0x45, 0x67, 0xf3, 0x80, 0xab, 0x50, 0x80, 0x99, 0x05, 0x67, 0x98,
-	0x43, 0x7a, 0x39, 0xc1, 0xf4, 0xeb, 0x97, 0x13, 0xb2, 0x7d, 0x64, 0x82, 0x69, 0x54, 0xe7, 0xe9,
-	0x6b, 0xd2, 0x4e, 0xd0, 0x00, 0xbb, 0xd3, 0x94, 0xe3, 0xe2, 0x76, 0x9f, 0x1c, 0x84, 0x00, 0x76,
-	0xb7, 0xf1, 0x3e, 0x2e, 0x6f, 0x41, 0x19, 0x16, 0x06, 0x58, 0xbb, 0x31, 0xc8, 0xe5, 0xe9, 0x47,
-	0x42, 0x05, 0x97, 0x71, 0x02, 0x1a, 0xe3, 0x1c, 0xec, 0x2f, 0xf3, 0x14, 0x59, 0xa7, 0x29, 0x75,
-	0x5f, 0x70, 0x39, 0x06, 0x8d, 0xa7, 0xa0, 0xcf, 0x2d, 0x89, 0x7e, 0x20, 0x0f, 0x2d, 0xbf, 0x40,
-	0xa8, 0x24, 0x97, 0x79, 0x5c, 0x81, 0x41, 0xd6, 0xfd, 0x1f, 0xfc, 0xd9, 0x16, 0x15, 0x81, 0xa9,
-	0xf1, 0xb0, 0xfa, 0x07, 0xbf, 0xd7, 0x1c, 0x0f, 0xab, 0xbf, 0xf0, 0x2f, 0xc9, 0x13, 0x8b, 0x4f,
-	0x0a, 0x95, 0xce, 0xe3, 0x85, 0xe1, 0x05, 0xff, 0x04, 0x86, 0x2b, 0xc9, 0x7a, 0x03, 0x6f, 0xd8,
-	0x8e, 0x1e, 0x09, 0x58, 0x8d, 0x6d, 0xef, 0xdd, 0xae, 0x45, 0x0f, 0x48, 0xf7, 0x82, 0xcb, 0x4c,
-	0x5d, 0xb0, 0xbe, 0x1b, 0xda, 0x3a, 0x7a, 0x44, 0xfa, 0x53, 0xc4, 0x38, 0x43, 0xa9, 0x04, 0x23,
-	0x76, 0xc5, 0xa8, 0x37, 0x45, 0x9c, 0x58, 0x4f, 0x19, 0xd9, 0x43, 0x09, 0x49, 0x81, 0x19, 0xbb,
-	0x37, 0xf0, 0x86, 0xbd, 0xe8, 0xb7, 0xa5, 0x2f, 0xc8, 0x7e, 0xc6, 0xb5, 0xa9, 0x78, 0xb2, 0x30,
-	0x18, 0x4f, 0x11, 0x35, 0xbb, 0xef, 0x26, 0x1e, 0xec, 0xca, 0x6f, 0x10, 0xf5, 0xf8, 0xed, 0xd5,
-	0xda, 0xf7, 0xae, 0xd7, 0xbe, 0xf7, 0x63, 0xed, 0x7b, 0x9f, 0x37, 0x7e, 0xeb, 0x7a, 0xe3, 0xb7,
-	0xbe, 0x6d, 0xfc, 0xd6, 0xfb, 0x30, 0xe7, 0x66, 0xb6, 0x48, 0x82, 0x54, 0x89, 0x50, 0xcf, 0x79,
-	0x79, 0x22, 0x70, 0x79, 0xeb, 0x10, 0x57, 0xb7, 0xb4, 0xb9, 0x2c, 0x51, 0x27, 0x5d, 0x77, 0x48,
-	0xaf, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0x33, 0xa4, 0xbf, 0xc2, 0xb8, 0x03, 0x00, 0x00,
+	// 746 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0x4f, 0x6f, 0x23, 0x35,
+	0x14, 0xc0, 0x13, 0x9a, 0xcd, 0x36, 0x4e, 0x68, 0x5a, 0x6f, 0x36, 0x32, 0x5b, 0x69, 0x36, 0x2a,
+	0x48, 0x44, 0x42, 0x9b, 0xa8, 0xe5, 0xca, 0x85, 0x10, 0x76, 0x85, 0xb4, 0x87, 0x68, 0x80, 0x0b,
+	0x02, 0x46, 0x9e, 0x99, 0x97, 0x19, 0x2b, 0xe3, 0xf1, 0xc8, 0x76, 0xd2, 0x84, 0x4f, 0xc1, 0x27,
+	0xe0, 0x53, 0xf0, 0x21, 0x7a, 0xac, 0x38, 0x21, 0x0e, 0x15, 0x6a, 0xbf, 0x08, 0xb2, 0x3d, 0x69,
+	0xd2, 0x02, 0x97, 0xe9, 0x25, 0xf2, 0x7b, 0xcf, 0xef, 0xf7, 0xfc, 0xfe, 0x64, 0x1e, 0xfa, 0x78,
+	0x0e, 0xc0, 0xa9, 0x5c, 0x80, 0x1e, 0xef, 0x4e, 0xab, 0xf3, 0x71, 0x41, 0x25, 0xe5, 0x6a, 0x54,
+	0x48, 0xa1, 0x05, 0xee, 0xdf, 0x9b, 0x46, 0xbb, 0xd3, 0xea, 0xfc, 0xd5, 0x47, 0x91, 0x50, 0x5c,
+	0xa8, 0xc0, 0xde, 0x1a, 0x3b, 0xc1, 0xb9, 0xbc, 0xea, 0x25, 0x22, 0x11, 0x4e, 0x6f, 0x4e, 0x4e,
+	0x7b, 0xf6, 0x5b, 0x07, 0x35, 0x67, 0x96, 0x8c, 0xdf, 0xa1, 0x67, 0x34, 0x2b, 0x52, 0x4a, 0xea,
+	0x83, 0xfa, 0xb0, 0x35, 0x39, 0xbf, 0xba, 0x79, 0x5d, 0xfb, 0xeb, 0xe6, 0xf5, 0xa9, 0xa3, 0xa8,
+	0x78, 0x31, 0x62, 0x62, 0xcc, 0xa9, 0x4e, 0x47, 0xef, 0x21, 0xa1, 0xd1, 0x66, 0x0a, 0xd1, 0x1f,
+	0xbf, 0xbf, 0x41, 0x65, 0x90, 0x29, 0x44, 0xbe, 0xf3, 0xc7, 0x5f, 0xa3, 0x46, 0x08, 0x9a, 0x92,
+	0x0f, 0xaa, 0x72, 0xac, 0xbb, 0x79, 0x4f, 0x42, 0x39, 0xa7, 0xe4, 0xa0, 0xf2, 0x7b, 0xac, 0xbf,
+	0x01, 0xc5, 0x90, 0x69, 0x4a, 0x1a, 0x95, 0x41, 0xd6, 0x1f, 0xff, 0x8c, 0x30, 0x67, 0x79, 0x10,
+	0x52, 0x05, 0x41, 0x42, 0x4d, 0x95, 0x59, 0x04, 0xe4, 0x59, 0x55, 0x6a, 0x97, 0xb3, 0x7c, 0x42,
+	0x15, 0xbc, 0xa3, 0x6a, 0x66, 0x48, 0xf8, 0x27, 0x74, 0x62, 0xf8, 0x19, 0x50, 0x99, 0xb3, 0x3c,
+	0x09, 0x24, 0xd5, 0x40, 0x9a, 0x4f, 0xc1, 0xbf, 0x2f, 0x51, 0x3e, 0xd5, 0x0e, 0x4f, 0xd7, 0x8f,
+	0xf0, 0xcf, 0xab, 0xe3, 0xe9, 0xfa, 0x01, 0xfe, 0x02, 0xbd, 0x34, 0xf8, 0x30, 0x13, 0xd1, 0x22,
+	0x58, 0x6a, 0x96, 0xb1, 0x5f, 0xa8, 0x66, 0x22, 0x27, 0x87, 0x83, 0xfa, 0xb0, 0xe1, 0xbf, 0xe0,
+	0x74, 0x3d, 0x31, 0xb6, 0xef, 0x77, 0x26, 0xdc, 0x47, 0xcd, 0x4b, 0x96, 0xc7, 0xe2, 0x92, 0xb4,
+	0xec, 0xa5, 0x52, 0xc2, 0xa7, 0xa8, 0x35, 0x07, 0x08, 0x62, 0xc8, 0x05, 0x27, 0xc8, 0x3c, 0xd1,
+	0x3f, 0x9c, 0x03, 0x4c, 0x8d, 0x8c, 0x09, 0x7a, 0x0e, 0x39, 0x0d, 0x33, 0x88, 0x49, 0x7b, 0x50,
+	0x1f, 0x1e, 0xfa, 0x5b, 0x11, 0x7f, 0x8a, 0xba, 0x31, 0x53, 0x5a, 0xb2, 0x70, 0xa9, 0x21, 0x98,
+	0x03, 0x28, 0xd2, 0xb1, 0x37, 0x8e, 0x76, 0xea, 0xb7, 0x00, 0x0a, 0x7f, 0x86, 0x4e, 0x4c, 0xf3,
+	0x4c, 0x15, 0x68, 0x96, 0x08, 0xc9, 0x74, 0xca, 0xc9, 0x87, 0x36, 0xce, 0x71, 0x69, 0xf8, 0x72,
+	0xab, 0xc7, 0x2b, 0xe4, 0x69, 0x2a, 0x13, 0xd0, 0xff, 0xce, 0xcd, 0xd4, 0x90, 0x09, 0x72, 0x54,
+	0xb5, 0x88, 0xa7, 0x0e, 0xfc, 0xb8, 0x2e, 0xbe, 0xf9, 0xb5, 0xe3, 0x46, 0xd7, 0x8f, 0xc7, 0xad,
+	0xfb, 0x94, 0x86, 0x3d, 0x18, 0xb7, 0x10, 0xf5, 0x0c, 0x3f, 0x4a, 0x69, 0x9e, 0x40, 0x50, 0x80,
+	0x74, 0xf9, 0x91, 0xe3, 0xaa, 0x11, 0xcc, 0x78, 0x7d, 0x65, 0x69, 0x33, 0x90, 0x36, 0x27, 0x53,
+	0xe8, 0x94, 0x29, 0x2d, 0xe4, 0x26, 0x90, 0xa0, 0x21, 0xb7, 0x03, 0x71, 0x62, 0x7b, 0x7d, 0x5c,
+	0x1a, 0xfc, 0xad, 0xde, 0xb4, 0x4f, 0xb3, 0x22, 0x88, 0x41, 0x69, 0x96, 0xbb, 0xd9, 0xc1, 0xb6,
+	0x27, 0x47, 0x9a, 0x15, 0xd3, 0x9d, 0x16, 0xcf, 0x10, 0x0a, 0x97, 0x72, 0x5b, 0xfd, 0x17, 0x55,
+	0xdf, 0xdb, 0x32, 0x10, 0x57, 0xeb, 0xef, 0x50, 0x47, 0x69, 0xba, 0x00, 0x59, 0x32, 0x7b, 0x55,
+	0x99, 0x6d, 0x87, 0x71, 0xd4, 0x08, 0xf5, 0x22, 0xc1, 0xf9, 0x32, 0x67, 0x7a, 0x13, 0x14, 0x42,
+	0x64, 0x25, 0xfd, 0x65, 0x55, 0x3a, 0xbe, 0xc7, 0xcd, 0x84, 0xc8, 0x5c, 0x90, 0x1f, 0x51, 0x0f,
+	0xd6, 0x5a, 0x52, 0x33, 0xef, 0x81, 0x84, 0x88, 0x15, 0x0c, 0x72, 0xad, 0x48, 0x7f, 0x70, 0x30,
+	0x6c, 0x5f, 0x7c, 0x32, 0xfa, 0xef, 0x55, 0x31, 0x7a, 0x0b, 0xe0, 0x6f, 0x2f, 0x4f, 0x1a, 0xe6,
+	0x29, 0x3e, 0xb6, 0x9c, 0x7d, 0x83, 0x3a, 0x13, 0xa8, 0xb3, 0xaf, 0x30, 0xff, 0x58, 0x2e, 0xe2,
+	0x65, 0x06, 0x6e, 0x4d, 0xf8, 0xa5, 0x64, 0x3e, 0xb2, 0x2e, 0xb7, 0xca, 0x5f, 0x7d, 0xe7, 0x7f,
+	0x96, 0xa0, 0xee, 0xb7, 0x51, 0x0a, 0x06, 0x1a, 0x97, 0x9b, 0xa9, 0x8f, 0x9a, 0x29, 0xb0, 0x24,
+	0xd5, 0x36, 0xe6, 0x81, 0x5f, 0x4a, 0xf8, 0x0b, 0xd4, 0x74, 0x5b, 0xd1, 0x06, 0x6d, 0x5f, 0x78,
+	0xff, 0x97, 0xab, 0xe3, 0x94, 0x59, 0x96, 0x3e, 0x93, 0x6f, 0xae, 0x6e, 0xbd, 0xfa, 0xf5, 0xad,
+	0x57, 0xff, 0xfb, 0xd6, 0xab, 0xff, 0x7a, 0xe7, 0xd5, 0xae, 0xef, 0xbc, 0xda, 0x9f, 0x77, 0x5e,
+	0xed, 0x87, 0x71, 0xc2, 0x74, 0xba, 0x0c, 0x47, 0x91, 0xe0, 0x63, 0xb5, 0x60, 0xc5, 0x1b, 0x0e,
+	0xab, 0xbd, 0x65, 0xbc, 0xde, 0x3b, 0xeb, 0x4d, 0x01, 0x2a, 0x6c, 0xda, 0x65, 0xfa, 0xf9, 0x3f,
+	0x03, 0x00, 0x2c, 0xde, 0x05, 0x8f, 0xbc, 0x07, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -199,6 +399,113 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExtraFeeRecipients) > 0 {
+		for iNdEx := len(m.ExtraFeeRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExtraFeeRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	{
+		size := m.CommunityPoolRatio.Size()
+		i -= size
+		if _, err := m.CommunityPoolRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	{
+		size := m.StakerRatio.Size()
+		i -= size
+		if _, err := m.StakerRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	{
+		size := m.BurnRatio.Size()
+		i -= size
+		if _, err := m.BurnRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if len(m.TipDestination) > 0 {
+		i -= len(m.TipDestination)
+		copy(dAtA[i:], m.TipDestination)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.TipDestination)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.HistoryRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.HistoryRetention))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	{
+		size := m.MaxChangePerBlock.Size()
+		i -= size
+		if _, err := m.MaxChangePerBlock.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.MaxBaseGasPrice.Size()
+		i -= size
+		if _, err := m.MaxBaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.TargetBlockUtilizationRatio.Size()
+		i -= size
+		if _, err := m.TargetBlockUtilizationRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if len(m.PricingAlgorithm) > 0 {
+		i -= len(m.PricingAlgorithm)
+		copy(dAtA[i:], m.PricingAlgorithm)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.PricingAlgorithm)))
+		i--
+		dAtA[i] = 0x6a
+	}
 	if m.DistributeFees {
 		i--
 		if m.DistributeFees {
@@ -309,6 +616,84 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Ratio.Size()
+		i -= size
+		if _, err := m.Ratio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -356,6 +741,64 @@ func (m *Params) Size() (n int) {
 	if m.DistributeFees {
 		n += 2
 	}
+	l = len(m.PricingAlgorithm)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.TargetBlockUtilizationRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxBaseGasPrice.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxChangePerBlock.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.HistoryRetention != 0 {
+		n += 2 + sovParams(uint64(m.HistoryRetention))
+	}
+	l = len(m.TipDestination)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	l = m.BurnRatio.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.StakerRatio.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.CommunityPoolRatio.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if len(m.ExtraFeeRecipients) > 0 {
+		for _, e := range m.ExtraFeeRecipients {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Ratio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *ScheduledParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovParams(uint64(m.Height))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -742,6 +1185,545 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.DistributeFees = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricingAlgorithm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PricingAlgorithm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockUtilizationRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetBlockUtilizationRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangePerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangePerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryRetention", wireType)
+			}
+			m.HistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TipDestination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TipDestination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakerRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPoolRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraFeeRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtraFeeRecipients = append(m.ExtraFeeRecipients, FeeRecipient{})
+			if err := m.ExtraFeeRecipients[len(m.ExtraFeeRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ratio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
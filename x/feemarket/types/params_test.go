@@ -1,14 +1,66 @@
 package types_test
 
 import (
+	"reflect"
+	"sort"
+	"strings"
 	"testing"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/skip-mev/feemarket/x/feemarket/types"
 )
 
+func TestParams_TargetBlockUtilization(t *testing.T) {
+	t.Run("defaults to half of max block utilization", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.TargetBlockUtilizationRatio = math.LegacyDec{}
+
+		require.Equal(t, params.MaxBlockUtilization/2, params.TargetBlockUtilization())
+
+		lower, upper := params.LearningRateThresholds()
+		require.True(t, params.Gamma.Equal(lower))
+		require.True(t, math.LegacyOneDec().Sub(params.Gamma).Equal(upper))
+	})
+
+	t.Run("a zero ratio selects the default target", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.TargetBlockUtilizationRatio = math.LegacyZeroDec()
+
+		require.NoError(t, params.ValidateBasic())
+		require.Equal(t, params.MaxBlockUtilization/2, params.TargetBlockUtilization())
+	})
+
+	t.Run("uses the configured ratio", func(t *testing.T) {
+		params := types.DefaultAIMDParams()
+		params.TargetBlockUtilizationRatio = math.LegacyMustNewDecFromStr("0.8")
+
+		require.Equal(t, uint64(24_000_000), params.TargetBlockUtilization())
+
+		lower, upper := params.LearningRateThresholds()
+		require.True(t, math.LegacyMustNewDecFromStr("0.4").Equal(lower))
+		require.True(t, math.LegacyMustNewDecFromStr("0.9").Equal(upper))
+	})
+}
+
+func TestParams_PricingAlgorithmDelta(t *testing.T) {
+	t.Run("rejects a non-zero delta for the eip1559 pricing algorithm", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.Delta = math.LegacyMustNewDecFromStr("0.1")
+
+		require.Error(t, params.ValidateBasic())
+	})
+
+	t.Run("accepts a non-zero delta for the aimd pricing algorithm", func(t *testing.T) {
+		params := types.DefaultAIMDParams()
+		params.Delta = math.LegacyMustNewDecFromStr("0.1")
+
+		require.NoError(t, params.ValidateBasic())
+	})
+}
+
 func TestParams(t *testing.T) {
 	testCases := []struct {
 		name        string
@@ -273,6 +325,261 @@ func TestParams(t *testing.T) {
 			},
 			expectedErr: true,
 		},
+		{
+			name: "target block utilization ratio is negative",
+			p: types.Params{
+				Window:                      1,
+				Alpha:                       math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                        math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:                       math.LegacyMustNewDecFromStr("0.1"),
+				Delta:                       math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization:         3,
+				MinBaseGasPrice:             math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:             math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:             math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:                    types.DefaultFeeDenom,
+				TargetBlockUtilizationRatio: math.LegacyMustNewDecFromStr("-0.1"),
+			},
+			expectedErr: true,
+		},
+		{
+			name: "target block utilization ratio is greater than 1",
+			p: types.Params{
+				Window:                      1,
+				Alpha:                       math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                        math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:                       math.LegacyMustNewDecFromStr("0.1"),
+				Delta:                       math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization:         3,
+				MinBaseGasPrice:             math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:             math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:             math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:                    types.DefaultFeeDenom,
+				TargetBlockUtilizationRatio: math.LegacyMustNewDecFromStr("1.1"),
+			},
+			expectedErr: true,
+		},
+		{
+			name: "target block utilization is zero",
+			p: types.Params{
+				Window:                      1,
+				Alpha:                       math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                        math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:                       math.LegacyMustNewDecFromStr("0.1"),
+				Delta:                       math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization:         3,
+				MinBaseGasPrice:             math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:             math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:             math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:                    types.DefaultFeeDenom,
+				TargetBlockUtilizationRatio: math.LegacyMustNewDecFromStr("0.1"),
+			},
+			expectedErr: true,
+		},
+		{
+			name: "valid target block utilization ratio",
+			p: types.Params{
+				Window:                      1,
+				Alpha:                       math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                        math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:                       math.LegacyMustNewDecFromStr("0.1"),
+				Delta:                       math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization:         3,
+				MinBaseGasPrice:             math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:             math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:             math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:                    types.DefaultFeeDenom,
+				TargetBlockUtilizationRatio: math.LegacyMustNewDecFromStr("0.8"),
+			},
+			expectedErr: false,
+		},
+		{
+			name: "target block utilization ratio of 0",
+			p: types.Params{
+				Window:                      1,
+				Alpha:                       math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                        math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:                       math.LegacyMustNewDecFromStr("0.1"),
+				Delta:                       math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization:         3,
+				MinBaseGasPrice:             math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:             math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:             math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:                    types.DefaultFeeDenom,
+				TargetBlockUtilizationRatio: math.LegacyZeroDec(),
+			},
+			expectedErr: false,
+		},
+		{
+			name: "target block utilization ratio of 1",
+			p: types.Params{
+				Window:                      1,
+				Alpha:                       math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                        math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:                       math.LegacyMustNewDecFromStr("0.1"),
+				Delta:                       math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization:         3,
+				MinBaseGasPrice:             math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:             math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:             math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:                    types.DefaultFeeDenom,
+				TargetBlockUtilizationRatio: math.LegacyMustNewDecFromStr("1.0"),
+			},
+			expectedErr: true,
+		},
+		{
+			name: "target block utilization ratio just below 1",
+			p: types.Params{
+				Window:                      1,
+				Alpha:                       math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                        math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:                       math.LegacyMustNewDecFromStr("0.1"),
+				Delta:                       math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization:         3,
+				MinBaseGasPrice:             math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:             math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:             math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:                    types.DefaultFeeDenom,
+				TargetBlockUtilizationRatio: math.LegacyMustNewDecFromStr("0.99"),
+			},
+			expectedErr: false,
+		},
+		{
+			name: "max base gas price is negative",
+			p: types.Params{
+				Window:              1,
+				Alpha:               math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:               math.LegacyMustNewDecFromStr("0.1"),
+				Delta:               math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization: 3,
+				MinBaseGasPrice:     math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:     math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:     math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:            types.DefaultFeeDenom,
+				MaxBaseGasPrice:     math.LegacyMustNewDecFromStr("-1"),
+			},
+			expectedErr: true,
+		},
+		{
+			name: "max base gas price is less than min base gas price",
+			p: types.Params{
+				Window:              1,
+				Alpha:               math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:               math.LegacyMustNewDecFromStr("0.1"),
+				Delta:               math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization: 3,
+				MinBaseGasPrice:     math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:     math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:     math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:            types.DefaultFeeDenom,
+				MaxBaseGasPrice:     math.LegacyMustNewDecFromStr("0.5"),
+			},
+			expectedErr: true,
+		},
+		{
+			name: "valid max base gas price",
+			p: types.Params{
+				Window:              1,
+				Alpha:               math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:               math.LegacyMustNewDecFromStr("0.1"),
+				Delta:               math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization: 3,
+				MinBaseGasPrice:     math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:     math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:     math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:            types.DefaultFeeDenom,
+				MaxBaseGasPrice:     math.LegacyMustNewDecFromStr("100"),
+			},
+			expectedErr: false,
+		},
+		{
+			name: "max change per block is negative",
+			p: types.Params{
+				Window:              1,
+				Alpha:               math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:               math.LegacyMustNewDecFromStr("0.1"),
+				Delta:               math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization: 3,
+				MinBaseGasPrice:     math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:     math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:     math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:            types.DefaultFeeDenom,
+				MaxChangePerBlock:   math.LegacyMustNewDecFromStr("-0.1"),
+			},
+			expectedErr: true,
+		},
+		{
+			name: "max change per block is greater than 1",
+			p: types.Params{
+				Window:              1,
+				Alpha:               math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:               math.LegacyMustNewDecFromStr("0.1"),
+				Delta:               math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization: 3,
+				MinBaseGasPrice:     math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:     math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:     math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:            types.DefaultFeeDenom,
+				MaxChangePerBlock:   math.LegacyMustNewDecFromStr("1.1"),
+			},
+			expectedErr: true,
+		},
+		{
+			name: "valid max change per block",
+			p: types.Params{
+				Window:              1,
+				Alpha:               math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:               math.LegacyMustNewDecFromStr("0.1"),
+				Delta:               math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization: 3,
+				MinBaseGasPrice:     math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:     math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:     math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:            types.DefaultFeeDenom,
+				MaxChangePerBlock:   math.LegacyMustNewDecFromStr("0.125"),
+			},
+			expectedErr: false,
+		},
+		{
+			name: "unknown tip destination",
+			p: types.Params{
+				Window:              1,
+				Alpha:               math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:               math.LegacyMustNewDecFromStr("0.1"),
+				Delta:               math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization: 3,
+				MinBaseGasPrice:     math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:     math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:     math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:            types.DefaultFeeDenom,
+				TipDestination:      "proposer",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "valid tip destination",
+			p: types.Params{
+				Window:              1,
+				Alpha:               math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:               math.LegacyMustNewDecFromStr("0.1"),
+				Delta:               math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization: 3,
+				MinBaseGasPrice:     math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:     math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:     math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:            types.DefaultFeeDenom,
+				TipDestination:      types.TipDestinationDistribution,
+			},
+			expectedErr: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -286,3 +593,149 @@ func TestParams(t *testing.T) {
 		})
 	}
 }
+
+func TestParams_FeeSplit(t *testing.T) {
+	t.Run("validates the fee split", func(t *testing.T) {
+		testCases := []struct {
+			name        string
+			malleate    func(*types.Params)
+			expectedErr bool
+		}{
+			{
+				name:     "no fee split",
+				malleate: func(*types.Params) {},
+			},
+			{
+				name: "valid fee split",
+				malleate: func(p *types.Params) {
+					p.BurnRatio = math.LegacyMustNewDecFromStr("0.5")
+					p.CommunityPoolRatio = math.LegacyMustNewDecFromStr("0.25")
+					p.ExtraFeeRecipients = []types.FeeRecipient{{Module: "module", Ratio: math.LegacyMustNewDecFromStr("0.25")}}
+				},
+			},
+			{
+				name: "ratios do not sum to 1",
+				malleate: func(p *types.Params) {
+					p.BurnRatio = math.LegacyMustNewDecFromStr("0.5")
+					p.StakerRatio = math.LegacyMustNewDecFromStr("0.25")
+				},
+				expectedErr: true,
+			},
+			{
+				name: "ratio is greater than 1",
+				malleate: func(p *types.Params) {
+					p.BurnRatio = math.LegacyMustNewDecFromStr("1.5")
+					p.StakerRatio = math.LegacyMustNewDecFromStr("-0.5")
+				},
+				expectedErr: true,
+			},
+			{
+				name: "extra fee recipient without module",
+				malleate: func(p *types.Params) {
+					p.ExtraFeeRecipients = []types.FeeRecipient{{Ratio: math.LegacyOneDec()}}
+				},
+				expectedErr: true,
+			},
+			{
+				name: "duplicate extra fee recipient",
+				malleate: func(p *types.Params) {
+					p.ExtraFeeRecipients = []types.FeeRecipient{
+						{Module: "module", Ratio: math.LegacyMustNewDecFromStr("0.5")},
+						{Module: "module", Ratio: math.LegacyMustNewDecFromStr("0.5")},
+					}
+				},
+				expectedErr: true,
+			},
+			{
+				name: "extra fee recipient with zero ratio",
+				malleate: func(p *types.Params) {
+					p.StakerRatio = math.LegacyOneDec()
+					p.ExtraFeeRecipients = []types.FeeRecipient{{Module: "module", Ratio: math.LegacyZeroDec()}}
+				},
+				expectedErr: true,
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				params := types.DefaultParams()
+				tc.malleate(&params)
+
+				err := params.ValidateBasic()
+				if tc.expectedErr {
+					require.Error(t, err)
+				} else {
+					require.NoError(t, err)
+				}
+			})
+		}
+	})
+
+	t.Run("splits the fee between the destinations", func(t *testing.T) {
+		params := types.DefaultParams()
+		require.False(t, params.HasFeeSplit())
+
+		params.BurnRatio = math.LegacyMustNewDecFromStr("0.5")
+		params.StakerRatio = math.LegacyMustNewDecFromStr("0.3")
+		params.ExtraFeeRecipients = []types.FeeRecipient{{Module: "module", Ratio: math.LegacyMustNewDecFromStr("0.2")}}
+		require.True(t, params.HasFeeSplit())
+
+		fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 3), sdk.NewInt64Coin("stake", 101))
+		require.Equal(t, []types.FeeShare{
+			{Destination: types.FeeSplitDestinationBurn, Coins: sdk.NewCoins(sdk.NewInt64Coin("atom", 1), sdk.NewInt64Coin("stake", 50))},
+			{Destination: types.FeeSplitDestinationStakers, Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 30))},
+			{Destination: "module", Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 20))},
+		}, params.FeeShares(fee))
+	})
+}
+
+func TestParams_MergeFields(t *testing.T) {
+	t.Run("every params field can be merged", func(t *testing.T) {
+		var names []string
+
+		paramsType := reflect.TypeOf(types.Params{})
+		for i := 0; i < paramsType.NumField(); i++ {
+			tag := paramsType.Field(i).Tag.Get("protobuf")
+			for _, part := range strings.Split(tag, ",") {
+				if name, ok := strings.CutPrefix(part, "name="); ok {
+					names = append(names, name)
+				}
+			}
+		}
+
+		sort.Strings(names)
+		require.Equal(t, names, types.ParamsFieldNames())
+	})
+
+	t.Run("only merges the given fields", func(t *testing.T) {
+		params := types.DefaultParams()
+
+		update := types.DefaultAIMDParams()
+		update.FeeDenom = "other"
+
+		merged, err := params.MergeFields(update, []string{"alpha", "window"})
+		require.NoError(t, err)
+
+		expected := types.DefaultParams()
+		expected.Alpha = update.Alpha
+		expected.Window = update.Window
+		require.Equal(t, expected, merged)
+
+		// The original params are not modified.
+		require.Equal(t, types.DefaultParams(), params)
+	})
+
+	t.Run("rejects unknown fields", func(t *testing.T) {
+		params := types.DefaultParams()
+
+		_, err := params.MergeFields(types.DefaultAIMDParams(), []string{"alpha", "Alpha"})
+		require.ErrorIs(t, err, types.ErrInvalidParamsField)
+	})
+
+	t.Run("rejects empty fields", func(t *testing.T) {
+		params := types.DefaultParams()
+
+		_, err := params.MergeFields(types.DefaultAIMDParams(), nil)
+		require.ErrorIs(t, err, types.ErrInvalidParamsField)
+	})
+}
@@ -0,0 +1,158 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/math"
+)
+
+const (
+	// AIMDPricingAlgorithmName is the name of the AIMD EIP-1559 pricing algorithm.
+	AIMDPricingAlgorithmName = "aimd"
+
+	// EIP1559PricingAlgorithmName is the name of the base EIP-1559 pricing algorithm.
+	EIP1559PricingAlgorithmName = "eip1559"
+
+	// DefaultPricingAlgorithmName is the pricing algorithm that is used when
+	// Params.PricingAlgorithm is not set. This preserves the behavior of chains
+	// that were running the fee market before pricing algorithms were pluggable.
+	DefaultPricingAlgorithmName = AIMDPricingAlgorithmName
+)
+
+// PricingAlgorithm defines the interface that a fee market pricing algorithm must
+// implement. A pricing algorithm is responsible for updating the learning rate and
+// the base gas price of the fee market state at the end of every block.
+type PricingAlgorithm interface {
+	// Name returns the name the pricing algorithm is registered under. This is the
+	// value that must be set in Params.PricingAlgorithm to select the algorithm.
+	Name() string
+
+	// UpdateLearningRate updates the learning rate of the given state and returns
+	// the new learning rate.
+	UpdateLearningRate(state *State, params Params) math.LegacyDec
+
+	// UpdateBaseGasPrice updates the base gas price of the given state and returns
+	// the new base gas price. This is called after UpdateLearningRate.
+	UpdateBaseGasPrice(state *State, params Params) math.LegacyDec
+}
+
+// PricingRegistry is a registry of pricing algorithms keyed by name.
+type PricingRegistry struct {
+	algorithms map[string]PricingAlgorithm
+}
+
+// NewPricingRegistry returns a new pricing registry with the given pricing algorithms
+// registered. This panics if two algorithms share the same name.
+func NewPricingRegistry(algorithms ...PricingAlgorithm) *PricingRegistry {
+	r := &PricingRegistry{
+		algorithms: make(map[string]PricingAlgorithm),
+	}
+
+	for _, algorithm := range algorithms {
+		if err := r.Register(algorithm); err != nil {
+			panic(err)
+		}
+	}
+
+	return r
+}
+
+// DefaultPricingRegistry returns a pricing registry with the AIMD and base EIP-1559
+// pricing algorithms registered.
+func DefaultPricingRegistry() *PricingRegistry {
+	return NewPricingRegistry(
+		AIMDPricingAlgorithm{},
+		EIP1559PricingAlgorithm{},
+	)
+}
+
+// Register registers the given pricing algorithm. An error is returned if an algorithm
+// with the same name has already been registered.
+func (r *PricingRegistry) Register(algorithm PricingAlgorithm) error {
+	name := algorithm.Name()
+	if name == "" {
+		return fmt.Errorf("pricing algorithm name cannot be empty")
+	}
+
+	if _, ok := r.algorithms[name]; ok {
+		return fmt.Errorf("pricing algorithm %s is already registered", name)
+	}
+
+	r.algorithms[name] = algorithm
+	return nil
+}
+
+// Get returns the pricing algorithm registered under the given name. If the name is
+// empty, the default pricing algorithm is returned.
+func (r *PricingRegistry) Get(name string) (PricingAlgorithm, error) {
+	if name == "" {
+		name = DefaultPricingAlgorithmName
+	}
+
+	algorithm, ok := r.algorithms[name]
+	if !ok {
+		return nil, ErrUnknownPricingAlgorithm.Wrapf("%s", name)
+	}
+
+	return algorithm, nil
+}
+
+// Names returns the sorted names of all registered pricing algorithms.
+func (r *PricingRegistry) Names() []string {
+	names := make([]string, 0, len(r.algorithms))
+	for name := range r.algorithms {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+var _ PricingAlgorithm = AIMDPricingAlgorithm{}
+
+// AIMDPricingAlgorithm implements the AIMD EIP-1559 pricing algorithm. The learning
+// rate is adjusted based on the average utilization of the block window and the base
+// gas price is adjusted with the learning rate and the delta adjustment.
+type AIMDPricingAlgorithm struct{}
+
+// Name implements PricingAlgorithm.
+func (AIMDPricingAlgorithm) Name() string {
+	return AIMDPricingAlgorithmName
+}
+
+// UpdateLearningRate implements PricingAlgorithm.
+func (AIMDPricingAlgorithm) UpdateLearningRate(state *State, params Params) math.LegacyDec {
+	return state.UpdateLearningRate(params)
+}
+
+// UpdateBaseGasPrice implements PricingAlgorithm.
+func (AIMDPricingAlgorithm) UpdateBaseGasPrice(state *State, params Params) math.LegacyDec {
+	return state.UpdateBaseGasPrice(params)
+}
+
+var _ PricingAlgorithm = EIP1559PricingAlgorithm{}
+
+// EIP1559PricingAlgorithm implements the base EIP-1559 pricing algorithm. The learning
+// rate is fixed and the base gas price is only adjusted based on the utilization of
+// the current block.
+type EIP1559PricingAlgorithm struct{}
+
+// Name implements PricingAlgorithm.
+func (EIP1559PricingAlgorithm) Name() string {
+	return EIP1559PricingAlgorithmName
+}
+
+// UpdateLearningRate implements PricingAlgorithm. The learning rate is not adjusted
+// and is only kept within the configured bounds.
+func (EIP1559PricingAlgorithm) UpdateLearningRate(state *State, params Params) math.LegacyDec {
+	state.ClampLearningRate(params)
+	return state.LearningRate
+}
+
+// UpdateBaseGasPrice implements PricingAlgorithm.
+func (EIP1559PricingAlgorithm) UpdateBaseGasPrice(state *State, params Params) math.LegacyDec {
+	// The base EIP-1559 update rule has no additive delta adjustment.
+	params.Delta = math.LegacyZeroDec()
+	return state.UpdateBaseGasPrice(params)
+}
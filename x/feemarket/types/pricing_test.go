@@ -0,0 +1,104 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+type fixedPricingAlgorithm struct {
+	price math.LegacyDec
+}
+
+func (f fixedPricingAlgorithm) Name() string { return "fixed" }
+
+func (f fixedPricingAlgorithm) UpdateLearningRate(state *types.State, _ types.Params) math.LegacyDec {
+	return state.LearningRate
+}
+
+func (f fixedPricingAlgorithm) UpdateBaseGasPrice(state *types.State, _ types.Params) math.LegacyDec {
+	state.BaseGasPrice = f.price
+	return state.BaseGasPrice
+}
+
+func TestPricingRegistry(t *testing.T) {
+	t.Run("default registry contains aimd and eip1559", func(t *testing.T) {
+		registry := types.DefaultPricingRegistry()
+		require.Equal(t, []string{types.AIMDPricingAlgorithmName, types.EIP1559PricingAlgorithmName}, registry.Names())
+	})
+
+	t.Run("empty name resolves to the default algorithm", func(t *testing.T) {
+		registry := types.DefaultPricingRegistry()
+
+		algorithm, err := registry.Get("")
+		require.NoError(t, err)
+		require.Equal(t, types.DefaultPricingAlgorithmName, algorithm.Name())
+	})
+
+	t.Run("unknown algorithm returns an error", func(t *testing.T) {
+		registry := types.DefaultPricingRegistry()
+
+		_, err := registry.Get("unknown")
+		require.ErrorIs(t, err, types.ErrUnknownPricingAlgorithm)
+	})
+
+	t.Run("can register a custom algorithm", func(t *testing.T) {
+		registry := types.DefaultPricingRegistry()
+		require.NoError(t, registry.Register(fixedPricingAlgorithm{price: OneHundred}))
+
+		algorithm, err := registry.Get("fixed")
+		require.NoError(t, err)
+
+		state := types.DefaultState()
+		require.Equal(t, OneHundred, algorithm.UpdateBaseGasPrice(&state, types.DefaultParams()))
+		require.Equal(t, OneHundred, state.BaseGasPrice)
+	})
+
+	t.Run("cannot register the same algorithm twice", func(t *testing.T) {
+		registry := types.DefaultPricingRegistry()
+		require.Error(t, registry.Register(types.AIMDPricingAlgorithm{}))
+	})
+}
+
+func TestEIP1559PricingAlgorithm(t *testing.T) {
+	t.Run("learning rate is not adjusted", func(t *testing.T) {
+		state := types.DefaultAIMDState()
+		params := types.DefaultAIMDParams()
+		state.LearningRate = math.LegacyMustNewDecFromStr("0.1")
+
+		lr := types.EIP1559PricingAlgorithm{}.UpdateLearningRate(&state, params)
+		require.Equal(t, math.LegacyMustNewDecFromStr("0.1"), lr)
+	})
+
+	t.Run("learning rate is kept within bounds", func(t *testing.T) {
+		state := types.DefaultAIMDState()
+		params := types.DefaultAIMDParams()
+		state.LearningRate = params.MaxLearningRate.Add(math.LegacyOneDec())
+
+		lr := types.EIP1559PricingAlgorithm{}.UpdateLearningRate(&state, params)
+		require.Equal(t, params.MaxLearningRate, lr)
+	})
+
+	t.Run("delta is ignored", func(t *testing.T) {
+		params := types.DefaultAIMDParams()
+		params.Delta = math.LegacyNewDec(10)
+
+		state := types.DefaultAIMDState()
+		for i := 0; i < len(state.Window); i++ {
+			state.Window[i] = params.MaxBlockUtilization
+		}
+		state.BaseGasPrice = OneHundred
+
+		aimdState := state
+		aimdState.Window = append([]uint64(nil), state.Window...)
+
+		eip1559Price := types.EIP1559PricingAlgorithm{}.UpdateBaseGasPrice(&state, params)
+
+		params.Delta = math.LegacyZeroDec()
+		expectedPrice := types.AIMDPricingAlgorithm{}.UpdateBaseGasPrice(&aimdState, params)
+		require.Equal(t, expectedPrice, eip1559Price)
+	})
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+)
+
+// MaxProjectionBlockCount is the maximum number of blocks that can be projected by a
+// single gas price projection query.
+const MaxProjectionBlockCount uint64 = 1024
+
+// ValidateUtilizationRatios checks that at least one and at most one utilization ratio
+// per projected block is given and that all ratios are in [0, 1].
+func ValidateUtilizationRatios(ratios []math.LegacyDec, blockCount uint64) error {
+	if len(ratios) == 0 {
+		return ErrInvalidProjection.Wrap("at least one utilization ratio must be given")
+	}
+
+	if uint64(len(ratios)) > blockCount {
+		return ErrInvalidProjection.Wrapf(
+			"at most %d utilization ratios must be given, got %d", blockCount, len(ratios),
+		)
+	}
+
+	for _, ratio := range ratios {
+		if ratio.IsNil() || ratio.IsNegative() || ratio.GT(math.LegacyOneDec()) {
+			return ErrInvalidProjection.Wrapf("utilization ratio %s must be between [0, 1]", ratio)
+		}
+	}
+
+	return nil
+}
+
+// ProjectedUtilizations returns the assumed gas used in each of the given number of
+// blocks. If fewer ratios than blocks are given, the last ratio is used for the
+// remaining blocks.
+func ProjectedUtilizations(blockCount uint64, ratios []math.LegacyDec, params Params) []uint64 {
+	utilizations := make([]uint64, blockCount)
+	for i := range utilizations {
+		ratio := ratios[len(ratios)-1]
+		if i < len(ratios) {
+			ratio = ratios[i]
+		}
+
+		utilizations[i] = ratio.MulInt(math.NewIntFromUint64(params.MaxBlockUtilization)).TruncateInt().Uint64()
+	}
+
+	return utilizations
+}
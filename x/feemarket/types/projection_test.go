@@ -0,0 +1,38 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func TestProjectedUtilizations(t *testing.T) {
+	params := types.DefaultParams()
+	params.MaxBlockUtilization = 1000
+
+	t.Run("converts ratios to gas", func(t *testing.T) {
+		ratios := []math.LegacyDec{math.LegacyMustNewDecFromStr("0.9"), math.LegacyZeroDec(), math.LegacyOneDec()}
+		require.Equal(t, []uint64{900, 0, 1000}, types.ProjectedUtilizations(3, ratios, params))
+	})
+
+	t.Run("repeats the last ratio", func(t *testing.T) {
+		ratios := []math.LegacyDec{math.LegacyOneDec(), math.LegacyMustNewDecFromStr("0.5")}
+		require.Equal(t, []uint64{1000, 500, 500, 500}, types.ProjectedUtilizations(4, ratios, params))
+	})
+
+	t.Run("ignores extra ratios", func(t *testing.T) {
+		ratios := []math.LegacyDec{math.LegacyOneDec(), math.LegacyMustNewDecFromStr("0.5")}
+		require.Equal(t, []uint64{1000}, types.ProjectedUtilizations(1, ratios, params))
+	})
+}
+
+func TestValidateUtilizationRatios(t *testing.T) {
+	require.ErrorIs(t, types.ValidateUtilizationRatios(nil, 1), types.ErrInvalidProjection)
+	require.ErrorIs(t, types.ValidateUtilizationRatios([]math.LegacyDec{math.LegacyNewDec(-1)}, 1), types.ErrInvalidProjection)
+	require.ErrorIs(t, types.ValidateUtilizationRatios([]math.LegacyDec{math.LegacyNewDec(2)}, 1), types.ErrInvalidProjection)
+	require.ErrorIs(t, types.ValidateUtilizationRatios([]math.LegacyDec{math.LegacyZeroDec(), math.LegacyOneDec()}, 1), types.ErrInvalidProjection)
+	require.NoError(t, types.ValidateUtilizationRatios([]math.LegacyDec{math.LegacyZeroDec(), math.LegacyOneDec()}, 2))
+}
@@ -5,9 +5,12 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -154,6 +157,9 @@ var xxx_messageInfo_StateRequest proto.InternalMessageInfo
 // StateResponse is the response type for the Query/State RPC method.
 type StateResponse struct {
 	State State `protobuf:"bytes,1,opt,name=state,proto3" json:"state"`
+	// Frozen is true if the learning rate and base gas price adjustments of the
+	// fee market are frozen.
+	Frozen bool `protobuf:"varint,2,opt,name=frozen,proto3" json:"frozen,omitempty"`
 }
 
 func (m *StateResponse) Reset()         { *m = StateResponse{} }
@@ -196,6 +202,13 @@ func (m *StateResponse) GetState() State {
 	return State{}
 }
 
+func (m *StateResponse) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
 // GasPriceRequest is the request type for the Query/GasPrice RPC method.
 type GasPriceRequest struct {
 	// denom we are querying gas price in
@@ -329,6 +342,9 @@ var xxx_messageInfo_GasPricesRequest proto.InternalMessageInfo
 // Returns a gas price in all available denoms.
 type GasPricesResponse struct {
 	Prices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=prices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"prices"`
+	// DenomStatuses contains the status of the gas price of each extra denom of
+	// the denom resolver, in the order of the resolver's extra denoms.
+	DenomStatuses []DenomGasPriceStatus `protobuf:"bytes,2,rep,name=denom_statuses,json=denomStatuses,proto3" json:"denom_statuses"`
 }
 
 func (m *GasPricesResponse) Reset()         { *m = GasPricesResponse{} }
@@ -371,630 +387,4460 @@ func (m *GasPricesResponse) GetPrices() github_com_cosmos_cosmos_sdk_types.DecCo
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*ParamsRequest)(nil), "feemarket.feemarket.v1.ParamsRequest")
-	proto.RegisterType((*ParamsResponse)(nil), "feemarket.feemarket.v1.ParamsResponse")
-	proto.RegisterType((*StateRequest)(nil), "feemarket.feemarket.v1.StateRequest")
-	proto.RegisterType((*StateResponse)(nil), "feemarket.feemarket.v1.StateResponse")
-	proto.RegisterType((*GasPriceRequest)(nil), "feemarket.feemarket.v1.GasPriceRequest")
-	proto.RegisterType((*GasPriceResponse)(nil), "feemarket.feemarket.v1.GasPriceResponse")
-	proto.RegisterType((*GasPricesRequest)(nil), "feemarket.feemarket.v1.GasPricesRequest")
-	proto.RegisterType((*GasPricesResponse)(nil), "feemarket.feemarket.v1.GasPricesResponse")
+func (m *GasPricesResponse) GetDenomStatuses() []DenomGasPriceStatus {
+	if m != nil {
+		return m.DenomStatuses
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("feemarket/feemarket/v1/query.proto", fileDescriptor_d683b3b0d8494138)
+// DenomGasPriceStatus is the status of the gas price of an extra denom of the
+// denom resolver.
+type DenomGasPriceStatus struct {
+	// Denom is the extra denom of the denom resolver.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Available determines whether the gas price of the denom could be resolved
+	// and is included in the prices of the response.
+	Available bool `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"`
+	// Error is the reason the gas price of the denom could not be resolved.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// LastPrice is the last gas price that was resolved for the denom at the end
+	// of a block. Unset if the gas price was never resolved.
+	LastPrice *DenomGasPrice `protobuf:"bytes,4,opt,name=last_price,json=lastPrice,proto3" json:"last_price,omitempty"`
 }
 
-var fileDescriptor_d683b3b0d8494138 = []byte{
-	// 565 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0x6e, 0x18, 0xad, 0xa8, 0x61, 0x1b, 0x33, 0x65, 0x9a, 0x42, 0x49, 0x47, 0xd8, 0xe8, 0x00,
-	0x2d, 0x56, 0xc7, 0x05, 0x24, 0xb8, 0x14, 0x24, 0x04, 0x07, 0xb4, 0x95, 0x1b, 0x17, 0xe4, 0x66,
-	0x26, 0x44, 0x25, 0x76, 0x1a, 0xbb, 0x15, 0x13, 0xe2, 0x32, 0x24, 0xce, 0x48, 0xfc, 0x09, 0xc4,
-	0x89, 0x9f, 0xb1, 0xe3, 0x24, 0x2e, 0x9c, 0x00, 0xb5, 0x48, 0xfc, 0x0c, 0x50, 0xec, 0x97, 0xb6,
-	0x4c, 0xcb, 0xc2, 0xa5, 0x7d, 0x7e, 0xfe, 0xde, 0xf7, 0x3d, 0xbf, 0xf7, 0xb5, 0xc8, 0x7d, 0xc1,
-	0x58, 0x44, 0x93, 0x1e, 0x53, 0x64, 0x1a, 0x0d, 0x5b, 0xa4, 0x3f, 0x60, 0xc9, 0x9e, 0x17, 0x27,
-	0x42, 0x09, 0xbc, 0x3c, 0xb9, 0xf1, 0xa6, 0xd1, 0xb0, 0x65, 0xd7, 0x02, 0x11, 0x08, 0x0d, 0x21,
-	0x69, 0x64, 0xd0, 0x76, 0x3d, 0x10, 0x22, 0x78, 0xc5, 0x08, 0x8d, 0x43, 0x42, 0x39, 0x17, 0x8a,
-	0xaa, 0x50, 0x70, 0x09, 0xb7, 0x8e, 0x2f, 0x64, 0x24, 0x24, 0xe9, 0x52, 0xc9, 0xc8, 0xb0, 0xd5,
-	0x65, 0x8a, 0xb6, 0x88, 0x2f, 0x42, 0x0e, 0xf7, 0x4b, 0x34, 0x0a, 0xb9, 0x20, 0xfa, 0x13, 0x52,
-	0x57, 0x73, 0x5a, 0x8c, 0x69, 0x42, 0xa3, 0x8c, 0x77, 0x2d, 0x07, 0x14, 0x30, 0xce, 0x64, 0x08,
-	0x28, 0x77, 0x11, 0xcd, 0x6f, 0xeb, 0xaa, 0x0e, 0xeb, 0x0f, 0x98, 0x54, 0xee, 0x13, 0xb4, 0x90,
-	0x25, 0x64, 0x2c, 0xb8, 0x64, 0xf8, 0x2e, 0xaa, 0x18, 0xe2, 0x15, 0x6b, 0xd5, 0xda, 0x38, 0xbb,
-	0xe5, 0x78, 0xc7, 0xbf, 0xde, 0x33, 0x75, 0xed, 0xd3, 0x07, 0xdf, 0x1b, 0xa5, 0x0e, 0xd4, 0xb8,
-	0x0b, 0xe8, 0xdc, 0x53, 0x45, 0x15, 0xcb, 0xf8, 0x1f, 0xa3, 0x79, 0x38, 0x03, 0xfd, 0x1d, 0x54,
-	0x96, 0x69, 0x02, 0xd8, 0x2f, 0xe7, 0xb1, 0xeb, 0x2a, 0x20, 0x37, 0x15, 0x6e, 0x13, 0x2d, 0x3e,
-	0xa4, 0x72, 0x3b, 0x09, 0xfd, 0x8c, 0x1e, 0xd7, 0x50, 0x79, 0x97, 0x71, 0x11, 0x69, 0xb6, 0x6a,
-	0xc7, 0x1c, 0xdc, 0x1d, 0x74, 0x7e, 0x0a, 0x04, 0xdd, 0x7b, 0xa8, 0x1c, 0xa7, 0x09, 0xd0, 0xad,
-	0x7b, 0x66, 0x0f, 0x5e, 0xba, 0x07, 0x0f, 0xf6, 0xe0, 0x3d, 0x60, 0xfe, 0x7d, 0x11, 0xf2, 0x76,
-	0x35, 0x95, 0xfd, 0xf4, 0xfb, 0xcb, 0x0d, 0xab, 0x63, 0xaa, 0x5c, 0x3c, 0xa5, 0x9c, 0xcc, 0xee,
-	0x9d, 0x85, 0x96, 0x66, 0x92, 0x20, 0xc4, 0x51, 0x45, 0x97, 0xa4, 0xf3, 0x9b, 0x2b, 0x54, 0xba,
-	0x9d, 0x2a, 0x7d, 0xfe, 0xd1, 0xb8, 0x19, 0x84, 0xea, 0xe5, 0xa0, 0xeb, 0xf9, 0x22, 0x22, 0xe0,
-	0x10, 0xf3, 0xb5, 0x29, 0x77, 0x7b, 0x44, 0xed, 0xc5, 0x4c, 0x66, 0x35, 0xd2, 0x34, 0x06, 0x2a,
-	0x5b, 0x7f, 0xe6, 0x50, 0x79, 0x27, 0x35, 0x2b, 0x1e, 0xa0, 0x8a, 0xd9, 0x09, 0x5e, 0x3f, 0x79,
-	0x67, 0xf0, 0x00, 0xfb, 0x5a, 0x11, 0xcc, 0x3c, 0xc9, 0xad, 0xef, 0x7f, 0xfd, 0xf5, 0xf1, 0xd4,
-	0x32, 0xae, 0x1d, 0xe7, 0x3f, 0xdc, 0x47, 0x65, 0xbd, 0x2c, 0xbc, 0x76, 0xe2, 0x2e, 0x33, 0xd1,
-	0xf5, 0x02, 0x14, 0x68, 0x5e, 0xd2, 0x9a, 0x17, 0xf1, 0x85, 0x7f, 0x35, 0xb5, 0x13, 0xf0, 0x7b,
-	0x0b, 0x9d, 0xc9, 0x26, 0x8f, 0x9b, 0x79, 0x84, 0x47, 0xcc, 0x62, 0x6f, 0x14, 0x03, 0x41, 0xbc,
-	0xa9, 0xc5, 0xaf, 0xe0, 0xc6, 0x91, 0xdf, 0x12, 0x95, 0xcf, 0xf5, 0xd4, 0xc9, 0x1b, 0x6d, 0xb4,
-	0xb7, 0x78, 0xdf, 0x42, 0xd5, 0x89, 0x05, 0x70, 0xa1, 0xc0, 0x64, 0xf2, 0xd7, 0xff, 0x03, 0x09,
-	0xbd, 0xac, 0xea, 0x5e, 0x6c, 0xbc, 0x92, 0xd3, 0x8b, 0x6c, 0x3f, 0x3a, 0x18, 0x39, 0xd6, 0xe1,
-	0xc8, 0xb1, 0x7e, 0x8e, 0x1c, 0xeb, 0xc3, 0xd8, 0x29, 0x1d, 0x8e, 0x9d, 0xd2, 0xb7, 0xb1, 0x53,
-	0x7a, 0x46, 0x66, 0x5c, 0x25, 0x7b, 0x61, 0xbc, 0x19, 0xb1, 0xe1, 0x0c, 0xcd, 0xeb, 0x99, 0x58,
-	0x5b, 0xac, 0x5b, 0xd1, 0x7f, 0x13, 0xb7, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x1e, 0x87, 0x38,
-	0x2d, 0x16, 0x05, 0x00, 0x00,
+func (m *DenomGasPriceStatus) Reset()         { *m = DenomGasPriceStatus{} }
+func (m *DenomGasPriceStatus) String() string { return proto.CompactTextString(m) }
+func (*DenomGasPriceStatus) ProtoMessage()    {}
+func (*DenomGasPriceStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{8}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params returns the current feemarket module parameters.
-	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
-	// State returns the current feemarket module state.
-	State(ctx context.Context, in *StateRequest, opts ...grpc.CallOption) (*StateResponse, error)
-	// GasPrice returns the current feemarket module gas price
-	// for specified denom.
-	GasPrice(ctx context.Context, in *GasPriceRequest, opts ...grpc.CallOption) (*GasPriceResponse, error)
-	// GasPrices returns the current feemarket module list of gas prices
-	// in all available denoms.
-	GasPrices(ctx context.Context, in *GasPricesRequest, opts ...grpc.CallOption) (*GasPricesResponse, error)
+func (m *DenomGasPriceStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *DenomGasPriceStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomGasPriceStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *DenomGasPriceStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomGasPriceStatus.Merge(m, src)
+}
+func (m *DenomGasPriceStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomGasPriceStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomGasPriceStatus.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
-	out := new(ParamsResponse)
-	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DenomGasPriceStatus proto.InternalMessageInfo
+
+func (m *DenomGasPriceStatus) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) State(ctx context.Context, in *StateRequest, opts ...grpc.CallOption) (*StateResponse, error) {
-	out := new(StateResponse)
-	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/State", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DenomGasPriceStatus) GetAvailable() bool {
+	if m != nil {
+		return m.Available
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) GasPrice(ctx context.Context, in *GasPriceRequest, opts ...grpc.CallOption) (*GasPriceResponse, error) {
-	out := new(GasPriceResponse)
-	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/GasPrice", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DenomGasPriceStatus) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GasPrices(ctx context.Context, in *GasPricesRequest, opts ...grpc.CallOption) (*GasPricesResponse, error) {
-	out := new(GasPricesResponse)
-	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/GasPrices", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DenomGasPriceStatus) GetLastPrice() *DenomGasPrice {
+	if m != nil {
+		return m.LastPrice
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params returns the current feemarket module parameters.
-	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
-	// State returns the current feemarket module state.
-	State(context.Context, *StateRequest) (*StateResponse, error)
-	// GasPrice returns the current feemarket module gas price
-	// for specified denom.
-	GasPrice(context.Context, *GasPriceRequest) (*GasPriceResponse, error)
-	// GasPrices returns the current feemarket module list of gas prices
-	// in all available denoms.
-	GasPrices(context.Context, *GasPricesRequest) (*GasPricesResponse, error)
+// ScheduledParamsRequest is the request type for the Query/ScheduledParams RPC
+// method.
+type ScheduledParamsRequest struct {
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *ScheduledParamsRequest) Reset()         { *m = ScheduledParamsRequest{} }
+func (m *ScheduledParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*ScheduledParamsRequest) ProtoMessage()    {}
+func (*ScheduledParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{9}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *ScheduledParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) State(ctx context.Context, req *StateRequest) (*StateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method State not implemented")
+func (m *ScheduledParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GasPrice(ctx context.Context, req *GasPriceRequest) (*GasPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GasPrice not implemented")
+func (m *ScheduledParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledParamsRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GasPrices(ctx context.Context, req *GasPricesRequest) (*GasPricesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GasPrices not implemented")
+func (m *ScheduledParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledParamsRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_ScheduledParamsRequest proto.InternalMessageInfo
+
+// ScheduledParamsResponse is the response type for the Query/ScheduledParams
+// RPC method.
+type ScheduledParamsResponse struct {
+	ScheduledParams []ScheduledParams `protobuf:"bytes,1,rep,name=scheduled_params,json=scheduledParams,proto3" json:"scheduled_params"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/feemarket.feemarket.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+func (m *ScheduledParamsResponse) Reset()         { *m = ScheduledParamsResponse{} }
+func (m *ScheduledParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*ScheduledParamsResponse) ProtoMessage()    {}
+func (*ScheduledParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{10}
+}
+func (m *ScheduledParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ScheduledParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledParamsResponse.Merge(m, src)
+}
+func (m *ScheduledParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledParamsResponse.DiscardUnknown(m)
 }
 
-func _Query_State_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).State(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/feemarket.feemarket.v1.Query/State",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).State(ctx, req.(*StateRequest))
+var xxx_messageInfo_ScheduledParamsResponse proto.InternalMessageInfo
+
+func (m *ScheduledParamsResponse) GetScheduledParams() []ScheduledParams {
+	if m != nil {
+		return m.ScheduledParams
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GasPriceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GasPrice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/feemarket.feemarket.v1.Query/GasPrice",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GasPrice(ctx, req.(*GasPriceRequest))
+// GasPriceHistoryRequest is the request type for the Query/GasPriceHistory RPC
+// method.
+type GasPriceHistoryRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *GasPriceHistoryRequest) Reset()         { *m = GasPriceHistoryRequest{} }
+func (m *GasPriceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GasPriceHistoryRequest) ProtoMessage()    {}
+func (*GasPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{11}
+}
+func (m *GasPriceHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPriceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPriceHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *GasPriceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPriceHistoryRequest.Merge(m, src)
+}
+func (m *GasPriceHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPriceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPriceHistoryRequest.DiscardUnknown(m)
 }
 
-func _Query_GasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GasPricesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_GasPriceHistoryRequest proto.InternalMessageInfo
+
+func (m *GasPriceHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GasPrices(ctx, in)
+	return nil
+}
+
+// GasPriceHistoryResponse is the response type for the Query/GasPriceHistory
+// RPC method.
+type GasPriceHistoryResponse struct {
+	Records    []GasPriceRecord    `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *GasPriceHistoryResponse) Reset()         { *m = GasPriceHistoryResponse{} }
+func (m *GasPriceHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GasPriceHistoryResponse) ProtoMessage()    {}
+func (*GasPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{12}
+}
+func (m *GasPriceHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPriceHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPriceHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/feemarket.feemarket.v1.Query/GasPrices",
+}
+func (m *GasPriceHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPriceHistoryResponse.Merge(m, src)
+}
+func (m *GasPriceHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPriceHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPriceHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPriceHistoryResponse proto.InternalMessageInfo
+
+func (m *GasPriceHistoryResponse) GetRecords() []GasPriceRecord {
+	if m != nil {
+		return m.Records
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GasPrices(ctx, req.(*GasPricesRequest))
+	return nil
+}
+
+func (m *GasPriceHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "feemarket.feemarket.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "State",
-			Handler:    _Query_State_Handler,
-		},
-		{
-			MethodName: "GasPrice",
-			Handler:    _Query_GasPrice_Handler,
-		},
-		{
-			MethodName: "GasPrices",
-			Handler:    _Query_GasPrices_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "feemarket/feemarket/v1/query.proto",
+// GasPriceRecordRequest is the request type for the Query/GasPriceRecord RPC
+// method.
+type GasPriceRecordRequest struct {
+	// height of the block we are querying the gas price record of
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GasPriceRecordRequest) Reset()         { *m = GasPriceRecordRequest{} }
+func (m *GasPriceRecordRequest) String() string { return proto.CompactTextString(m) }
+func (*GasPriceRecordRequest) ProtoMessage()    {}
+func (*GasPriceRecordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{13}
+}
+func (m *GasPriceRecordRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPriceRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPriceRecordRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GasPriceRecordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPriceRecordRequest.Merge(m, src)
 }
-
-func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *GasPriceRecordRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPriceRecordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPriceRecordRequest.DiscardUnknown(m)
 }
 
-func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GasPriceRecordRequest proto.InternalMessageInfo
+
+func (m *GasPriceRecordRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// GasPriceRecordResponse is the response type for the Query/GasPriceRecord RPC
+// method.
+type GasPriceRecordResponse struct {
+	Record GasPriceRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record"`
 }
 
-func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *GasPriceRecordResponse) Reset()         { *m = GasPriceRecordResponse{} }
+func (m *GasPriceRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*GasPriceRecordResponse) ProtoMessage()    {}
+func (*GasPriceRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{14}
+}
+func (m *GasPriceRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPriceRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPriceRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *StateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *GasPriceRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPriceRecordResponse.Merge(m, src)
 }
-
-func (m *StateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GasPriceRecordResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *StateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *GasPriceRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPriceRecordResponse.DiscardUnknown(m)
 }
 
-func (m *StateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GasPriceRecordResponse proto.InternalMessageInfo
+
+func (m *GasPriceRecordResponse) GetRecord() GasPriceRecord {
+	if m != nil {
+		return m.Record
 	}
-	return dAtA[:n], nil
+	return GasPriceRecord{}
 }
 
-func (m *StateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// FeeHistoryRequest is the request type for the Query/FeeHistory RPC method.
+type FeeHistoryRequest struct {
+	// block_count is the number of blocks to return, ending at newest_block. If
+	// fewer blocks exist, all blocks up to newest_block are returned.
+	BlockCount uint64 `protobuf:"varint,1,opt,name=block_count,json=blockCount,proto3" json:"block_count,omitempty"`
+	// newest_block is the height of the newest block to return. If zero, the
+	// latest recorded block is used. It cannot be negative.
+	NewestBlock int64 `protobuf:"varint,2,opt,name=newest_block,json=newestBlock,proto3" json:"newest_block,omitempty"`
+	// reward_percentiles is a monotonically increasing list of percentiles in
+	// [0, 100] of the tips per gas to return for each block, weighted by the gas
+	// used by each transaction.
+	RewardPercentiles []cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,rep,name=reward_percentiles,json=rewardPercentiles,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reward_percentiles"`
 }
 
-func (m *StateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+func (m *FeeHistoryRequest) Reset()         { *m = FeeHistoryRequest{} }
+func (m *FeeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*FeeHistoryRequest) ProtoMessage()    {}
+func (*FeeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{15}
+}
+func (m *FeeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *GasPriceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *FeeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeHistoryRequest.Merge(m, src)
 }
-
-func (m *GasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FeeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *GasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_FeeHistoryRequest proto.InternalMessageInfo
+
+func (m *FeeHistoryRequest) GetBlockCount() uint64 {
+	if m != nil {
+		return m.BlockCount
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *GasPriceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FeeHistoryRequest) GetNewestBlock() int64 {
+	if m != nil {
+		return m.NewestBlock
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// FeeHistoryResponse is the response type for the Query/FeeHistory RPC method.
+type FeeHistoryResponse struct {
+	// oldest_block is the height of the oldest block returned.
+	OldestBlock int64 `protobuf:"varint,1,opt,name=oldest_block,json=oldestBlock,proto3" json:"oldest_block,omitempty"`
+	// base_gas_prices are the base gas prices of the returned blocks.
+	BaseGasPrices []cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,rep,name=base_gas_prices,json=baseGasPrices,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_gas_prices"`
+	// gas_used_ratios are the block utilizations of the returned blocks divided
+	// by the max block utilization.
+	GasUsedRatios []cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,rep,name=gas_used_ratios,json=gasUsedRatios,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"gas_used_ratios"`
+	// rewards are the requested tip percentiles of the returned blocks.
+	Rewards []FeeHistoryReward `protobuf:"bytes,4,rep,name=rewards,proto3" json:"rewards"`
 }
 
-func (m *GasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+func (m *FeeHistoryResponse) Reset()         { *m = FeeHistoryResponse{} }
+func (m *FeeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*FeeHistoryResponse) ProtoMessage()    {}
+func (*FeeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{16}
+}
+func (m *FeeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *FeeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeHistoryResponse.Merge(m, src)
+}
+func (m *FeeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *GasPricesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_FeeHistoryResponse proto.InternalMessageInfo
+
+func (m *FeeHistoryResponse) GetOldestBlock() int64 {
+	if m != nil {
+		return m.OldestBlock
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GasPricesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FeeHistoryResponse) GetRewards() []FeeHistoryReward {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
 }
 
-func (m *GasPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// FeeHistoryReward contains the requested tip per gas percentiles of a block,
+// denominated in the fee denom.
+type FeeHistoryReward struct {
+	Tips []cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,rep,name=tips,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"tips"`
 }
 
-func (m *GasPricesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FeeHistoryReward) Reset()         { *m = FeeHistoryReward{} }
+func (m *FeeHistoryReward) String() string { return proto.CompactTextString(m) }
+func (*FeeHistoryReward) ProtoMessage()    {}
+func (*FeeHistoryReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{17}
+}
+func (m *FeeHistoryReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeHistoryReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeHistoryReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *FeeHistoryReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeHistoryReward.Merge(m, src)
+}
+func (m *FeeHistoryReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeHistoryReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeHistoryReward.DiscardUnknown(m)
 }
 
-func (m *GasPricesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_FeeHistoryReward proto.InternalMessageInfo
+
+// ProjectGasPriceRequest is the request type for the Query/ProjectGasPrice RPC
+// method.
+type ProjectGasPriceRequest struct {
+	// block_count is the number of blocks to project.
+	BlockCount uint64 `protobuf:"varint,1,opt,name=block_count,json=blockCount,proto3" json:"block_count,omitempty"`
+	// utilization_ratios are the assumed block utilizations of the projected
+	// blocks as a fraction of the max block utilization. If fewer ratios than
+	// blocks are given, the last ratio is used for the remaining blocks. At most
+	// block_count ratios can be given.
+	//
+	// Must be [0, 1].
+	UtilizationRatios []cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,rep,name=utilization_ratios,json=utilizationRatios,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"utilization_ratios"`
 }
 
-func (m *GasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Prices) > 0 {
-		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *ProjectGasPriceRequest) Reset()         { *m = ProjectGasPriceRequest{} }
+func (m *ProjectGasPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*ProjectGasPriceRequest) ProtoMessage()    {}
+func (*ProjectGasPriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{18}
+}
+func (m *ProjectGasPriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectGasPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectGasPriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ProjectGasPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectGasPriceRequest.Merge(m, src)
+}
+func (m *ProjectGasPriceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectGasPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectGasPriceRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_ProjectGasPriceRequest proto.InternalMessageInfo
+
+func (m *ProjectGasPriceRequest) GetBlockCount() uint64 {
+	if m != nil {
+		return m.BlockCount
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+// ProjectGasPriceResponse is the response type for the Query/ProjectGasPrice
+// RPC method.
+type ProjectGasPriceResponse struct {
+	// base_gas_price is the projected base gas price after all projected blocks.
+	BaseGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=base_gas_price,json=baseGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_gas_price"`
+	// path contains the projected base gas price and learning rate after each
+	// projected block.
+	Path []ProjectedGasPrice `protobuf:"bytes,2,rep,name=path,proto3" json:"path"`
 }
 
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProjectGasPriceResponse) Reset()         { *m = ProjectGasPriceResponse{} }
+func (m *ProjectGasPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*ProjectGasPriceResponse) ProtoMessage()    {}
+func (*ProjectGasPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{19}
+}
+func (m *ProjectGasPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectGasPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectGasPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *ProjectGasPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectGasPriceResponse.Merge(m, src)
+}
+func (m *ProjectGasPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectGasPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectGasPriceResponse.DiscardUnknown(m)
 }
 
-func (m *StateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_ProjectGasPriceResponse proto.InternalMessageInfo
+
+func (m *ProjectGasPriceResponse) GetPath() []ProjectedGasPrice {
+	if m != nil {
+		return m.Path
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *StateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// ProjectedGasPrice is the projected fee market state after a block.
+type ProjectedGasPrice struct {
+	// base_gas_price is the projected base gas price.
+	BaseGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=base_gas_price,json=baseGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_gas_price"`
+	// learning_rate is the projected learning rate.
+	LearningRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=learning_rate,json=learningRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"learning_rate"`
+}
+
+func (m *ProjectedGasPrice) Reset()         { *m = ProjectedGasPrice{} }
+func (m *ProjectedGasPrice) String() string { return proto.CompactTextString(m) }
+func (*ProjectedGasPrice) ProtoMessage()    {}
+func (*ProjectedGasPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{20}
+}
+func (m *ProjectedGasPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectedGasPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectedGasPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.State.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *ProjectedGasPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectedGasPrice.Merge(m, src)
+}
+func (m *ProjectedGasPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectedGasPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectedGasPrice.DiscardUnknown(m)
 }
 
-func (m *GasPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_ProjectedGasPrice proto.InternalMessageInfo
+
+// FeeCollectorRequest is the request type for the Query/FeeCollector RPC
+// method.
+type FeeCollectorRequest struct {
+}
+
+func (m *FeeCollectorRequest) Reset()         { *m = FeeCollectorRequest{} }
+func (m *FeeCollectorRequest) String() string { return proto.CompactTextString(m) }
+func (*FeeCollectorRequest) ProtoMessage()    {}
+func (*FeeCollectorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{21}
+}
+func (m *FeeCollectorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeCollectorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeCollectorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *FeeCollectorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeCollectorRequest.Merge(m, src)
+}
+func (m *FeeCollectorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeCollectorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeCollectorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeCollectorRequest proto.InternalMessageInfo
+
+// FeeCollectorResponse is the response type for the Query/FeeCollector RPC
+// method.
+type FeeCollectorResponse struct {
+	// balance is the current balance of the feemarket fee collector.
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+	// swept contains the cumulative amount of fees swept from the feemarket fee
+	// collector per destination.
+	Swept []SweptFees `protobuf:"bytes,2,rep,name=swept,proto3" json:"swept"`
+	// total_swept is the cumulative amount of fees swept from the feemarket fee
+	// collector to all destinations.
+	TotalSwept github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=total_swept,json=totalSwept,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_swept"`
+}
+
+func (m *FeeCollectorResponse) Reset()         { *m = FeeCollectorResponse{} }
+func (m *FeeCollectorResponse) String() string { return proto.CompactTextString(m) }
+func (*FeeCollectorResponse) ProtoMessage()    {}
+func (*FeeCollectorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{22}
+}
+func (m *FeeCollectorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeCollectorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeCollectorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *FeeCollectorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeCollectorResponse.Merge(m, src)
+}
+func (m *FeeCollectorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeCollectorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeCollectorResponse.DiscardUnknown(m)
 }
 
-func (m *GasPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_FeeCollectorResponse proto.InternalMessageInfo
+
+func (m *FeeCollectorResponse) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
 	}
-	var l int
-	_ = l
-	l = m.Price.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *GasPricesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FeeCollectorResponse) GetSwept() []SweptFees {
+	if m != nil {
+		return m.Swept
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *GasPricesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FeeCollectorResponse) GetTotalSwept() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalSwept
 	}
-	var l int
-	_ = l
-	if len(m.Prices) > 0 {
-		for _, e := range m.Prices {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// DenomRatesRequest is the request type for the Query/DenomRates RPC method.
+type DenomRatesRequest struct {
+}
+
+func (m *DenomRatesRequest) Reset()         { *m = DenomRatesRequest{} }
+func (m *DenomRatesRequest) String() string { return proto.CompactTextString(m) }
+func (*DenomRatesRequest) ProtoMessage()    {}
+func (*DenomRatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{23}
+}
+func (m *DenomRatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomRatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomRatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *DenomRatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomRatesRequest.Merge(m, src)
+}
+func (m *DenomRatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomRatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomRatesRequest.DiscardUnknown(m)
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+var xxx_messageInfo_DenomRatesRequest proto.InternalMessageInfo
+
+// DenomRatesResponse is the response type for the Query/DenomRates RPC method.
+type DenomRatesResponse struct {
+	// denom_rates are the static conversion rates, ordered by denom.
+	DenomRates []DenomRate `protobuf:"bytes,1,rep,name=denom_rates,json=denomRates,proto3" json:"denom_rates"`
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *DenomRatesResponse) Reset()         { *m = DenomRatesResponse{} }
+func (m *DenomRatesResponse) String() string { return proto.CompactTextString(m) }
+func (*DenomRatesResponse) ProtoMessage()    {}
+func (*DenomRatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{24}
 }
-func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+func (m *DenomRatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomRatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomRatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		switch fieldNum {
+		return b[:n], nil
+	}
+}
+func (m *DenomRatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomRatesResponse.Merge(m, src)
+}
+func (m *DenomRatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomRatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomRatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomRatesResponse proto.InternalMessageInfo
+
+func (m *DenomRatesResponse) GetDenomRates() []DenomRate {
+	if m != nil {
+		return m.DenomRates
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ParamsRequest)(nil), "feemarket.feemarket.v1.ParamsRequest")
+	proto.RegisterType((*ParamsResponse)(nil), "feemarket.feemarket.v1.ParamsResponse")
+	proto.RegisterType((*StateRequest)(nil), "feemarket.feemarket.v1.StateRequest")
+	proto.RegisterType((*StateResponse)(nil), "feemarket.feemarket.v1.StateResponse")
+	proto.RegisterType((*GasPriceRequest)(nil), "feemarket.feemarket.v1.GasPriceRequest")
+	proto.RegisterType((*GasPriceResponse)(nil), "feemarket.feemarket.v1.GasPriceResponse")
+	proto.RegisterType((*GasPricesRequest)(nil), "feemarket.feemarket.v1.GasPricesRequest")
+	proto.RegisterType((*GasPricesResponse)(nil), "feemarket.feemarket.v1.GasPricesResponse")
+	proto.RegisterType((*DenomGasPriceStatus)(nil), "feemarket.feemarket.v1.DenomGasPriceStatus")
+	proto.RegisterType((*ScheduledParamsRequest)(nil), "feemarket.feemarket.v1.ScheduledParamsRequest")
+	proto.RegisterType((*ScheduledParamsResponse)(nil), "feemarket.feemarket.v1.ScheduledParamsResponse")
+	proto.RegisterType((*GasPriceHistoryRequest)(nil), "feemarket.feemarket.v1.GasPriceHistoryRequest")
+	proto.RegisterType((*GasPriceHistoryResponse)(nil), "feemarket.feemarket.v1.GasPriceHistoryResponse")
+	proto.RegisterType((*GasPriceRecordRequest)(nil), "feemarket.feemarket.v1.GasPriceRecordRequest")
+	proto.RegisterType((*GasPriceRecordResponse)(nil), "feemarket.feemarket.v1.GasPriceRecordResponse")
+	proto.RegisterType((*FeeHistoryRequest)(nil), "feemarket.feemarket.v1.FeeHistoryRequest")
+	proto.RegisterType((*FeeHistoryResponse)(nil), "feemarket.feemarket.v1.FeeHistoryResponse")
+	proto.RegisterType((*FeeHistoryReward)(nil), "feemarket.feemarket.v1.FeeHistoryReward")
+	proto.RegisterType((*ProjectGasPriceRequest)(nil), "feemarket.feemarket.v1.ProjectGasPriceRequest")
+	proto.RegisterType((*ProjectGasPriceResponse)(nil), "feemarket.feemarket.v1.ProjectGasPriceResponse")
+	proto.RegisterType((*ProjectedGasPrice)(nil), "feemarket.feemarket.v1.ProjectedGasPrice")
+	proto.RegisterType((*FeeCollectorRequest)(nil), "feemarket.feemarket.v1.FeeCollectorRequest")
+	proto.RegisterType((*FeeCollectorResponse)(nil), "feemarket.feemarket.v1.FeeCollectorResponse")
+	proto.RegisterType((*DenomRatesRequest)(nil), "feemarket.feemarket.v1.DenomRatesRequest")
+	proto.RegisterType((*DenomRatesResponse)(nil), "feemarket.feemarket.v1.DenomRatesResponse")
+}
+
+func init() {
+	proto.RegisterFile("feemarket/feemarket/v1/query.proto", fileDescriptor_d683b3b0d8494138)
+}
+
+var fileDescriptor_d683b3b0d8494138 = []byte{
+	// 1492 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcb, 0x6f, 0x1b, 0xd5,
+	0x17, 0xce, 0xc4, 0x89, 0xdb, 0x9c, 0xbc, 0xea, 0x9b, 0xd4, 0x75, 0x9d, 0xd4, 0x49, 0xa6, 0x6d,
+	0xe2, 0xa6, 0xbf, 0x78, 0x7e, 0x29, 0x42, 0x02, 0x09, 0x36, 0x49, 0x48, 0x8b, 0x84, 0x50, 0x3a,
+	0x11, 0xd0, 0xb2, 0xa8, 0x7b, 0x3d, 0xbe, 0xb5, 0xa7, 0x19, 0xcf, 0x75, 0xe6, 0x8e, 0x13, 0xd2,
+	0xaa, 0x9b, 0x2e, 0x58, 0x22, 0x24, 0x76, 0x74, 0x81, 0x58, 0x20, 0xa1, 0xae, 0x58, 0x54, 0xaa,
+	0xd8, 0xb3, 0xe8, 0xb2, 0x82, 0x0d, 0x42, 0xa2, 0xa0, 0x16, 0x89, 0x7f, 0x03, 0xdd, 0xc7, 0x78,
+	0xc6, 0x8e, 0x27, 0x1e, 0x82, 0xba, 0x69, 0x67, 0xce, 0x9c, 0xc7, 0x77, 0xbf, 0x73, 0x66, 0xce,
+	0xe7, 0x80, 0x7e, 0x87, 0x90, 0x06, 0xf6, 0x76, 0x88, 0x6f, 0x84, 0x57, 0x7b, 0xab, 0xc6, 0x6e,
+	0x8b, 0x78, 0x07, 0xa5, 0xa6, 0x47, 0x7d, 0x8a, 0xb2, 0xed, 0x27, 0xa5, 0xf0, 0x6a, 0x6f, 0x35,
+	0x7f, 0xd6, 0xa2, 0xac, 0x41, 0x59, 0x59, 0x78, 0x19, 0xf2, 0x46, 0x86, 0xe4, 0xa7, 0x6b, 0xb4,
+	0x46, 0xa5, 0x9d, 0x5f, 0x29, 0xeb, 0x6c, 0x8d, 0xd2, 0x9a, 0x43, 0x0c, 0xdc, 0xb4, 0x0d, 0xec,
+	0xba, 0xd4, 0xc7, 0xbe, 0x4d, 0xdd, 0x20, 0xa6, 0x20, 0x33, 0x18, 0x15, 0xcc, 0x88, 0xb1, 0xb7,
+	0x5a, 0x21, 0x3e, 0x5e, 0x35, 0x2c, 0x6a, 0xbb, 0xea, 0xf9, 0x72, 0xf4, 0xb9, 0xc0, 0xd7, 0xf6,
+	0x6a, 0xe2, 0x9a, 0xed, 0x8a, 0x64, 0xca, 0x37, 0x83, 0x1b, 0xb6, 0x4b, 0x0d, 0xf1, 0xaf, 0x32,
+	0x9d, 0x8f, 0x39, 0x69, 0x13, 0x7b, 0xb8, 0x11, 0x60, 0xb8, 0x10, 0xe3, 0x54, 0x23, 0x2e, 0x61,
+	0xb6, 0xf2, 0xd2, 0x27, 0x61, 0x7c, 0x4b, 0x44, 0x99, 0x64, 0xb7, 0x45, 0x98, 0xaf, 0x7f, 0x08,
+	0x13, 0x81, 0x81, 0x35, 0xa9, 0xcb, 0x08, 0x7a, 0x07, 0xd2, 0x32, 0x71, 0x4e, 0x9b, 0xd7, 0x8a,
+	0xa3, 0x57, 0x0a, 0xa5, 0xde, 0x24, 0x96, 0x64, 0xdc, 0xda, 0xd0, 0xb3, 0x17, 0x73, 0x03, 0xa6,
+	0x8a, 0xd1, 0x27, 0x60, 0x6c, 0xdb, 0xc7, 0x3e, 0x09, 0xf2, 0x57, 0x60, 0x5c, 0xdd, 0xab, 0xf4,
+	0x6f, 0xc3, 0x30, 0xe3, 0x06, 0x95, 0xfd, 0x5c, 0x5c, 0x76, 0x11, 0xa5, 0x92, 0xcb, 0x08, 0x94,
+	0x85, 0xf4, 0x1d, 0x8f, 0xde, 0x23, 0x6e, 0x6e, 0x70, 0x5e, 0x2b, 0x9e, 0x34, 0xd5, 0x9d, 0xbe,
+	0x04, 0x93, 0x57, 0x31, 0xdb, 0xf2, 0x6c, 0x2b, 0x28, 0x8b, 0xa6, 0x61, 0xb8, 0x4a, 0x5c, 0xda,
+	0x10, 0x55, 0x46, 0x4c, 0x79, 0xa3, 0x5f, 0x87, 0x53, 0xa1, 0xa3, 0xc2, 0xf3, 0x2e, 0x0c, 0x37,
+	0xb9, 0x41, 0xe1, 0x99, 0x2d, 0xa9, 0x69, 0xe0, 0xbd, 0x2a, 0xa9, 0x2e, 0x95, 0x36, 0x88, 0xb5,
+	0x4e, 0x6d, 0x77, 0x6d, 0x84, 0xc3, 0xf9, 0xfe, 0xef, 0x1f, 0x96, 0x35, 0x53, 0x46, 0xe9, 0x28,
+	0x4c, 0xd9, 0xe6, 0xf4, 0x77, 0x0d, 0x32, 0x11, 0xa3, 0x2a, 0xe4, 0x42, 0x5a, 0x84, 0x70, 0x5e,
+	0x53, 0x7d, 0x2b, 0xbd, 0xc5, 0x2b, 0x3d, 0xfe, 0x63, 0xee, 0x72, 0xcd, 0xf6, 0xeb, 0xad, 0x4a,
+	0xc9, 0xa2, 0x0d, 0x35, 0xa7, 0xea, 0xbf, 0x15, 0x56, 0xdd, 0x31, 0xfc, 0x83, 0x26, 0x61, 0x41,
+	0x0c, 0x93, 0xc0, 0x54, 0x15, 0x74, 0x03, 0x26, 0xc4, 0xa9, 0xcb, 0x9c, 0xbc, 0x16, 0x23, 0x2c,
+	0x37, 0x28, 0xea, 0x5e, 0x8e, 0x63, 0x7c, 0x83, 0x7b, 0x07, 0xb8, 0xb7, 0x45, 0x90, 0xe2, 0x7f,
+	0x5c, 0x24, 0xda, 0x56, 0x79, 0xf4, 0xef, 0x34, 0x98, 0xea, 0xe1, 0xdc, 0x9b, 0x74, 0x34, 0x0b,
+	0x23, 0x78, 0x0f, 0xdb, 0x0e, 0xae, 0x38, 0x44, 0x35, 0x2e, 0x34, 0xf0, 0x18, 0xe2, 0x79, 0xd4,
+	0xcb, 0xa5, 0x64, 0x8c, 0xb8, 0x41, 0x1b, 0x00, 0x0e, 0x66, 0x7e, 0x59, 0x76, 0x66, 0x48, 0x74,
+	0xe6, 0x62, 0x22, 0xdc, 0xe6, 0x08, 0x0f, 0x14, 0x97, 0x7a, 0x0e, 0xb2, 0xdb, 0x56, 0x9d, 0x54,
+	0x5b, 0x0e, 0xa9, 0x76, 0x4e, 0x3d, 0x83, 0x33, 0x87, 0x9e, 0xa8, 0x36, 0xdd, 0x80, 0x53, 0x2c,
+	0x78, 0x54, 0x6e, 0xbf, 0x08, 0x9c, 0xb8, 0xa5, 0xd8, 0x51, 0xed, 0x4c, 0xa5, 0x48, 0x9b, 0x64,
+	0x9d, 0x66, 0xfd, 0x36, 0x64, 0x03, 0x94, 0xd7, 0x6c, 0xe6, 0x53, 0xef, 0x20, 0x98, 0xd6, 0x4d,
+	0x80, 0xf0, 0x3b, 0xa0, 0x06, 0x71, 0xb1, 0x63, 0x3c, 0xe4, 0x47, 0x2d, 0x18, 0x92, 0x2d, 0x5c,
+	0x0b, 0x26, 0xdd, 0x8c, 0x44, 0xea, 0x8f, 0x35, 0x38, 0x73, 0xa8, 0x84, 0x3a, 0xd7, 0x26, 0x9c,
+	0xf0, 0x88, 0x45, 0xbd, 0x6a, 0x70, 0x9c, 0xc5, 0xb8, 0xe3, 0x84, 0xaf, 0x08, 0x77, 0x57, 0xa7,
+	0x09, 0x82, 0xd1, 0xd5, 0x0e, 0xac, 0x83, 0x02, 0xeb, 0x52, 0x5f, 0xac, 0x12, 0x44, 0x07, 0x58,
+	0x03, 0x4e, 0x77, 0x56, 0x0a, 0xd8, 0xc8, 0x42, 0xba, 0x4e, 0xec, 0x5a, 0xdd, 0x17, 0x4c, 0xa4,
+	0x4c, 0x75, 0xa7, 0xdf, 0x82, 0x6c, 0x77, 0x80, 0x3a, 0xdb, 0x06, 0xa4, 0x25, 0xbc, 0x36, 0x77,
+	0xff, 0xe6, 0x68, 0x2a, 0x56, 0xff, 0x51, 0x83, 0xcc, 0x26, 0xe9, 0xee, 0xcd, 0x1c, 0x8c, 0x56,
+	0x1c, 0x6a, 0xed, 0x94, 0x2d, 0xda, 0x72, 0x25, 0xa4, 0x21, 0x13, 0x84, 0x69, 0x9d, 0x5b, 0xd0,
+	0x02, 0x8c, 0xb9, 0x64, 0x9f, 0x30, 0xbf, 0x2c, 0x8c, 0x82, 0x92, 0x94, 0x39, 0x2a, 0x6d, 0x6b,
+	0xdc, 0x84, 0x6e, 0x03, 0xf2, 0xc8, 0x3e, 0xf6, 0xaa, 0xe5, 0x26, 0xf1, 0x2c, 0xe2, 0xfa, 0xb6,
+	0x43, 0x58, 0x2e, 0x35, 0x9f, 0x2a, 0x8e, 0xac, 0xad, 0x72, 0x0c, 0xbf, 0xbd, 0x98, 0x9b, 0x91,
+	0x14, 0xb2, 0xea, 0x4e, 0xc9, 0xa6, 0x46, 0x03, 0xfb, 0xf5, 0xd2, 0x07, 0xa4, 0x86, 0xad, 0x83,
+	0x0d, 0x62, 0xfd, 0xfc, 0x64, 0x05, 0x14, 0xc3, 0x1b, 0xc4, 0x32, 0x33, 0x32, 0xd9, 0x56, 0x98,
+	0x4b, 0x7f, 0x3a, 0x08, 0x28, 0x8a, 0x5d, 0x11, 0xb3, 0x00, 0x63, 0xd4, 0xa9, 0x86, 0xd8, 0x24,
+	0xa1, 0xa3, 0xd2, 0x26, 0xb1, 0xdd, 0x84, 0x49, 0xde, 0xb5, 0x72, 0x0d, 0xb3, 0xb2, 0xfa, 0x3e,
+	0x0d, 0x1e, 0x17, 0xd8, 0x38, 0xcf, 0xd4, 0xfe, 0xf2, 0xf1, 0xd4, 0x3c, 0x6b, 0x8b, 0x91, 0x6a,
+	0xd9, 0xe3, 0x4d, 0xff, 0x0f, 0x67, 0x1e, 0xaf, 0x61, 0xf6, 0x11, 0x23, 0x55, 0x53, 0xe4, 0x41,
+	0xd7, 0xe0, 0x84, 0x24, 0x81, 0xe5, 0x86, 0xc4, 0x34, 0x17, 0xe3, 0x5a, 0x1e, 0x65, 0x65, 0x1f,
+	0xb7, 0x9b, 0x1e, 0x84, 0xeb, 0x37, 0xe1, 0x54, 0xb7, 0x0b, 0x7a, 0x0f, 0x86, 0x7c, 0xbb, 0x29,
+	0x5f, 0x94, 0x63, 0xa1, 0x15, 0xe1, 0xfa, 0x23, 0x0d, 0xb2, 0x5b, 0x1e, 0xbd, 0x4b, 0x2c, 0xbf,
+	0x7b, 0x3f, 0xf5, 0x9d, 0xaa, 0xdb, 0x80, 0x5a, 0xbe, 0xed, 0xd8, 0xf7, 0xc4, 0xcb, 0x12, 0xd0,
+	0x77, 0xec, 0xce, 0x64, 0x22, 0xc9, 0x24, 0x85, 0xfa, 0x53, 0x0d, 0xce, 0x1c, 0x42, 0xa7, 0xe6,
+	0xe6, 0x13, 0x98, 0xe8, 0x1c, 0x0a, 0xf9, 0x49, 0x3f, 0x4e, 0xe5, 0xb1, 0xe8, 0x4c, 0xa0, 0x75,
+	0x18, 0x6a, 0x62, 0xbf, 0xae, 0x56, 0xd1, 0xa5, 0x58, 0x69, 0x21, 0x71, 0x91, 0x6a, 0x10, 0xa8,
+	0xba, 0x26, 0x82, 0xf5, 0x9f, 0x34, 0xc8, 0x1c, 0xf2, 0x78, 0x7d, 0x98, 0x3f, 0x86, 0x71, 0x87,
+	0x60, 0xcf, 0xb5, 0xdd, 0x1a, 0xef, 0x83, 0x5c, 0x62, 0xc7, 0xcb, 0x1b, 0xe4, 0x31, 0xb1, 0x4f,
+	0xf4, 0xd3, 0x30, 0xb5, 0x49, 0xc8, 0x3a, 0x75, 0x1c, 0x62, 0xf9, 0xd4, 0x0b, 0x76, 0xd3, 0x93,
+	0x41, 0x98, 0xee, 0xb4, 0xab, 0xa6, 0xdc, 0x85, 0x13, 0x15, 0xec, 0x60, 0x57, 0x9c, 0x8c, 0xd3,
+	0x77, 0xb6, 0xa7, 0x82, 0x10, 0xf2, 0xe1, 0x4d, 0x25, 0x1f, 0x8a, 0x09, 0xe4, 0x43, 0x44, 0x3b,
+	0x04, 0x05, 0xb8, 0x2a, 0x62, 0xfb, 0xa4, 0xe9, 0xab, 0x46, 0x2d, 0xc4, 0xae, 0x3e, 0xee, 0xb4,
+	0x49, 0x08, 0x6b, 0x2b, 0x35, 0x6e, 0x40, 0xbb, 0x30, 0xea, 0x53, 0x1f, 0x3b, 0x65, 0x99, 0x24,
+	0xf5, 0x9a, 0xe0, 0x82, 0x28, 0x22, 0x30, 0xe8, 0x53, 0x90, 0x11, 0x42, 0x80, 0x53, 0xdb, 0xde,
+	0xf3, 0xb7, 0x00, 0x45, 0x8d, 0x8a, 0xc8, 0x6b, 0x30, 0x2a, 0x95, 0x11, 0xef, 0x66, 0xb0, 0x0e,
+	0x17, 0x8e, 0x94, 0x17, 0x66, 0x28, 0x46, 0xa1, 0xda, 0xce, 0x78, 0xe5, 0x9b, 0x31, 0x18, 0xbe,
+	0xce, 0xd7, 0x1d, 0x6a, 0x41, 0x5a, 0xae, 0x79, 0x74, 0xf1, 0x68, 0xbd, 0xac, 0xa0, 0xe5, 0x17,
+	0xfb, 0xb9, 0x49, 0xb0, 0xfa, 0xec, 0xc3, 0x5f, 0xfe, 0xfa, 0x6a, 0x30, 0x8b, 0xa6, 0x7b, 0x69,
+	0x7f, 0xb4, 0x0b, 0xc3, 0x42, 0x28, 0xa3, 0x0b, 0x47, 0xea, 0xe8, 0xa0, 0xe8, 0xc5, 0x3e, 0x5e,
+	0xaa, 0xe6, 0x8c, 0xa8, 0x79, 0x1a, 0x4d, 0x75, 0xd6, 0x94, 0x2a, 0xfc, 0x73, 0x0d, 0x4e, 0xb6,
+	0xdf, 0x8d, 0xa5, 0xfe, 0x9b, 0x56, 0x56, 0x2e, 0xf6, 0x77, 0x54, 0xc5, 0x97, 0x44, 0xf1, 0x05,
+	0x34, 0xd7, 0xf5, 0x3b, 0x26, 0x78, 0xad, 0x8d, 0xfb, 0xa2, 0x01, 0x0f, 0xd0, 0x43, 0x0d, 0x46,
+	0xc2, 0x65, 0xd3, 0xb7, 0x40, 0x9b, 0xf9, 0x4b, 0x09, 0x3c, 0x15, 0x96, 0x79, 0x81, 0x25, 0x8f,
+	0x72, 0x31, 0x58, 0x18, 0xfa, 0x5a, 0x83, 0xc9, 0x2e, 0xfd, 0x87, 0x4a, 0x09, 0x85, 0x62, 0x00,
+	0xc8, 0x48, 0xec, 0xaf, 0x60, 0x2d, 0x0a, 0x58, 0xf3, 0xa8, 0xd0, 0xd5, 0x9f, 0x2e, 0xdd, 0x8a,
+	0x1e, 0x69, 0xe1, 0x2f, 0x23, 0xb5, 0xe1, 0xe2, 0xc1, 0xf5, 0xd6, 0xa6, 0x79, 0x23, 0xb1, 0x7f,
+	0xc2, 0xfe, 0x95, 0xeb, 0x0a, 0xc9, 0xb7, 0x1a, 0x4c, 0x74, 0x0a, 0x32, 0xb4, 0x92, 0x4c, 0xb8,
+	0x05, 0xd8, 0x4a, 0x49, 0xdd, 0x15, 0xb4, 0xff, 0x0b, 0x68, 0xcb, 0xa8, 0xd8, 0x07, 0x9a, 0x71,
+	0x5f, 0x4a, 0xce, 0x07, 0x7c, 0xd8, 0x21, 0x94, 0x07, 0xe8, 0x52, 0x12, 0x95, 0x21, 0xb1, 0x2d,
+	0x27, 0x71, 0x55, 0xb8, 0x16, 0x04, 0xae, 0x19, 0x74, 0xb6, 0x13, 0xd7, 0x1d, 0x12, 0x92, 0xc5,
+	0x5b, 0xd9, 0xb5, 0xad, 0xe3, 0x5b, 0xd9, 0x5b, 0x74, 0xe4, 0x8d, 0xc4, 0xfe, 0x47, 0xb7, 0xb2,
+	0x29, 0xdd, 0xc3, 0x4d, 0x8b, 0xbe, 0xd0, 0x60, 0x2c, 0xba, 0xb3, 0xd0, 0xe5, 0x23, 0x4e, 0xdf,
+	0xbd, 0xf1, 0xf2, 0xff, 0x4b, 0xe6, 0xac, 0x40, 0x9d, 0x17, 0xa0, 0xce, 0xa1, 0x99, 0xc3, 0x64,
+	0x59, 0xed, 0xfa, 0xbc, 0x6f, 0xe1, 0x97, 0x3f, 0xbe, 0x6f, 0x87, 0x56, 0x46, 0x7e, 0x39, 0x89,
+	0xeb, 0xd1, 0x7d, 0x8b, 0x2c, 0x97, 0xb5, 0xf7, 0x9f, 0xbd, 0x2c, 0x68, 0xcf, 0x5f, 0x16, 0xb4,
+	0x3f, 0x5f, 0x16, 0xb4, 0x2f, 0x5f, 0x15, 0x06, 0x9e, 0xbf, 0x2a, 0x0c, 0xfc, 0xfa, 0xaa, 0x30,
+	0xf0, 0xa9, 0x11, 0xd9, 0x75, 0x6c, 0xc7, 0x6e, 0xae, 0x34, 0xc8, 0x5e, 0x24, 0xcf, 0x67, 0x91,
+	0x6b, 0xb1, 0xf8, 0x2a, 0x69, 0xf1, 0x27, 0x9c, 0x37, 0xfe, 0x19, 0x00, 0x6a, 0xf5, 0x60, 0x74,
+	0xf9, 0x12, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the current feemarket module parameters.
+	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// State returns the current feemarket module state.
+	State(ctx context.Context, in *StateRequest, opts ...grpc.CallOption) (*StateResponse, error)
+	// GasPrice returns the current feemarket module gas price
+	// for specified denom.
+	GasPrice(ctx context.Context, in *GasPriceRequest, opts ...grpc.CallOption) (*GasPriceResponse, error)
+	// GasPrices returns the current feemarket module list of gas prices
+	// in all available denoms.
+	GasPrices(ctx context.Context, in *GasPricesRequest, opts ...grpc.CallOption) (*GasPricesResponse, error)
+	// ScheduledParams returns the pending parameter changes ordered by the
+	// height at which they are applied.
+	ScheduledParams(ctx context.Context, in *ScheduledParamsRequest, opts ...grpc.CallOption) (*ScheduledParamsResponse, error)
+	// GasPriceHistory returns the recorded gas price history ordered by height.
+	GasPriceHistory(ctx context.Context, in *GasPriceHistoryRequest, opts ...grpc.CallOption) (*GasPriceHistoryResponse, error)
+	// GasPriceRecord returns the recorded gas price at the given height.
+	GasPriceRecord(ctx context.Context, in *GasPriceRecordRequest, opts ...grpc.CallOption) (*GasPriceRecordResponse, error)
+	// FeeHistory returns the base gas prices, gas used ratios and tip
+	// percentiles of a range of recorded blocks, modeled after Ethereum's
+	// eth_feeHistory.
+	FeeHistory(ctx context.Context, in *FeeHistoryRequest, opts ...grpc.CallOption) (*FeeHistoryResponse, error)
+	// ProjectGasPrice returns the projected base gas price and learning rate of
+	// the next blocks under an assumed block utilization.
+	ProjectGasPrice(ctx context.Context, in *ProjectGasPriceRequest, opts ...grpc.CallOption) (*ProjectGasPriceResponse, error)
+	// FeeCollector returns the balance of the feemarket fee collector and the
+	// cumulative amount of fees swept from it.
+	FeeCollector(ctx context.Context, in *FeeCollectorRequest, opts ...grpc.CallOption) (*FeeCollectorResponse, error)
+	// DenomRates returns the static conversion rates of the denoms that fees can
+	// be paid in.
+	DenomRates(ctx context.Context, in *DenomRatesRequest, opts ...grpc.CallOption) (*DenomRatesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
+	out := new(ParamsResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) State(ctx context.Context, in *StateRequest, opts ...grpc.CallOption) (*StateResponse, error) {
+	out := new(StateResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/State", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GasPrice(ctx context.Context, in *GasPriceRequest, opts ...grpc.CallOption) (*GasPriceResponse, error) {
+	out := new(GasPriceResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/GasPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GasPrices(ctx context.Context, in *GasPricesRequest, opts ...grpc.CallOption) (*GasPricesResponse, error) {
+	out := new(GasPricesResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/GasPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledParams(ctx context.Context, in *ScheduledParamsRequest, opts ...grpc.CallOption) (*ScheduledParamsResponse, error) {
+	out := new(ScheduledParamsResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/ScheduledParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GasPriceHistory(ctx context.Context, in *GasPriceHistoryRequest, opts ...grpc.CallOption) (*GasPriceHistoryResponse, error) {
+	out := new(GasPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/GasPriceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GasPriceRecord(ctx context.Context, in *GasPriceRecordRequest, opts ...grpc.CallOption) (*GasPriceRecordResponse, error) {
+	out := new(GasPriceRecordResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/GasPriceRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeHistory(ctx context.Context, in *FeeHistoryRequest, opts ...grpc.CallOption) (*FeeHistoryResponse, error) {
+	out := new(FeeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/FeeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProjectGasPrice(ctx context.Context, in *ProjectGasPriceRequest, opts ...grpc.CallOption) (*ProjectGasPriceResponse, error) {
+	out := new(ProjectGasPriceResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/ProjectGasPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeCollector(ctx context.Context, in *FeeCollectorRequest, opts ...grpc.CallOption) (*FeeCollectorResponse, error) {
+	out := new(FeeCollectorResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/FeeCollector", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomRates(ctx context.Context, in *DenomRatesRequest, opts ...grpc.CallOption) (*DenomRatesResponse, error) {
+	out := new(DenomRatesResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/DenomRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns the current feemarket module parameters.
+	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// State returns the current feemarket module state.
+	State(context.Context, *StateRequest) (*StateResponse, error)
+	// GasPrice returns the current feemarket module gas price
+	// for specified denom.
+	GasPrice(context.Context, *GasPriceRequest) (*GasPriceResponse, error)
+	// GasPrices returns the current feemarket module list of gas prices
+	// in all available denoms.
+	GasPrices(context.Context, *GasPricesRequest) (*GasPricesResponse, error)
+	// ScheduledParams returns the pending parameter changes ordered by the
+	// height at which they are applied.
+	ScheduledParams(context.Context, *ScheduledParamsRequest) (*ScheduledParamsResponse, error)
+	// GasPriceHistory returns the recorded gas price history ordered by height.
+	GasPriceHistory(context.Context, *GasPriceHistoryRequest) (*GasPriceHistoryResponse, error)
+	// GasPriceRecord returns the recorded gas price at the given height.
+	GasPriceRecord(context.Context, *GasPriceRecordRequest) (*GasPriceRecordResponse, error)
+	// FeeHistory returns the base gas prices, gas used ratios and tip
+	// percentiles of a range of recorded blocks, modeled after Ethereum's
+	// eth_feeHistory.
+	FeeHistory(context.Context, *FeeHistoryRequest) (*FeeHistoryResponse, error)
+	// ProjectGasPrice returns the projected base gas price and learning rate of
+	// the next blocks under an assumed block utilization.
+	ProjectGasPrice(context.Context, *ProjectGasPriceRequest) (*ProjectGasPriceResponse, error)
+	// FeeCollector returns the balance of the feemarket fee collector and the
+	// cumulative amount of fees swept from it.
+	FeeCollector(context.Context, *FeeCollectorRequest) (*FeeCollectorResponse, error)
+	// DenomRates returns the static conversion rates of the denoms that fees can
+	// be paid in.
+	DenomRates(context.Context, *DenomRatesRequest) (*DenomRatesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) State(ctx context.Context, req *StateRequest) (*StateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method State not implemented")
+}
+func (*UnimplementedQueryServer) GasPrice(ctx context.Context, req *GasPriceRequest) (*GasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasPrice not implemented")
+}
+func (*UnimplementedQueryServer) GasPrices(ctx context.Context, req *GasPricesRequest) (*GasPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasPrices not implemented")
+}
+func (*UnimplementedQueryServer) ScheduledParams(ctx context.Context, req *ScheduledParamsRequest) (*ScheduledParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledParams not implemented")
+}
+func (*UnimplementedQueryServer) GasPriceHistory(ctx context.Context, req *GasPriceHistoryRequest) (*GasPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasPriceHistory not implemented")
+}
+func (*UnimplementedQueryServer) GasPriceRecord(ctx context.Context, req *GasPriceRecordRequest) (*GasPriceRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasPriceRecord not implemented")
+}
+func (*UnimplementedQueryServer) FeeHistory(ctx context.Context, req *FeeHistoryRequest) (*FeeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeHistory not implemented")
+}
+func (*UnimplementedQueryServer) ProjectGasPrice(ctx context.Context, req *ProjectGasPriceRequest) (*ProjectGasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectGasPrice not implemented")
+}
+func (*UnimplementedQueryServer) FeeCollector(ctx context.Context, req *FeeCollectorRequest) (*FeeCollectorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeCollector not implemented")
+}
+func (*UnimplementedQueryServer) DenomRates(ctx context.Context, req *DenomRatesRequest) (*DenomRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomRates not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_State_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).State(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Query/State",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).State(ctx, req.(*StateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GasPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Query/GasPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasPrice(ctx, req.(*GasPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GasPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GasPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Query/GasPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasPrices(ctx, req.(*GasPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduledParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Query/ScheduledParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledParams(ctx, req.(*ScheduledParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GasPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GasPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GasPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Query/GasPriceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasPriceHistory(ctx, req.(*GasPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GasPriceRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GasPriceRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GasPriceRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Query/GasPriceRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasPriceRecord(ctx, req.(*GasPriceRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Query/FeeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeHistory(ctx, req.(*FeeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectGasPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectGasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Query/ProjectGasPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectGasPrice(ctx, req.(*ProjectGasPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeCollector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeeCollectorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeCollector(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Query/FeeCollector",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeCollector(ctx, req.(*FeeCollectorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenomRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Query/DenomRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomRates(ctx, req.(*DenomRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "feemarket.feemarket.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "State",
+			Handler:    _Query_State_Handler,
+		},
+		{
+			MethodName: "GasPrice",
+			Handler:    _Query_GasPrice_Handler,
+		},
+		{
+			MethodName: "GasPrices",
+			Handler:    _Query_GasPrices_Handler,
+		},
+		{
+			MethodName: "ScheduledParams",
+			Handler:    _Query_ScheduledParams_Handler,
+		},
+		{
+			MethodName: "GasPriceHistory",
+			Handler:    _Query_GasPriceHistory_Handler,
+		},
+		{
+			MethodName: "GasPriceRecord",
+			Handler:    _Query_GasPriceRecord_Handler,
+		},
+		{
+			MethodName: "FeeHistory",
+			Handler:    _Query_FeeHistory_Handler,
+		},
+		{
+			MethodName: "ProjectGasPrice",
+			Handler:    _Query_ProjectGasPrice_Handler,
+		},
+		{
+			MethodName: "FeeCollector",
+			Handler:    _Query_FeeCollector_Handler,
+		},
+		{
+			MethodName: "DenomRates",
+			Handler:    _Query_DenomRates_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "feemarket/feemarket/v1/query.proto",
+}
+
+func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *StateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *StateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomStatuses) > 0 {
+		for iNdEx := len(m.DenomStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomGasPriceStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomGasPriceStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomGasPriceStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastPrice != nil {
+		{
+			size, err := m.LastPrice.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Available {
+		i--
+		if m.Available {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScheduledParams) > 0 {
+		for iNdEx := len(m.ScheduledParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPriceHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPriceHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPriceHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPriceHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPriceHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPriceHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPriceRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPriceRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPriceRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPriceRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPriceRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPriceRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardPercentiles) > 0 {
+		for iNdEx := len(m.RewardPercentiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.RewardPercentiles[iNdEx].Size()
+				i -= size
+				if _, err := m.RewardPercentiles[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.NewestBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NewestBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.GasUsedRatios) > 0 {
+		for iNdEx := len(m.GasUsedRatios) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.GasUsedRatios[iNdEx].Size()
+				i -= size
+				if _, err := m.GasUsedRatios[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BaseGasPrices) > 0 {
+		for iNdEx := len(m.BaseGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.BaseGasPrices[iNdEx].Size()
+				i -= size
+				if _, err := m.BaseGasPrices[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.OldestBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OldestBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeHistoryReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeHistoryReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeHistoryReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tips) > 0 {
+		for iNdEx := len(m.Tips) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Tips[iNdEx].Size()
+				i -= size
+				if _, err := m.Tips[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectGasPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UtilizationRatios) > 0 {
+		for iNdEx := len(m.UtilizationRatios) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.UtilizationRatios[iNdEx].Size()
+				i -= size
+				if _, err := m.UtilizationRatios[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BlockCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectGasPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Path) > 0 {
+		for iNdEx := len(m.Path) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Path[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.BaseGasPrice.Size()
+		i -= size
+		if _, err := m.BaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectedGasPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectedGasPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectedGasPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LearningRate.Size()
+		i -= size
+		if _, err := m.LearningRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.BaseGasPrice.Size()
+		i -= size
+		if _, err := m.BaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeCollectorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeCollectorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeCollectorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeCollectorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeCollectorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeCollectorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalSwept) > 0 {
+		for iNdEx := len(m.TotalSwept) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalSwept[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Swept) > 0 {
+		for iNdEx := len(m.Swept) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Swept[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomRatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomRatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomRatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomRatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomRatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomRates) > 0 {
+		for iNdEx := len(m.DenomRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *StateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *StateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.State.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Frozen {
+		n += 2
+	}
+	return n
+}
+
+func (m *GasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *GasPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GasPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.DenomStatuses) > 0 {
+		for _, e := range m.DenomStatuses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomGasPriceStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Available {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LastPrice != nil {
+		l = m.LastPrice.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduledParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ScheduledParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScheduledParams) > 0 {
+		for _, e := range m.ScheduledParams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GasPriceHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GasPriceHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GasPriceRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *GasPriceRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *FeeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockCount != 0 {
+		n += 1 + sovQuery(uint64(m.BlockCount))
+	}
+	if m.NewestBlock != 0 {
+		n += 1 + sovQuery(uint64(m.NewestBlock))
+	}
+	if len(m.RewardPercentiles) > 0 {
+		for _, e := range m.RewardPercentiles {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OldestBlock != 0 {
+		n += 1 + sovQuery(uint64(m.OldestBlock))
+	}
+	if len(m.BaseGasPrices) > 0 {
+		for _, e := range m.BaseGasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.GasUsedRatios) > 0 {
+		for _, e := range m.GasUsedRatios {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeHistoryReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tips) > 0 {
+		for _, e := range m.Tips {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProjectGasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockCount != 0 {
+		n += 1 + sovQuery(uint64(m.BlockCount))
+	}
+	if len(m.UtilizationRatios) > 0 {
+		for _, e := range m.UtilizationRatios {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProjectGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseGasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Path) > 0 {
+		for _, e := range m.Path {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProjectedGasPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseGasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LearningRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *FeeCollectorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *FeeCollectorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Swept) > 0 {
+		for _, e := range m.Swept {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalSwept) > 0 {
+		for _, e := range m.TotalSwept {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomRatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DenomRatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DenomRates) > 0 {
+		for _, e := range m.DenomRates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, types.DecCoin{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomStatuses = append(m.DenomStatuses, DenomGasPriceStatus{})
+			if err := m.DenomStatuses[len(m.DenomStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomGasPriceStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomGasPriceStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomGasPriceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Available = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastPrice == nil {
+				m.LastPrice = &DenomGasPrice{}
+			}
+			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledParams = append(m.ScheduledParams, ScheduledParams{})
+			if err := m.ScheduledParams[len(m.ScheduledParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPriceHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPriceHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPriceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPriceHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPriceHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPriceHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, GasPriceRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPriceRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPriceRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPriceRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPriceRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPriceRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPriceRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCount", wireType)
+			}
+			m.BlockCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewestBlock", wireType)
+			}
+			m.NewestBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewestBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPercentiles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.RewardPercentiles = append(m.RewardPercentiles, v)
+			if err := m.RewardPercentiles[len(m.RewardPercentiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestBlock", wireType)
+			}
+			m.OldestBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldestBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGasPrices", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.BaseGasPrices = append(m.BaseGasPrices, v)
+			if err := m.BaseGasPrices[len(m.BaseGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsedRatios", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.GasUsedRatios = append(m.GasUsedRatios, v)
+			if err := m.GasUsedRatios[len(m.GasUsedRatios)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, FeeHistoryReward{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeHistoryReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeHistoryReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeHistoryReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tips", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.Tips = append(m.Tips, v)
+			if err := m.Tips[len(m.Tips)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1016,7 +4862,7 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *ProjectGasPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1039,17 +4885,36 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectGasPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCount", wireType)
+			}
+			m.BlockCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UtilizationRatios", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1059,22 +4924,25 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v cosmossdk_io_math.LegacyDec
+			m.UtilizationRatios = append(m.UtilizationRatios, v)
+			if err := m.UtilizationRatios[len(m.UtilizationRatios)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1099,7 +4967,7 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StateRequest) Unmarshal(dAtA []byte) error {
+func (m *ProjectGasPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1122,12 +4990,80 @@ func (m *StateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectGasPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = append(m.Path, ProjectedGasPrice{})
+			if err := m.Path[len(m.Path)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1149,7 +5085,7 @@ func (m *StateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StateResponse) Unmarshal(dAtA []byte) error {
+func (m *ProjectedGasPrice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1172,17 +5108,17 @@ func (m *StateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectedGasPrice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectedGasPrice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGasPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1192,22 +5128,57 @@ func (m *StateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LearningRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1232,7 +5203,7 @@ func (m *StateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GasPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *FeeCollectorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1255,44 +5226,12 @@ func (m *GasPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GasPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeCollectorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeCollectorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1314,7 +5253,7 @@ func (m *GasPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GasPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *FeeCollectorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1337,15 +5276,15 @@ func (m *GasPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GasPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeCollectorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeCollectorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1372,7 +5311,76 @@ func (m *GasPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Swept", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Swept = append(m.Swept, SweptFees{})
+			if err := m.Swept[len(m.Swept)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSwept", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalSwept = append(m.TotalSwept, types.Coin{})
+			if err := m.TotalSwept[len(m.TotalSwept)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1397,7 +5405,7 @@ func (m *GasPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GasPricesRequest) Unmarshal(dAtA []byte) error {
+func (m *DenomRatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1420,10 +5428,10 @@ func (m *GasPricesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GasPricesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomRatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1447,7 +5455,7 @@ func (m *GasPricesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GasPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *DenomRatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1470,15 +5478,15 @@ func (m *GasPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GasPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomRatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomRates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1505,8 +5513,8 @@ func (m *GasPricesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prices = append(m.Prices, types.DecCoin{})
-			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DenomRates = append(m.DenomRates, DenomRate{})
+			if err := m.DenomRates[len(m.DenomRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
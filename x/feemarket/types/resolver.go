@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -9,9 +10,9 @@ import (
 // DenomResolver is an interface to convert a given token to the feemarket's base token.
 type DenomResolver interface {
 	// ConvertToDenom converts deccoin into the equivalent amount of the token denominated in denom.
-	ConvertToDenom(ctx sdk.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error)
+	ConvertToDenom(ctx context.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error)
 	// ExtraDenoms returns a list of denoms in addition of `Params.base_denom` it's possible to pay fees with
-	ExtraDenoms(ctx sdk.Context) ([]string, error)
+	ExtraDenoms(ctx context.Context) ([]string, error)
 }
 
 // TestDenomResolver is a test implementation of the DenomResolver interface.  It returns "feeCoin.Amount baseDenom" for all coins that are not the baseDenom.
@@ -19,7 +20,7 @@ type DenomResolver interface {
 type TestDenomResolver struct{}
 
 // ConvertToDenom returns "coin.Amount denom" for all coins that are not the denom.
-func (r *TestDenomResolver) ConvertToDenom(_ sdk.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error) {
+func (r *TestDenomResolver) ConvertToDenom(_ context.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error) {
 	if coin.Denom == denom {
 		return coin, nil
 	}
@@ -27,7 +28,7 @@ func (r *TestDenomResolver) ConvertToDenom(_ sdk.Context, coin sdk.DecCoin, deno
 	return sdk.NewDecCoinFromDec(denom, coin.Amount), nil
 }
 
-func (r *TestDenomResolver) ExtraDenoms(_ sdk.Context) ([]string, error) {
+func (r *TestDenomResolver) ExtraDenoms(_ context.Context) ([]string, error) {
 	return []string{}, nil
 }
 
@@ -36,7 +37,7 @@ func (r *TestDenomResolver) ExtraDenoms(_ sdk.Context) ([]string, error) {
 type ErrorDenomResolver struct{}
 
 // ConvertToDenom returns an error for all coins that are not the denom.
-func (r *ErrorDenomResolver) ConvertToDenom(_ sdk.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error) {
+func (r *ErrorDenomResolver) ConvertToDenom(_ context.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error) {
 	if coin.Denom == denom {
 		return coin, nil
 	}
@@ -44,6 +45,6 @@ func (r *ErrorDenomResolver) ConvertToDenom(_ sdk.Context, coin sdk.DecCoin, den
 	return sdk.DecCoin{}, fmt.Errorf("error resolving denom")
 }
 
-func (r *ErrorDenomResolver) ExtraDenoms(_ sdk.Context) ([]string, error) {
+func (r *ErrorDenomResolver) ExtraDenoms(_ context.Context) ([]string, error) {
 	return []string{}, nil
 }
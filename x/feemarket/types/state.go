@@ -41,16 +41,45 @@ func (s *State) IncrementHeight() {
 	s.Window[s.Index] = 0
 }
 
+// Resize resizes the block utilization window to the given size, keeping the most
+// recent entries. The entry of the current height remains the current entry of the
+// resized window.
+func (s *State) Resize(windowSize uint64) {
+	size := uint64(len(s.Window))
+	if size == windowSize {
+		return
+	}
+
+	kept := min(size, windowSize)
+	window := make([]uint64, windowSize)
+
+	// Walk backwards from the current index, starting with the most recent entry.
+	for i := uint64(0); i < kept; i++ {
+		window[kept-1-i] = s.Window[(s.Index+size-i)%size]
+	}
+
+	s.Window = window
+	s.Index = 0
+	if kept > 0 {
+		s.Index = kept - 1
+	}
+}
+
 // UpdateBaseGasPrice updates the learning rate and base gas price based on the AIMD
 // learning rate adjustment algorithm. The learning rate is updated
 // based on the average utilization of the block window. The base gas price is
 // update using the new learning rate and the delta adjustment. Please
 // see the EIP-1559 specification for more details.
 func (s *State) UpdateBaseGasPrice(params Params) (gasPrice math.LegacyDec) {
-	// Panic catch in case there is an overflow
+	// Panic catch in case there is an overflow. If a maximum base gasPrice is
+	// configured, the base gasPrice saturates at the maximum instead of being
+	// reset to the minimum.
 	defer func() {
 		if rec := recover(); rec != nil {
 			s.BaseGasPrice = params.MinBaseGasPrice
+			if params.HasMaxBaseGasPrice() {
+				s.BaseGasPrice = params.MaxBaseGasPrice
+			}
 			gasPrice = s.BaseGasPrice
 		}
 	}()
@@ -81,6 +110,41 @@ func (s *State) UpdateBaseGasPrice(params Params) (gasPrice math.LegacyDec) {
 	return s.BaseGasPrice
 }
 
+// ClampBaseGasPrice bounds the base gas price by the maximum change per block
+// relative to the previous base gas price, the maximum base gas price and the
+// minimum base gas price, in that order. It returns true if the base gas price
+// was modified.
+func (s *State) ClampBaseGasPrice(previous math.LegacyDec, params Params) bool {
+	gasPrice := s.BaseGasPrice
+
+	if params.HasMaxChangePerBlock() {
+		upper := previous.Mul(math.LegacyOneDec().Add(params.MaxChangePerBlock))
+		if gasPrice.GT(upper) {
+			gasPrice = upper
+		}
+
+		lower := previous.Mul(math.LegacyOneDec().Sub(params.MaxChangePerBlock))
+		if gasPrice.LT(lower) {
+			gasPrice = lower
+		}
+	}
+
+	if params.HasMaxBaseGasPrice() && gasPrice.GT(params.MaxBaseGasPrice) {
+		gasPrice = params.MaxBaseGasPrice
+	}
+
+	if !params.MinBaseGasPrice.IsNil() && gasPrice.LT(params.MinBaseGasPrice) {
+		gasPrice = params.MinBaseGasPrice
+	}
+
+	if gasPrice.Equal(s.BaseGasPrice) {
+		return false
+	}
+
+	s.BaseGasPrice = gasPrice
+	return true
+}
+
 // UpdateLearningRate updates the learning rate based on the AIMD
 // learning rate adjustment algorithm. The learning rate is updated
 // based on the average utilization of the block window. There are
@@ -108,7 +172,8 @@ func (s *State) UpdateLearningRate(params Params) (lr math.LegacyDec) {
 
 	// Determine if the average utilization is above or below the target
 	// threshold and adjust the learning rate accordingly.
-	if avg.LTE(params.Gamma) || avg.GTE(math.LegacyOneDec().Sub(params.Gamma)) {
+	lower, upper := params.LearningRateThresholds()
+	if avg.LTE(lower) || avg.GTE(upper) {
 		lr = params.Alpha.Add(s.LearningRate)
 		if lr.GT(params.MaxLearningRate) {
 			lr = params.MaxLearningRate
@@ -125,6 +190,42 @@ func (s *State) UpdateLearningRate(params Params) (lr math.LegacyDec) {
 	return s.LearningRate
 }
 
+// ClampLearningRate bounds the learning rate by the minimum and maximum learning
+// rate. It returns true if the learning rate was modified.
+func (s *State) ClampLearningRate(params Params) bool {
+	lr := s.LearningRate
+	if !params.MaxLearningRate.IsNil() && lr.GT(params.MaxLearningRate) {
+		lr = params.MaxLearningRate
+	}
+
+	if !params.MinLearningRate.IsNil() && lr.LT(params.MinLearningRate) {
+		lr = params.MinLearningRate
+	}
+
+	if lr.Equal(s.LearningRate) {
+		return false
+	}
+
+	s.LearningRate = lr
+	return true
+}
+
+// ClampUtilization bounds the utilization of every block in the window by the maximum
+// block utilization, so that a lowered maximum still admits txs in the current block and
+// does not push the average utilization of the window above the maximum. It returns true
+// if the window was modified.
+func (s *State) ClampUtilization(params Params) bool {
+	clamped := false
+	for i, utilization := range s.Window {
+		if utilization > params.MaxBlockUtilization {
+			s.Window[i] = params.MaxBlockUtilization
+			clamped = true
+		}
+	}
+
+	return clamped
+}
+
 // GetNetUtilization returns the net utilization of the block window.
 func (s *State) GetNetUtilization(params Params) math.Int {
 	net := math.NewInt(0)
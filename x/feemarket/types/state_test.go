@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"math/rand"
+	"strings"
 	"testing"
 
 	"cosmossdk.io/math"
@@ -387,6 +388,37 @@ func TestState_UpdateBaseGasPrice(t *testing.T) {
 		require.Equal(t, expectedLR, lr)
 		require.Equal(t, expectedGasPrice, bgs)
 	})
+
+	t.Run("target block with custom target utilization", func(t *testing.T) {
+		state := types.DefaultState()
+		params := types.DefaultParams()
+		params.TargetBlockUtilizationRatio = math.LegacyMustNewDecFromStr("0.8")
+
+		state.BaseGasPrice = math.LegacyMustNewDecFromStr("1000")
+		params.MinBaseGasPrice = math.LegacyMustNewDecFromStr("125")
+
+		state.Window[0] = params.TargetBlockUtilization()
+
+		newBaseGasPrice := state.UpdateBaseGasPrice(params)
+		expectedBaseGasPrice := math.LegacyMustNewDecFromStr("1000")
+		require.True(t, expectedBaseGasPrice.Equal(newBaseGasPrice))
+	})
+
+	t.Run("full block with custom target utilization", func(t *testing.T) {
+		state := types.DefaultState()
+		params := types.DefaultParams()
+		params.TargetBlockUtilizationRatio = math.LegacyMustNewDecFromStr("0.8")
+
+		state.BaseGasPrice = math.LegacyMustNewDecFromStr("1000")
+		params.MinBaseGasPrice = math.LegacyMustNewDecFromStr("125")
+
+		state.Window[0] = params.MaxBlockUtilization
+
+		// (30M - 24M) / 24M = 0.25 utilization above target.
+		newBaseGasPrice := state.UpdateBaseGasPrice(params)
+		expectedBaseGasPrice := math.LegacyMustNewDecFromStr("1031.25")
+		require.True(t, expectedBaseGasPrice.Equal(newBaseGasPrice))
+	})
 }
 
 func TestState_UpdateLearningRate(t *testing.T) {
@@ -535,6 +567,246 @@ func TestState_UpdateLearningRate(t *testing.T) {
 		expectedLearningRate := defaultLR.Add(params.Alpha)
 		require.True(t, expectedLearningRate.Equal(state.LearningRate))
 	})
+
+	t.Run("within thresholds with custom target utilization", func(t *testing.T) {
+		state := types.DefaultAIMDState()
+		defaultLR := math.LegacyMustNewDecFromStr("0.125")
+		state.LearningRate = defaultLR
+
+		params := types.DefaultAIMDParams()
+		params.TargetBlockUtilizationRatio = math.LegacyMustNewDecFromStr("0.8")
+
+		// The thresholds are [0.4, 0.9], so an average utilization of 0.85 is
+		// within the thresholds whereas it would exceed them with a 50% target.
+		for i := 0; i < len(state.Window); i++ {
+			state.Window[i] = params.MaxBlockUtilization / 100 * 85
+		}
+
+		state.UpdateLearningRate(params)
+		expectedLearningRate := defaultLR.Mul(params.Beta)
+		require.True(t, expectedLearningRate.Equal(state.LearningRate))
+	})
+
+	t.Run("below threshold with custom target utilization", func(t *testing.T) {
+		state := types.DefaultAIMDState()
+		defaultLR := math.LegacyMustNewDecFromStr("0.125")
+		state.LearningRate = defaultLR
+
+		params := types.DefaultAIMDParams()
+		params.TargetBlockUtilizationRatio = math.LegacyMustNewDecFromStr("0.8")
+
+		for i := 0; i < len(state.Window); i++ {
+			state.Window[i] = params.MaxBlockUtilization / 100 * 30
+		}
+
+		state.UpdateLearningRate(params)
+		expectedLearningRate := defaultLR.Add(params.Alpha)
+		require.True(t, expectedLearningRate.Equal(state.LearningRate))
+	})
+}
+
+func TestState_ClampBaseGasPrice(t *testing.T) {
+	t.Run("no bounds configured", func(t *testing.T) {
+		state := types.DefaultState()
+		params := types.DefaultParams()
+
+		state.BaseGasPrice = math.LegacyMustNewDecFromStr("1000")
+
+		require.False(t, state.ClampBaseGasPrice(math.LegacyMustNewDecFromStr("1"), params))
+		require.True(t, math.LegacyMustNewDecFromStr("1000").Equal(state.BaseGasPrice))
+	})
+
+	t.Run("increase is limited by max change per block", func(t *testing.T) {
+		state := types.DefaultState()
+		params := types.DefaultParams()
+		params.MaxChangePerBlock = math.LegacyMustNewDecFromStr("0.1")
+
+		state.BaseGasPrice = math.LegacyMustNewDecFromStr("1000")
+
+		require.True(t, state.ClampBaseGasPrice(math.LegacyMustNewDecFromStr("100"), params))
+		require.True(t, math.LegacyMustNewDecFromStr("110").Equal(state.BaseGasPrice))
+	})
+
+	t.Run("decrease is limited by max change per block", func(t *testing.T) {
+		state := types.DefaultState()
+		params := types.DefaultParams()
+		params.MaxChangePerBlock = math.LegacyMustNewDecFromStr("0.1")
+
+		state.BaseGasPrice = math.LegacyMustNewDecFromStr("10")
+
+		require.True(t, state.ClampBaseGasPrice(math.LegacyMustNewDecFromStr("100"), params))
+		require.True(t, math.LegacyMustNewDecFromStr("90").Equal(state.BaseGasPrice))
+	})
+
+	t.Run("change within max change per block", func(t *testing.T) {
+		state := types.DefaultState()
+		params := types.DefaultParams()
+		params.MaxChangePerBlock = math.LegacyMustNewDecFromStr("0.1")
+
+		state.BaseGasPrice = math.LegacyMustNewDecFromStr("105")
+
+		require.False(t, state.ClampBaseGasPrice(math.LegacyMustNewDecFromStr("100"), params))
+		require.True(t, math.LegacyMustNewDecFromStr("105").Equal(state.BaseGasPrice))
+	})
+
+	t.Run("capped by max base gas price", func(t *testing.T) {
+		state := types.DefaultState()
+		params := types.DefaultParams()
+		params.MaxBaseGasPrice = math.LegacyMustNewDecFromStr("500")
+
+		state.BaseGasPrice = math.LegacyMustNewDecFromStr("1000")
+
+		require.True(t, state.ClampBaseGasPrice(math.LegacyMustNewDecFromStr("900"), params))
+		require.True(t, params.MaxBaseGasPrice.Equal(state.BaseGasPrice))
+	})
+
+	t.Run("min base gas price takes precedence over max change per block", func(t *testing.T) {
+		state := types.DefaultState()
+		params := types.DefaultParams()
+		params.MaxChangePerBlock = math.LegacyMustNewDecFromStr("0.1")
+		params.MinBaseGasPrice = math.LegacyMustNewDecFromStr("100")
+
+		state.BaseGasPrice = math.LegacyMustNewDecFromStr("100")
+
+		// The price would be limited to 55, but it may not drop below the minimum.
+		require.False(t, state.ClampBaseGasPrice(math.LegacyMustNewDecFromStr("50"), params))
+		require.True(t, params.MinBaseGasPrice.Equal(state.BaseGasPrice))
+	})
+}
+
+func TestState_UpdateBaseGasPriceOverflow(t *testing.T) {
+	newOverflowingState := func() (types.State, types.Params) {
+		state := types.DefaultAIMDState()
+		params := types.DefaultAIMDParams()
+		params.Delta = math.LegacyNewDec(10)
+
+		for i := 0; i < len(state.Window); i++ {
+			state.Window[i] = params.MaxBlockUtilization
+		}
+
+		// Close to the largest value a legacy decimal can hold (~1.16e77).
+		state.BaseGasPrice = math.LegacyMustNewDecFromStr("1" + strings.Repeat("0", 77))
+		state.LearningRate = params.MaxLearningRate
+
+		return state, params
+	}
+
+	t.Run("resets to the min base gas price without a max", func(t *testing.T) {
+		state, params := newOverflowingState()
+
+		gasPrice := state.UpdateBaseGasPrice(params)
+		require.True(t, params.MinBaseGasPrice.Equal(gasPrice))
+	})
+
+	t.Run("saturates at the max base gas price", func(t *testing.T) {
+		state, params := newOverflowingState()
+		params.MaxBaseGasPrice = math.LegacyNewDec(1_000_000_000_000)
+
+		gasPrice := state.UpdateBaseGasPrice(params)
+		require.True(t, params.MaxBaseGasPrice.Equal(gasPrice))
+	})
+}
+
+func TestState_ClampLearningRate(t *testing.T) {
+	t.Run("within bounds", func(t *testing.T) {
+		state := types.DefaultAIMDState()
+		params := types.DefaultAIMDParams()
+
+		state.LearningRate = math.LegacyMustNewDecFromStr("0.1")
+
+		require.False(t, state.ClampLearningRate(params))
+		require.True(t, math.LegacyMustNewDecFromStr("0.1").Equal(state.LearningRate))
+	})
+
+	t.Run("above max learning rate", func(t *testing.T) {
+		state := types.DefaultAIMDState()
+		params := types.DefaultAIMDParams()
+
+		state.LearningRate = params.MaxLearningRate.Add(math.LegacyOneDec())
+
+		require.True(t, state.ClampLearningRate(params))
+		require.True(t, params.MaxLearningRate.Equal(state.LearningRate))
+	})
+
+	t.Run("below min learning rate", func(t *testing.T) {
+		state := types.DefaultAIMDState()
+		params := types.DefaultAIMDParams()
+
+		state.LearningRate = math.LegacyZeroDec()
+
+		require.True(t, state.ClampLearningRate(params))
+		require.True(t, params.MinLearningRate.Equal(state.LearningRate))
+	})
+}
+
+func TestState_ClampUtilization(t *testing.T) {
+	t.Run("within bounds", func(t *testing.T) {
+		state := types.DefaultAIMDState()
+		params := types.DefaultAIMDParams()
+
+		state.Window[0] = params.MaxBlockUtilization
+
+		require.False(t, state.ClampUtilization(params))
+		require.Equal(t, params.MaxBlockUtilization, state.Window[0])
+	})
+
+	t.Run("above max block utilization", func(t *testing.T) {
+		state := types.DefaultAIMDState()
+		params := types.DefaultAIMDParams()
+
+		state.Window[0] = params.MaxBlockUtilization
+		state.Window[1] = params.MaxBlockUtilization / 4
+		params.MaxBlockUtilization /= 2
+
+		require.True(t, state.ClampUtilization(params))
+		require.Equal(t, params.MaxBlockUtilization, state.Window[0])
+		require.Equal(t, params.MaxBlockUtilization/2, state.Window[1])
+	})
+}
+
+func TestState_Resize(t *testing.T) {
+	newState := func() types.State {
+		state := types.NewState(4, OneHundred, OneHundred)
+		state.Window = []uint64{1, 2, 3, 4}
+		state.Index = 1
+
+		return state
+	}
+
+	t.Run("same size is a no-op", func(t *testing.T) {
+		state := newState()
+		state.Resize(4)
+
+		require.Equal(t, []uint64{1, 2, 3, 4}, state.Window)
+		require.Equal(t, uint64(1), state.Index)
+	})
+
+	t.Run("shrinking keeps the newest entries", func(t *testing.T) {
+		state := newState()
+		state.Resize(2)
+
+		require.Equal(t, []uint64{1, 2}, state.Window)
+		require.Equal(t, uint64(1), state.Index)
+	})
+
+	t.Run("shrinking wraps around the window", func(t *testing.T) {
+		state := newState()
+		state.Resize(3)
+
+		require.Equal(t, []uint64{4, 1, 2}, state.Window)
+		require.Equal(t, uint64(2), state.Index)
+	})
+
+	t.Run("growing keeps all entries", func(t *testing.T) {
+		state := newState()
+		state.Resize(6)
+
+		require.Equal(t, []uint64{3, 4, 1, 2, 0, 0}, state.Window)
+		require.Equal(t, uint64(3), state.Index)
+
+		state.IncrementHeight()
+		require.Equal(t, uint64(4), state.Index)
+	})
 }
 
 func TestState_GetNetUtilization(t *testing.T) {
@@ -664,6 +936,24 @@ func TestState_GetNetUtilization(t *testing.T) {
 		expectedUtilization := math.NewIntFromUint64(250).Mul(math.NewInt(-1))
 		require.True(t, expectedUtilization.Equal(netUtilization))
 	})
+
+	t.Run("state with 4 entries in window with custom target utilization", func(t *testing.T) {
+		state := types.DefaultAIMDState()
+		state.Window = make([]uint64, 4)
+
+		params := types.DefaultAIMDParams()
+		params.MaxBlockUtilization = 200
+		params.TargetBlockUtilizationRatio = math.LegacyMustNewDecFromStr("0.25")
+
+		state.Window[0] = 0
+		state.Window[1] = 25
+		state.Window[2] = 50
+		state.Window[3] = 75
+
+		netUtilization := state.GetNetUtilization(params)
+		expectedUtilization := math.NewIntFromUint64(50).Mul(math.NewInt(-1))
+		require.True(t, expectedUtilization.Equal(netUtilization))
+	})
 }
 
 func TestState_GetAverageUtilization(t *testing.T) {
@@ -0,0 +1,40 @@
+package types
+
+import "fmt"
+
+const (
+	// TipDestinationOperator sends the priority tips to the account of the operator of
+	// the validator that proposed the block.
+	TipDestinationOperator = "operator"
+
+	// TipDestinationDistribution allocates the priority tips to the validator that
+	// proposed the block through x/distribution, so that its commission and delegator
+	// rewards apply.
+	TipDestinationDistribution = "distribution"
+
+	// DefaultTipDestination is the tip destination that is used when
+	// Params.TipDestination is not set.
+	DefaultTipDestination = TipDestinationOperator
+)
+
+// ValidateTipDestination returns an error if the given tip destination is neither
+// empty nor one of the supported tip destinations.
+func ValidateTipDestination(destination string) error {
+	switch destination {
+	case "", TipDestinationOperator, TipDestinationDistribution:
+		return nil
+	default:
+		return fmt.Errorf("tip destination must be one of [%s, %s], got %s",
+			TipDestinationOperator, TipDestinationDistribution, destination)
+	}
+}
+
+// TipDestinationOrDefault returns the destination of the priority tips. If
+// TipDestination is unset, DefaultTipDestination is returned.
+func (p *Params) TipDestinationOrDefault() string {
+	if p.TipDestination == "" {
+		return DefaultTipDestination
+	}
+
+	return p.TipDestination
+}
@@ -0,0 +1,196 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TwapPool maps a denom to the AMM pool that prices it against the fee denom.
+type TwapPool struct {
+	// Denom is the denom priced by the pool, e.g. "uatom".
+	Denom string
+	// PoolID is the id of a pool that holds the denom and the fee denom.
+	PoolID uint64
+	// MinLiquidity is the minimum amount of the fee denom that the pool must hold for
+	// its price to be used. If nil or zero, the liquidity of the pool is not checked.
+	MinLiquidity math.Int
+}
+
+// TwapDenomResolverConfig configures a TwapDenomResolver.
+type TwapDenomResolverConfig struct {
+	// FeeDenom is the fee denom of the fee market, which every pool prices its denom in.
+	FeeDenom string
+	// Pools are the pools of the denoms that can be converted.
+	Pools []TwapPool
+	// Window is the time window over which the time-weighted average price is taken.
+	Window time.Duration
+	// MaxSpotDeviation is the maximum relative deviation of the spot price of a pool from
+	// its time-weighted average price, e.g. 0.1 for 10%, for which the pool is used. If
+	// nil or zero, the deviation is not checked.
+	MaxSpotDeviation math.LegacyDec
+}
+
+// ValidateBasic performs basic validation on the config.
+func (c TwapDenomResolverConfig) ValidateBasic() error {
+	if err := sdk.ValidateDenom(c.FeeDenom); err != nil {
+		return fmt.Errorf("invalid fee denom: %w", err)
+	}
+
+	if c.Window <= 0 {
+		return fmt.Errorf("twap window must be positive")
+	}
+
+	if !c.MaxSpotDeviation.IsNil() && c.MaxSpotDeviation.IsNegative() {
+		return fmt.Errorf("max spot deviation cannot be negative")
+	}
+
+	seen := make(map[string]struct{}, len(c.Pools))
+	for _, pool := range c.Pools {
+		if err := sdk.ValidateDenom(pool.Denom); err != nil {
+			return err
+		}
+
+		if pool.Denom == c.FeeDenom {
+			return fmt.Errorf("the fee denom %s cannot be priced by a pool", pool.Denom)
+		}
+
+		if _, ok := seen[pool.Denom]; ok {
+			return fmt.Errorf("duplicate pool for denom %s", pool.Denom)
+		}
+		seen[pool.Denom] = struct{}{}
+
+		if !pool.MinLiquidity.IsNil() && pool.MinLiquidity.IsNegative() {
+			return fmt.Errorf("min liquidity of denom %s cannot be negative", pool.Denom)
+		}
+	}
+
+	return nil
+}
+
+var _ DenomResolver = (*TwapDenomResolver)(nil)
+
+// TwapDenomResolver is a DenomResolver that converts between the fee denom and other
+// denoms with the time-weighted average prices of on-chain AMM pools. A pool is only used
+// if it holds enough liquidity and its spot price is close to its average price, and a
+// denom is always valued at the lower of the two prices, so that moving the spot price of
+// a pool cannot lower the fees paid in its denom.
+type TwapDenomResolver struct {
+	pools   PoolKeeper
+	config  TwapDenomResolverConfig
+	byDenom map[string]TwapPool
+}
+
+// NewTwapDenomResolver returns a new TwapDenomResolver that reads prices from the given
+// pool keeper. An error is returned if the config is invalid.
+func NewTwapDenomResolver(pools PoolKeeper, config TwapDenomResolverConfig) (*TwapDenomResolver, error) {
+	if err := config.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	byDenom := make(map[string]TwapPool, len(config.Pools))
+	for _, pool := range config.Pools {
+		byDenom[pool.Denom] = pool
+	}
+
+	return &TwapDenomResolver{
+		pools:   pools,
+		config:  config,
+		byDenom: byDenom,
+	}, nil
+}
+
+// ConvertToDenom converts the given coin into the equivalent amount of denom.
+func (r *TwapDenomResolver) ConvertToDenom(ctx context.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error) {
+	if coin.Denom == denom {
+		return coin, nil
+	}
+
+	fromPrice, err := r.price(ctx, coin.Denom)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	toPrice, err := r.price(ctx, denom)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	return sdk.NewDecCoinFromDec(denom, coin.Amount.Mul(fromPrice).Quo(toPrice)), nil
+}
+
+// ExtraDenoms returns the sorted denoms of all configured pools.
+func (r *TwapDenomResolver) ExtraDenoms(_ context.Context) ([]string, error) {
+	denoms := make([]string, 0, len(r.byDenom))
+	for denom := range r.byDenom {
+		denoms = append(denoms, denom)
+	}
+
+	sort.Strings(denoms)
+	return denoms, nil
+}
+
+// price returns the amount of the fee denom that one unit of the given denom is worth.
+// An error is returned if the pool of the denom fails the liquidity floor or the max
+// deviation guard.
+func (r *TwapDenomResolver) price(ctx context.Context, denom string) (math.LegacyDec, error) {
+	if denom == r.config.FeeDenom {
+		return math.LegacyOneDec(), nil
+	}
+
+	pool, ok := r.byDenom[denom]
+	if !ok {
+		return math.LegacyDec{}, ErrUnknownResolverDenom.Wrapf("%s", denom)
+	}
+
+	if !pool.MinLiquidity.IsNil() && pool.MinLiquidity.IsPositive() {
+		liquidity, err := r.pools.GetPoolLiquidity(ctx, pool.PoolID)
+		if err != nil {
+			return math.LegacyDec{}, ErrPoolPriceRejected.Wrapf("pool %d: %s", pool.PoolID, err)
+		}
+
+		if liquidity.AmountOf(r.config.FeeDenom).LT(pool.MinLiquidity) {
+			return math.LegacyDec{}, ErrPoolPriceRejected.Wrapf(
+				"pool %d holds %s%s, less than the min liquidity %s%s",
+				pool.PoolID, liquidity.AmountOf(r.config.FeeDenom), r.config.FeeDenom, pool.MinLiquidity, r.config.FeeDenom,
+			)
+		}
+	}
+
+	startTime := sdk.UnwrapSDKContext(ctx).BlockTime().Add(-r.config.Window)
+	twap, err := r.pools.GetArithmeticTwapToNow(ctx, pool.PoolID, denom, r.config.FeeDenom, startTime)
+	if err != nil {
+		return math.LegacyDec{}, ErrPoolPriceRejected.Wrapf("pool %d: %s", pool.PoolID, err)
+	}
+
+	if twap.IsNil() || !twap.IsPositive() {
+		return math.LegacyDec{}, ErrPoolPriceRejected.Wrapf("pool %d: twap is not positive", pool.PoolID)
+	}
+
+	spot, err := r.pools.GetSpotPrice(ctx, pool.PoolID, denom, r.config.FeeDenom)
+	if err != nil {
+		return math.LegacyDec{}, ErrPoolPriceRejected.Wrapf("pool %d: %s", pool.PoolID, err)
+	}
+
+	if spot.IsNil() || !spot.IsPositive() {
+		return math.LegacyDec{}, ErrPoolPriceRejected.Wrapf("pool %d: spot price is not positive", pool.PoolID)
+	}
+
+	if !r.config.MaxSpotDeviation.IsNil() && r.config.MaxSpotDeviation.IsPositive() {
+		deviation := spot.Sub(twap).Abs().Quo(twap)
+		if deviation.GT(r.config.MaxSpotDeviation) {
+			return math.LegacyDec{}, ErrPoolPriceRejected.Wrapf(
+				"pool %d: spot price %s deviates from the twap %s by more than %s",
+				pool.PoolID, spot, twap, r.config.MaxSpotDeviation,
+			)
+		}
+	}
+
+	// value the denom at the lower of the two prices, so that neither a pumped spot
+	// price nor a twap that has not caught up with a crash lowers the fees paid in it
+	return math.LegacyMinDec(twap, spot), nil
+}
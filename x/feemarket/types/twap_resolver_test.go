@@ -0,0 +1,185 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+	"github.com/skip-mev/feemarket/x/feemarket/types/mocks"
+)
+
+func twapResolverConfig() types.TwapDenomResolverConfig {
+	return types.TwapDenomResolverConfig{
+		FeeDenom: "ustake",
+		Pools: []types.TwapPool{
+			{Denom: "uatom", PoolID: 1, MinLiquidity: math.NewInt(1_000)},
+			{Denom: "uosmo", PoolID: 2},
+		},
+		Window:           10 * time.Minute,
+		MaxSpotDeviation: math.LegacyMustNewDecFromStr("0.1"),
+	}
+}
+
+func TestTwapDenomResolverConfig(t *testing.T) {
+	testCases := []struct {
+		name   string
+		modify func(*types.TwapDenomResolverConfig)
+		valid  bool
+	}{
+		{"valid config", func(*types.TwapDenomResolverConfig) {}, true},
+		{
+			"valid config without guards",
+			func(c *types.TwapDenomResolverConfig) {
+				c.MaxSpotDeviation = math.LegacyDec{}
+				c.Pools[0].MinLiquidity = math.Int{}
+			},
+			true,
+		},
+		{"invalid fee denom", func(c *types.TwapDenomResolverConfig) { c.FeeDenom = "" }, false},
+		{"zero window", func(c *types.TwapDenomResolverConfig) { c.Window = 0 }, false},
+		{"negative max spot deviation", func(c *types.TwapDenomResolverConfig) { c.MaxSpotDeviation = math.LegacyNewDec(-1) }, false},
+		{"invalid denom", func(c *types.TwapDenomResolverConfig) { c.Pools[0].Denom = "" }, false},
+		{"pool for the fee denom", func(c *types.TwapDenomResolverConfig) { c.Pools[0].Denom = "ustake" }, false},
+		{"duplicate denom", func(c *types.TwapDenomResolverConfig) { c.Pools[1].Denom = "uatom" }, false},
+		{"negative min liquidity", func(c *types.TwapDenomResolverConfig) { c.Pools[0].MinLiquidity = math.NewInt(-1) }, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := twapResolverConfig()
+			tc.modify(&config)
+
+			_, err := types.NewTwapDenomResolver(mocks.NewPoolKeeper(t), config)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestTwapDenomResolver(t *testing.T) {
+	now := time.Unix(1700000000, 0).UTC()
+	ctx := sdk.Context{}.WithBlockTime(now)
+	start := now.Add(-10 * time.Minute)
+
+	dec := math.LegacyMustNewDecFromStr
+	liquid := sdk.NewCoins(sdk.NewInt64Coin("ustake", 5_000), sdk.NewInt64Coin("uatom", 1_000))
+
+	t.Run("converts with the twap", func(t *testing.T) {
+		pools := mocks.NewPoolKeeper(t)
+		pools.On("GetPoolLiquidity", mock.Anything, uint64(1)).Return(liquid, nil)
+		pools.On("GetArithmeticTwapToNow", mock.Anything, uint64(1), "uatom", "ustake", start).Return(dec("5"), nil)
+		pools.On("GetSpotPrice", mock.Anything, uint64(1), "uatom", "ustake").Return(dec("5.2"), nil)
+
+		resolver, err := types.NewTwapDenomResolver(pools, twapResolverConfig())
+		require.NoError(t, err)
+
+		got, err := resolver.ConvertToDenom(ctx, sdk.NewDecCoin("uatom", math.NewInt(10)), "ustake")
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDecCoin("ustake", math.NewInt(50)), got)
+
+		got, err = resolver.ConvertToDenom(ctx, sdk.NewDecCoin("ustake", math.NewInt(50)), "uatom")
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDecCoin("uatom", math.NewInt(10)), got)
+	})
+
+	t.Run("a pumped spot price does not lower the fee", func(t *testing.T) {
+		pools := mocks.NewPoolKeeper(t)
+		pools.On("GetPoolLiquidity", mock.Anything, uint64(1)).Return(liquid, nil)
+		pools.On("GetArithmeticTwapToNow", mock.Anything, uint64(1), "uatom", "ustake", start).Return(dec("5"), nil)
+		pools.On("GetSpotPrice", mock.Anything, uint64(1), "uatom", "ustake").Return(dec("500"), nil)
+
+		config := twapResolverConfig()
+		resolver, err := types.NewTwapDenomResolver(pools, config)
+		require.NoError(t, err)
+
+		// the deviation guard rejects the pool
+		_, err = resolver.ConvertToDenom(ctx, sdk.NewDecCoin("uatom", math.NewInt(10)), "ustake")
+		require.ErrorIs(t, err, types.ErrPoolPriceRejected)
+
+		// without it, the denom is still valued at the twap
+		config.MaxSpotDeviation = math.LegacyDec{}
+		resolver, err = types.NewTwapDenomResolver(pools, config)
+		require.NoError(t, err)
+
+		got, err := resolver.ConvertToDenom(ctx, sdk.NewDecCoin("uatom", math.NewInt(10)), "ustake")
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDecCoin("ustake", math.NewInt(50)), got)
+	})
+
+	t.Run("values the denom at a crashed spot price", func(t *testing.T) {
+		pools := mocks.NewPoolKeeper(t)
+		pools.On("GetArithmeticTwapToNow", mock.Anything, uint64(2), "uosmo", "ustake", start).Return(dec("2"), nil)
+		pools.On("GetSpotPrice", mock.Anything, uint64(2), "uosmo", "ustake").Return(dec("1"), nil)
+
+		config := twapResolverConfig()
+		config.MaxSpotDeviation = math.LegacyDec{}
+		resolver, err := types.NewTwapDenomResolver(pools, config)
+		require.NoError(t, err)
+
+		got, err := resolver.ConvertToDenom(ctx, sdk.NewDecCoin("uosmo", math.NewInt(10)), "ustake")
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDecCoin("ustake", math.NewInt(10)), got)
+	})
+
+	t.Run("rejects a pool below the liquidity floor", func(t *testing.T) {
+		pools := mocks.NewPoolKeeper(t)
+		pools.On("GetPoolLiquidity", mock.Anything, uint64(1)).Return(sdk.NewCoins(sdk.NewInt64Coin("ustake", 999)), nil)
+
+		resolver, err := types.NewTwapDenomResolver(pools, twapResolverConfig())
+		require.NoError(t, err)
+
+		_, err = resolver.ConvertToDenom(ctx, sdk.NewDecCoin("uatom", math.NewInt(10)), "ustake")
+		require.ErrorIs(t, err, types.ErrPoolPriceRejected)
+	})
+
+	unavailable := []struct {
+		name string
+		twap math.LegacyDec
+		err  error
+	}{
+		{"missing twap", math.LegacyDec{}, fmt.Errorf("no twap records")},
+		{"zero twap", math.LegacyZeroDec(), nil},
+	}
+
+	for _, tc := range unavailable {
+		t.Run(tc.name+" is rejected", func(t *testing.T) {
+			pools := mocks.NewPoolKeeper(t)
+			pools.On("GetArithmeticTwapToNow", mock.Anything, uint64(2), "uosmo", "ustake", start).Return(tc.twap, tc.err)
+
+			resolver, err := types.NewTwapDenomResolver(pools, twapResolverConfig())
+			require.NoError(t, err)
+
+			_, err = resolver.ConvertToDenom(ctx, sdk.NewDecCoin("uosmo", math.NewInt(10)), "ustake")
+			require.ErrorIs(t, err, types.ErrPoolPriceRejected)
+		})
+	}
+
+	t.Run("rejects unknown denoms", func(t *testing.T) {
+		resolver, err := types.NewTwapDenomResolver(mocks.NewPoolKeeper(t), twapResolverConfig())
+		require.NoError(t, err)
+
+		_, err = resolver.ConvertToDenom(ctx, sdk.NewDecCoin("unknown", math.NewInt(100)), "ustake")
+		require.ErrorIs(t, err, types.ErrUnknownResolverDenom)
+
+		_, err = resolver.ConvertToDenom(ctx, sdk.NewDecCoin("ustake", math.NewInt(100)), "unknown")
+		require.ErrorIs(t, err, types.ErrUnknownResolverDenom)
+	})
+
+	t.Run("returns the configured denoms", func(t *testing.T) {
+		resolver, err := types.NewTwapDenomResolver(mocks.NewPoolKeeper(t), twapResolverConfig())
+		require.NoError(t, err)
+
+		denoms, err := resolver.ExtraDenoms(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []string{"uatom", "uosmo"}, denoms)
+	})
+}
@@ -5,9 +5,13 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -38,6 +42,11 @@ type MsgParams struct {
 	// Authority defines the authority that is updating the feemarket module
 	// parameters.
 	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+	// ResetState determines whether the fee market state is reset to the
+	// minimum base gas price and learning rate with an empty window. If false,
+	// the current base gas price, learning rate and window are carried over to
+	// the new parameters.
+	ResetState bool `protobuf:"varint,3,opt,name=reset_state,json=resetState,proto3" json:"reset_state,omitempty"`
 }
 
 func (m *MsgParams) Reset()         { *m = MsgParams{} }
@@ -87,6 +96,13 @@ func (m *MsgParams) GetAuthority() string {
 	return ""
 }
 
+func (m *MsgParams) GetResetState() bool {
+	if m != nil {
+		return m.ResetState
+	}
+	return false
+}
+
 // MsgParamsResponse defines the Msg/Params response type.
 type MsgParamsResponse struct {
 }
@@ -124,224 +140,3930 @@ func (m *MsgParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgParams)(nil), "feemarket.feemarket.v1.MsgParams")
-	proto.RegisterType((*MsgParamsResponse)(nil), "feemarket.feemarket.v1.MsgParamsResponse")
+// MsgUpdateParamsPartial defines the Msg/UpdateParamsPartial request type. Only
+// the parameters named in fields are updated, all other parameters are left
+// unchanged.
+type MsgUpdateParamsPartial struct {
+	// Authority defines the authority that is updating the feemarket module
+	// parameters.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Params contains the new values of the parameters named in fields. All
+	// other values are ignored.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// Fields is the list of parameters to update, identified by their proto
+	// field name e.g. "alpha" or "fee_denom".
+	Fields []string `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
 }
 
-func init() { proto.RegisterFile("feemarket/feemarket/v1/tx.proto", fileDescriptor_1bbf67a633e47917) }
+func (m *MsgUpdateParamsPartial) Reset()         { *m = MsgUpdateParamsPartial{} }
+func (m *MsgUpdateParamsPartial) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsPartial) ProtoMessage()    {}
+func (*MsgUpdateParamsPartial) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{2}
+}
+func (m *MsgUpdateParamsPartial) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsPartial) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsPartial.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsPartial) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsPartial.Merge(m, src)
+}
+func (m *MsgUpdateParamsPartial) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsPartial) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsPartial.DiscardUnknown(m)
+}
 
-var fileDescriptor_1bbf67a633e47917 = []byte{
-	// 313 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0x4b, 0x4d, 0xcd,
-	0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xd1, 0x47, 0xb0, 0xca, 0x0c, 0xf5, 0x4b, 0x2a, 0xf4, 0x0a, 0x8a,
-	0xf2, 0x4b, 0xf2, 0x85, 0xc4, 0xe0, 0xc2, 0x7a, 0x08, 0x56, 0x99, 0xa1, 0x94, 0x32, 0x0e, 0x8d,
-	0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x10, 0xcd, 0x52, 0x92, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5,
-	0xf1, 0x60, 0x9e, 0x3e, 0x84, 0x03, 0x95, 0x12, 0x87, 0xf0, 0xf4, 0x73, 0x8b, 0xd3, 0x41, 0xda,
-	0x72, 0x8b, 0xd3, 0xa1, 0x12, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x10, 0x0d, 0x20, 0x16, 0x44, 0x54,
-	0x69, 0x22, 0x23, 0x17, 0xa7, 0x6f, 0x71, 0x7a, 0x00, 0xd8, 0x74, 0x21, 0x1b, 0x2e, 0x36, 0x88,
-	0x3d, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x72, 0x7a, 0xd8, 0x5d, 0xa9, 0x07, 0x51, 0xef,
-	0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x54, 0x8f, 0x90, 0x19, 0x17, 0x67, 0x62, 0x69, 0x49,
-	0x46, 0x7e, 0x51, 0x66, 0x49, 0xa5, 0x04, 0x93, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xc4, 0xa5, 0x2d,
-	0xba, 0x22, 0x50, 0xf7, 0x39, 0xa6, 0xa4, 0x14, 0xa5, 0x16, 0x17, 0x07, 0x97, 0x14, 0x65, 0xe6,
-	0xa5, 0x07, 0x21, 0x94, 0x5a, 0xf1, 0x35, 0x3d, 0xdf, 0xa0, 0x85, 0xe0, 0x2b, 0x09, 0x73, 0x09,
-	0xc2, 0x9d, 0x14, 0x94, 0x5a, 0x5c, 0x90, 0x9f, 0x57, 0x9c, 0x6a, 0x94, 0xc2, 0xc5, 0xec, 0x5b,
-	0x9c, 0x2e, 0x14, 0xc6, 0xc5, 0x06, 0x75, 0xab, 0x22, 0x2e, 0xb7, 0xc1, 0xf5, 0x4a, 0x69, 0x12,
-	0x54, 0x02, 0x33, 0x5e, 0x8a, 0xb5, 0xe1, 0xf9, 0x06, 0x2d, 0x46, 0x27, 0xcf, 0x13, 0x8f, 0xe4,
-	0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f,
-	0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x4f, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b,
-	0xce, 0xcf, 0xd5, 0x2f, 0xce, 0xce, 0x2c, 0xd0, 0xcd, 0x4d, 0x2d, 0x43, 0x8a, 0xa1, 0x0a, 0x24,
-	0x76, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0x80, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xac, 0xa3, 0x3e, 0x01, 0x0a, 0x02, 0x00, 0x00,
+var xxx_messageInfo_MsgUpdateParamsPartial proto.InternalMessageInfo
+
+func (m *MsgUpdateParamsPartial) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgUpdateParamsPartial) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgUpdateParamsPartial) GetFields() []string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// Params defines a method for updating the feemarket module parameters.
-	Params(ctx context.Context, in *MsgParams, opts ...grpc.CallOption) (*MsgParamsResponse, error)
+// MsgUpdateParamsPartialResponse defines the Msg/UpdateParamsPartial response
+// type.
+type MsgUpdateParamsPartialResponse struct {
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgUpdateParamsPartialResponse) Reset()         { *m = MsgUpdateParamsPartialResponse{} }
+func (m *MsgUpdateParamsPartialResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsPartialResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsPartialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{3}
+}
+func (m *MsgUpdateParamsPartialResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsPartialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsPartialResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsPartialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsPartialResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsPartialResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsPartialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsPartialResponse.DiscardUnknown(m)
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+var xxx_messageInfo_MsgUpdateParamsPartialResponse proto.InternalMessageInfo
+
+// MsgScheduleParams defines the Msg/ScheduleParams request type. It schedules
+// the given parameters to be applied at the end of the block with the given
+// height. Parameters cannot be scheduled for a height that already has
+// scheduled parameters; those must be cancelled first.
+type MsgScheduleParams struct {
+	// Authority defines the authority that is scheduling the feemarket module
+	// parameters.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Height is the block height at which the parameters are applied. Must be
+	// greater than the current block height.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Params defines the new parameters for the feemarket module.
+	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
 }
 
-func (c *msgClient) Params(ctx context.Context, in *MsgParams, opts ...grpc.CallOption) (*MsgParamsResponse, error) {
-	out := new(MsgParamsResponse)
-	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Msg/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgScheduleParams) Reset()         { *m = MsgScheduleParams{} }
+func (m *MsgScheduleParams) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleParams) ProtoMessage()    {}
+func (*MsgScheduleParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{4}
+}
+func (m *MsgScheduleParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgScheduleParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleParams.Merge(m, src)
+}
+func (m *MsgScheduleParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleParams.DiscardUnknown(m)
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// Params defines a method for updating the feemarket module parameters.
-	Params(context.Context, *MsgParams) (*MsgParamsResponse, error)
+var xxx_messageInfo_MsgScheduleParams proto.InternalMessageInfo
+
+func (m *MsgScheduleParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgScheduleParams) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (*UnimplementedMsgServer) Params(ctx context.Context, req *MsgParams) (*MsgParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *MsgScheduleParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+// MsgScheduleParamsResponse defines the Msg/ScheduleParams response type.
+type MsgScheduleParamsResponse struct {
 }
 
-func _Msg_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgParams)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgScheduleParamsResponse) Reset()         { *m = MsgScheduleParamsResponse{} }
+func (m *MsgScheduleParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleParamsResponse) ProtoMessage()    {}
+func (*MsgScheduleParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{5}
+}
+func (m *MsgScheduleParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).Params(ctx, in)
+}
+func (m *MsgScheduleParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleParamsResponse.Merge(m, src)
+}
+func (m *MsgScheduleParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleParamsResponse proto.InternalMessageInfo
+
+// MsgCancelScheduledParams defines the Msg/CancelScheduledParams request type.
+// It removes the parameters scheduled at the given height.
+type MsgCancelScheduledParams struct {
+	// Authority defines the authority that is cancelling the scheduled
+	// parameters.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Height is the block height that the parameters are scheduled at.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *MsgCancelScheduledParams) Reset()         { *m = MsgCancelScheduledParams{} }
+func (m *MsgCancelScheduledParams) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledParams) ProtoMessage()    {}
+func (*MsgCancelScheduledParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{6}
+}
+func (m *MsgCancelScheduledParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/feemarket.feemarket.v1.Msg/Params",
+}
+func (m *MsgCancelScheduledParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledParams.Merge(m, src)
+}
+func (m *MsgCancelScheduledParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledParams proto.InternalMessageInfo
+
+func (m *MsgCancelScheduledParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Params(ctx, req.(*MsgParams))
+	return ""
+}
+
+func (m *MsgCancelScheduledParams) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "feemarket.feemarket.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Msg_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "feemarket/feemarket/v1/tx.proto",
+// MsgCancelScheduledParamsResponse defines the Msg/CancelScheduledParams
+// response type.
+type MsgCancelScheduledParamsResponse struct {
 }
 
-func (m *MsgParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelScheduledParamsResponse) Reset()         { *m = MsgCancelScheduledParamsResponse{} }
+func (m *MsgCancelScheduledParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledParamsResponse) ProtoMessage()    {}
+func (*MsgCancelScheduledParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{7}
+}
+func (m *MsgCancelScheduledParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCancelScheduledParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledParamsResponse.Merge(m, src)
+}
+func (m *MsgCancelScheduledParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledParamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCancelScheduledParamsResponse proto.InternalMessageInfo
+
+// MsgSetBaseGasPrice defines the Msg/SetBaseGasPrice request type. It
+// overrides the current base gas price of the fee market.
+type MsgSetBaseGasPrice struct {
+	// Authority defines the authority that is setting the base gas price.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// BaseGasPrice is the new base gas price. Must be within
+	// [MinBaseGasPrice, MaxBaseGasPrice].
+	BaseGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=base_gas_price,json=baseGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_gas_price"`
 }
 
-func (m *MsgParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSetBaseGasPrice) Reset()         { *m = MsgSetBaseGasPrice{} }
+func (m *MsgSetBaseGasPrice) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBaseGasPrice) ProtoMessage()    {}
+func (*MsgSetBaseGasPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{8}
+}
+func (m *MsgSetBaseGasPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBaseGasPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBaseGasPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetBaseGasPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBaseGasPrice.Merge(m, src)
+}
+func (m *MsgSetBaseGasPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBaseGasPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBaseGasPrice.DiscardUnknown(m)
 }
 
-func (m *MsgParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetBaseGasPrice proto.InternalMessageInfo
+
+func (m *MsgSetBaseGasPrice) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgSetBaseGasPriceResponse defines the Msg/SetBaseGasPrice response type.
+type MsgSetBaseGasPriceResponse struct {
+}
+
+func (m *MsgSetBaseGasPriceResponse) Reset()         { *m = MsgSetBaseGasPriceResponse{} }
+func (m *MsgSetBaseGasPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBaseGasPriceResponse) ProtoMessage()    {}
+func (*MsgSetBaseGasPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{9}
+}
+func (m *MsgSetBaseGasPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBaseGasPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBaseGasPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetBaseGasPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBaseGasPriceResponse.Merge(m, src)
+}
+func (m *MsgSetBaseGasPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBaseGasPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBaseGasPriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetBaseGasPriceResponse proto.InternalMessageInfo
+
+// MsgFreezeFeeMarket defines the Msg/FreezeFeeMarket request type. While the
+// fee market is frozen, the learning rate and base gas price are not updated
+// at the end of a block, but block utilization is still recorded.
+type MsgFreezeFeeMarket struct {
+	// Authority defines the authority that is freezing the fee market.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgFreezeFeeMarket) Reset()         { *m = MsgFreezeFeeMarket{} }
+func (m *MsgFreezeFeeMarket) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeFeeMarket) ProtoMessage()    {}
+func (*MsgFreezeFeeMarket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{10}
+}
+func (m *MsgFreezeFeeMarket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeFeeMarket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeFeeMarket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFreezeFeeMarket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeFeeMarket.Merge(m, src)
+}
+func (m *MsgFreezeFeeMarket) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeFeeMarket) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeFeeMarket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFreezeFeeMarket proto.InternalMessageInfo
+
+func (m *MsgFreezeFeeMarket) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgFreezeFeeMarketResponse defines the Msg/FreezeFeeMarket response type.
+type MsgFreezeFeeMarketResponse struct {
+}
+
+func (m *MsgFreezeFeeMarketResponse) Reset()         { *m = MsgFreezeFeeMarketResponse{} }
+func (m *MsgFreezeFeeMarketResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeFeeMarketResponse) ProtoMessage()    {}
+func (*MsgFreezeFeeMarketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{11}
+}
+func (m *MsgFreezeFeeMarketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeFeeMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeFeeMarketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFreezeFeeMarketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeFeeMarketResponse.Merge(m, src)
+}
+func (m *MsgFreezeFeeMarketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeFeeMarketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeFeeMarketResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFreezeFeeMarketResponse proto.InternalMessageInfo
+
+// MsgUnfreezeFeeMarket defines the Msg/UnfreezeFeeMarket request type.
+type MsgUnfreezeFeeMarket struct {
+	// Authority defines the authority that is unfreezing the fee market.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgUnfreezeFeeMarket) Reset()         { *m = MsgUnfreezeFeeMarket{} }
+func (m *MsgUnfreezeFeeMarket) String() string { return proto.CompactTextString(m) }
+func (*MsgUnfreezeFeeMarket) ProtoMessage()    {}
+func (*MsgUnfreezeFeeMarket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{12}
+}
+func (m *MsgUnfreezeFeeMarket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnfreezeFeeMarket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnfreezeFeeMarket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnfreezeFeeMarket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnfreezeFeeMarket.Merge(m, src)
+}
+func (m *MsgUnfreezeFeeMarket) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnfreezeFeeMarket) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnfreezeFeeMarket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnfreezeFeeMarket proto.InternalMessageInfo
+
+func (m *MsgUnfreezeFeeMarket) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgUnfreezeFeeMarketResponse defines the Msg/UnfreezeFeeMarket response
+// type.
+type MsgUnfreezeFeeMarketResponse struct {
+}
+
+func (m *MsgUnfreezeFeeMarketResponse) Reset()         { *m = MsgUnfreezeFeeMarketResponse{} }
+func (m *MsgUnfreezeFeeMarketResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnfreezeFeeMarketResponse) ProtoMessage()    {}
+func (*MsgUnfreezeFeeMarketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{13}
+}
+func (m *MsgUnfreezeFeeMarketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnfreezeFeeMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnfreezeFeeMarketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnfreezeFeeMarketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnfreezeFeeMarketResponse.Merge(m, src)
+}
+func (m *MsgUnfreezeFeeMarketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnfreezeFeeMarketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnfreezeFeeMarketResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnfreezeFeeMarketResponse proto.InternalMessageInfo
+
+// MsgSweepFeeCollector defines the Msg/SweepFeeCollector request type. It
+// sweeps the fees that accumulated in the feemarket fee collector to the given
+// destination.
+type MsgSweepFeeCollector struct {
+	// Authority defines the authority that is sweeping the fee collector.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Destination is where the fees are swept to. If "burn", the fees are
+	// burned. If "community_pool", the fees are sent to the community pool.
+	// Otherwise, the fees are sent to the module account with the given name.
+	Destination string `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	// Amount is the amount of fees to sweep. If empty, the whole balance of the
+	// feemarket fee collector is swept.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgSweepFeeCollector) Reset()         { *m = MsgSweepFeeCollector{} }
+func (m *MsgSweepFeeCollector) String() string { return proto.CompactTextString(m) }
+func (*MsgSweepFeeCollector) ProtoMessage()    {}
+func (*MsgSweepFeeCollector) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{14}
+}
+func (m *MsgSweepFeeCollector) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSweepFeeCollector) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSweepFeeCollector.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSweepFeeCollector) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSweepFeeCollector.Merge(m, src)
+}
+func (m *MsgSweepFeeCollector) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSweepFeeCollector) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSweepFeeCollector.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSweepFeeCollector proto.InternalMessageInfo
+
+func (m *MsgSweepFeeCollector) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSweepFeeCollector) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *MsgSweepFeeCollector) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgSweepFeeCollectorResponse defines the Msg/SweepFeeCollector response
+// type.
+type MsgSweepFeeCollectorResponse struct {
+	// Amount is the amount of fees that was swept.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgSweepFeeCollectorResponse) Reset()         { *m = MsgSweepFeeCollectorResponse{} }
+func (m *MsgSweepFeeCollectorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSweepFeeCollectorResponse) ProtoMessage()    {}
+func (*MsgSweepFeeCollectorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{15}
+}
+func (m *MsgSweepFeeCollectorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSweepFeeCollectorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSweepFeeCollectorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSweepFeeCollectorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSweepFeeCollectorResponse.Merge(m, src)
+}
+func (m *MsgSweepFeeCollectorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSweepFeeCollectorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSweepFeeCollectorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSweepFeeCollectorResponse proto.InternalMessageInfo
+
+func (m *MsgSweepFeeCollectorResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgSetDenomRate defines the Msg/SetDenomRate request type. It sets the static
+// conversion rate of a denom that fees can be paid in, replacing the current
+// rate of the denom.
+type MsgSetDenomRate struct {
+	// Authority defines the authority that is setting the denom rate.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Denom is the denom that fees can be paid in. Must not be the fee denom.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Rate is the amount of the fee denom that one unit of the denom is worth.
+	// Must be positive.
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+}
+
+func (m *MsgSetDenomRate) Reset()         { *m = MsgSetDenomRate{} }
+func (m *MsgSetDenomRate) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomRate) ProtoMessage()    {}
+func (*MsgSetDenomRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{16}
+}
+func (m *MsgSetDenomRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDenomRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDenomRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomRate.Merge(m, src)
+}
+func (m *MsgSetDenomRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDenomRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDenomRate proto.InternalMessageInfo
+
+func (m *MsgSetDenomRate) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetDenomRate) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgSetDenomRateResponse defines the Msg/SetDenomRate response type.
+type MsgSetDenomRateResponse struct {
+}
+
+func (m *MsgSetDenomRateResponse) Reset()         { *m = MsgSetDenomRateResponse{} }
+func (m *MsgSetDenomRateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomRateResponse) ProtoMessage()    {}
+func (*MsgSetDenomRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{17}
+}
+func (m *MsgSetDenomRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDenomRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDenomRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomRateResponse.Merge(m, src)
+}
+func (m *MsgSetDenomRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDenomRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDenomRateResponse proto.InternalMessageInfo
+
+// MsgRemoveDenom defines the Msg/RemoveDenom request type. It removes a denom
+// and its static conversion rate.
+type MsgRemoveDenom struct {
+	// Authority defines the authority that is removing the denom.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Denom is the denom to remove.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgRemoveDenom) Reset()         { *m = MsgRemoveDenom{} }
+func (m *MsgRemoveDenom) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveDenom) ProtoMessage()    {}
+func (*MsgRemoveDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{18}
+}
+func (m *MsgRemoveDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveDenom.Merge(m, src)
+}
+func (m *MsgRemoveDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveDenom proto.InternalMessageInfo
+
+func (m *MsgRemoveDenom) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgRemoveDenomResponse defines the Msg/RemoveDenom response type.
+type MsgRemoveDenomResponse struct {
+}
+
+func (m *MsgRemoveDenomResponse) Reset()         { *m = MsgRemoveDenomResponse{} }
+func (m *MsgRemoveDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveDenomResponse) ProtoMessage()    {}
+func (*MsgRemoveDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{19}
+}
+func (m *MsgRemoveDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveDenomResponse.Merge(m, src)
+}
+func (m *MsgRemoveDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveDenomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveDenomResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgParams)(nil), "feemarket.feemarket.v1.MsgParams")
+	proto.RegisterType((*MsgParamsResponse)(nil), "feemarket.feemarket.v1.MsgParamsResponse")
+	proto.RegisterType((*MsgUpdateParamsPartial)(nil), "feemarket.feemarket.v1.MsgUpdateParamsPartial")
+	proto.RegisterType((*MsgUpdateParamsPartialResponse)(nil), "feemarket.feemarket.v1.MsgUpdateParamsPartialResponse")
+	proto.RegisterType((*MsgScheduleParams)(nil), "feemarket.feemarket.v1.MsgScheduleParams")
+	proto.RegisterType((*MsgScheduleParamsResponse)(nil), "feemarket.feemarket.v1.MsgScheduleParamsResponse")
+	proto.RegisterType((*MsgCancelScheduledParams)(nil), "feemarket.feemarket.v1.MsgCancelScheduledParams")
+	proto.RegisterType((*MsgCancelScheduledParamsResponse)(nil), "feemarket.feemarket.v1.MsgCancelScheduledParamsResponse")
+	proto.RegisterType((*MsgSetBaseGasPrice)(nil), "feemarket.feemarket.v1.MsgSetBaseGasPrice")
+	proto.RegisterType((*MsgSetBaseGasPriceResponse)(nil), "feemarket.feemarket.v1.MsgSetBaseGasPriceResponse")
+	proto.RegisterType((*MsgFreezeFeeMarket)(nil), "feemarket.feemarket.v1.MsgFreezeFeeMarket")
+	proto.RegisterType((*MsgFreezeFeeMarketResponse)(nil), "feemarket.feemarket.v1.MsgFreezeFeeMarketResponse")
+	proto.RegisterType((*MsgUnfreezeFeeMarket)(nil), "feemarket.feemarket.v1.MsgUnfreezeFeeMarket")
+	proto.RegisterType((*MsgUnfreezeFeeMarketResponse)(nil), "feemarket.feemarket.v1.MsgUnfreezeFeeMarketResponse")
+	proto.RegisterType((*MsgSweepFeeCollector)(nil), "feemarket.feemarket.v1.MsgSweepFeeCollector")
+	proto.RegisterType((*MsgSweepFeeCollectorResponse)(nil), "feemarket.feemarket.v1.MsgSweepFeeCollectorResponse")
+	proto.RegisterType((*MsgSetDenomRate)(nil), "feemarket.feemarket.v1.MsgSetDenomRate")
+	proto.RegisterType((*MsgSetDenomRateResponse)(nil), "feemarket.feemarket.v1.MsgSetDenomRateResponse")
+	proto.RegisterType((*MsgRemoveDenom)(nil), "feemarket.feemarket.v1.MsgRemoveDenom")
+	proto.RegisterType((*MsgRemoveDenomResponse)(nil), "feemarket.feemarket.v1.MsgRemoveDenomResponse")
+}
+
+func init() { proto.RegisterFile("feemarket/feemarket/v1/tx.proto", fileDescriptor_1bbf67a633e47917) }
+
+var fileDescriptor_1bbf67a633e47917 = []byte{
+	// 944 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdf, 0x6f, 0xdb, 0x54,
+	0x14, 0xae, 0x97, 0x2d, 0x22, 0x27, 0x53, 0xa7, 0x66, 0x25, 0x4b, 0xbd, 0xca, 0x09, 0x45, 0x82,
+	0x50, 0x51, 0x9b, 0x84, 0x31, 0x21, 0xc4, 0x0b, 0x69, 0x29, 0x42, 0x22, 0x52, 0xe5, 0x0a, 0x90,
+	0x10, 0xa2, 0xba, 0xb1, 0x4f, 0x1d, 0xab, 0xb1, 0x6f, 0xf0, 0xbd, 0xcd, 0xd6, 0x49, 0x48, 0x68,
+	0xbc, 0xf0, 0xc8, 0x9f, 0x81, 0x78, 0x1a, 0x68, 0xf0, 0x37, 0xec, 0x71, 0xda, 0x13, 0xe2, 0x61,
+	0xa0, 0xf6, 0x61, 0x12, 0x7f, 0x05, 0xb2, 0x7d, 0x73, 0xe3, 0xc4, 0x89, 0x49, 0xa2, 0xf5, 0xa5,
+	0xf5, 0xbd, 0xf7, 0x3b, 0xe7, 0xfb, 0xbe, 0xfb, 0xe3, 0x1c, 0x05, 0xaa, 0xc7, 0x88, 0x1e, 0x09,
+	0x4e, 0x90, 0x1b, 0xa3, 0xaf, 0x41, 0xc3, 0xe0, 0xf7, 0xf5, 0x7e, 0x40, 0x39, 0x2d, 0x95, 0xe5,
+	0xb4, 0x3e, 0xfa, 0x1a, 0x34, 0xd4, 0xd7, 0x67, 0x04, 0xf6, 0x49, 0x40, 0x3c, 0x16, 0x07, 0xab,
+	0x1b, 0x16, 0x65, 0x1e, 0x65, 0x47, 0xd1, 0xc8, 0x88, 0x07, 0x62, 0xe9, 0x56, 0x3c, 0x32, 0x3c,
+	0xe6, 0x84, 0x61, 0x1e, 0x73, 0xc4, 0x82, 0x26, 0x16, 0x3a, 0x84, 0xa1, 0x31, 0x68, 0x74, 0x90,
+	0x93, 0x86, 0x61, 0x51, 0xd7, 0x17, 0xeb, 0x6b, 0xc4, 0x73, 0x7d, 0x6a, 0x44, 0x7f, 0xc5, 0xd4,
+	0xba, 0x43, 0x1d, 0x1a, 0x73, 0x84, 0x5f, 0xf1, 0xec, 0xd6, 0x6f, 0x0a, 0x14, 0xda, 0xcc, 0x39,
+	0x88, 0x04, 0x95, 0x3e, 0x84, 0x7c, 0x2c, 0xad, 0xa2, 0xd4, 0x94, 0x7a, 0xb1, 0xa9, 0xe9, 0xd3,
+	0x8d, 0xe9, 0x31, 0xbe, 0x75, 0xf5, 0xc9, 0xf3, 0xea, 0x8a, 0x29, 0x62, 0x4a, 0x77, 0xa1, 0x40,
+	0x4e, 0x79, 0x97, 0x06, 0x2e, 0x3f, 0xab, 0x5c, 0xa9, 0x29, 0xf5, 0x42, 0xab, 0xf2, 0xec, 0xf1,
+	0xce, 0xba, 0xb0, 0xf4, 0x91, 0x6d, 0x07, 0xc8, 0xd8, 0x21, 0x0f, 0x5c, 0xdf, 0x31, 0x47, 0xd0,
+	0x52, 0x15, 0x8a, 0x01, 0x32, 0xe4, 0x47, 0x8c, 0x13, 0x8e, 0x95, 0x5c, 0x4d, 0xa9, 0xbf, 0x62,
+	0x42, 0x34, 0x75, 0x18, 0xce, 0x7c, 0xb0, 0xfa, 0xf0, 0xc5, 0xa3, 0xed, 0x51, 0xc0, 0xd6, 0x4d,
+	0x58, 0x93, 0x9a, 0x4d, 0x64, 0x7d, 0xea, 0x33, 0xdc, 0xfa, 0x43, 0x81, 0x72, 0x9b, 0x39, 0x9f,
+	0xf7, 0x6d, 0xc2, 0x31, 0x5e, 0x3b, 0x20, 0x01, 0x77, 0x49, 0x6f, 0x5c, 0x98, 0x32, 0xbf, 0xb0,
+	0xd1, 0x76, 0x5c, 0x59, 0x62, 0x3b, 0xca, 0x90, 0x3f, 0x76, 0xb1, 0x67, 0xb3, 0x4a, 0xae, 0x96,
+	0xab, 0x17, 0x4c, 0x31, 0x4a, 0xb9, 0xa9, 0x81, 0x36, 0x5d, 0xb7, 0xb4, 0xf6, 0xab, 0x12, 0x19,
+	0x3e, 0xb4, 0xba, 0x68, 0x9f, 0xf6, 0x04, 0x68, 0x69, 0x57, 0x65, 0xc8, 0x77, 0xd1, 0x75, 0xba,
+	0x3c, 0x72, 0x95, 0x33, 0xc5, 0x28, 0xe1, 0x36, 0xb7, 0xb8, 0xdb, 0x94, 0xab, 0xdb, 0xb0, 0x91,
+	0x92, 0x2c, 0x0d, 0x3d, 0x80, 0x4a, 0x9b, 0x39, 0xbb, 0xc4, 0xb7, 0xb0, 0x37, 0x84, 0xd8, 0x97,
+	0x63, 0x2b, 0x25, 0x6c, 0x0b, 0x6a, 0xb3, 0xb8, 0xa5, 0xbe, 0xdf, 0x15, 0x28, 0x85, 0xea, 0x91,
+	0xb7, 0x08, 0xc3, 0x4f, 0x08, 0x3b, 0x08, 0x5c, 0x0b, 0x97, 0x96, 0xf6, 0x25, 0xac, 0x86, 0x0f,
+	0xf5, 0xc8, 0x21, 0xe1, 0x2b, 0x77, 0x2d, 0x14, 0xaf, 0xa3, 0x11, 0xee, 0xe0, 0x5f, 0xcf, 0xab,
+	0xb7, 0xe3, 0x04, 0xcc, 0x3e, 0xd1, 0x5d, 0x6a, 0x78, 0x84, 0x77, 0xf5, 0xcf, 0xd0, 0x21, 0xd6,
+	0xd9, 0x1e, 0x5a, 0xcf, 0x1e, 0xef, 0x80, 0xc8, 0xbf, 0x87, 0x96, 0x79, 0xbd, 0x93, 0x10, 0x94,
+	0xf2, 0xb6, 0x09, 0x6a, 0x5a, 0xb6, 0x74, 0xf5, 0x75, 0x64, 0x6a, 0x3f, 0x40, 0x7c, 0x80, 0xfb,
+	0x88, 0xed, 0xe8, 0x40, 0x97, 0x35, 0x35, 0x83, 0x7b, 0x22, 0xbb, 0xe4, 0xfe, 0x06, 0xd6, 0xc3,
+	0x4b, 0xee, 0x1f, 0x5f, 0x12, 0xbb, 0x06, 0x9b, 0xd3, 0xf2, 0x4b, 0xfe, 0x7f, 0x95, 0x48, 0xc0,
+	0xe1, 0x3d, 0xc4, 0xfe, 0x3e, 0xe2, 0x2e, 0xed, 0xf5, 0xd0, 0xe2, 0x34, 0x58, 0xfa, 0x4c, 0x6b,
+	0x50, 0xb4, 0x91, 0x71, 0xd7, 0x27, 0xdc, 0xa5, 0x7e, 0x7c, 0xa0, 0x66, 0x72, 0xaa, 0xd4, 0x85,
+	0x3c, 0xf1, 0xe8, 0xa9, 0xcf, 0xa3, 0xf7, 0x5f, 0x6c, 0x6e, 0xe8, 0x22, 0x67, 0x78, 0x84, 0xba,
+	0x28, 0xda, 0xfa, 0x2e, 0x75, 0xfd, 0xd6, 0x7b, 0xe1, 0x45, 0xf8, 0xe5, 0xef, 0x6a, 0xdd, 0x71,
+	0x79, 0xf7, 0xb4, 0xa3, 0x5b, 0xd4, 0x13, 0x8d, 0x40, 0xfc, 0xdb, 0x61, 0xf6, 0x89, 0xc1, 0xcf,
+	0xfa, 0xc8, 0xa2, 0x00, 0xf6, 0xf3, 0x8b, 0x47, 0xdb, 0x8a, 0x29, 0xf2, 0xa7, 0x36, 0xe3, 0x47,
+	0x05, 0x36, 0xa7, 0x99, 0x1d, 0xee, 0x46, 0x42, 0x9a, 0x72, 0xb9, 0xd2, 0xc2, 0xaa, 0x7c, 0x23,
+	0xbe, 0x92, 0x7b, 0xe8, 0x53, 0xcf, 0x24, 0x7c, 0xf9, 0x67, 0xb4, 0x0e, 0xd7, 0xec, 0x30, 0x89,
+	0xd8, 0xec, 0x78, 0x50, 0xfa, 0x18, 0xae, 0x06, 0xc3, 0xb6, 0xb1, 0xd4, 0x93, 0x8a, 0xc2, 0x53,
+	0x7b, 0xb8, 0x01, 0xb7, 0x26, 0x74, 0xcb, 0xbb, 0xe4, 0xc3, 0x6a, 0x9b, 0x39, 0x26, 0x7a, 0x74,
+	0x80, 0xd1, 0xea, 0xcb, 0x75, 0x94, 0x92, 0x52, 0x81, 0xf2, 0x38, 0xdf, 0x50, 0x49, 0xf3, 0x61,
+	0x01, 0x72, 0x6d, 0xe6, 0x94, 0xbe, 0x80, 0xbc, 0xa8, 0x9e, 0xaf, 0xcd, 0x2a, 0xda, 0xb2, 0x61,
+	0xaa, 0x6f, 0xfd, 0x2f, 0x44, 0xde, 0x93, 0xef, 0xe0, 0xe6, 0xb4, 0x7e, 0xaa, 0x67, 0x64, 0x98,
+	0x82, 0x57, 0xef, 0x2e, 0x86, 0x97, 0xf4, 0x3e, 0xac, 0x4e, 0xf4, 0xbc, 0x2c, 0xed, 0xe3, 0x50,
+	0xb5, 0x31, 0x37, 0x54, 0xf2, 0xfd, 0xa0, 0xc0, 0xab, 0xd3, 0x9b, 0xd2, 0x3b, 0x19, 0xc9, 0xa6,
+	0x46, 0xa8, 0xef, 0x2f, 0x1a, 0x21, 0x55, 0x7c, 0x0b, 0x37, 0x26, 0x1b, 0xcf, 0x76, 0x96, 0x97,
+	0x71, 0xac, 0xda, 0x9c, 0x1f, 0x9b, 0xa4, 0x9c, 0x6c, 0x0b, 0x59, 0x94, 0x13, 0x58, 0xb5, 0x39,
+	0x3f, 0x56, 0x52, 0xde, 0x83, 0xb5, 0x74, 0x37, 0x78, 0x3b, 0xeb, 0xa2, 0x4c, 0xa2, 0xd5, 0x3b,
+	0x8b, 0xa0, 0x93, 0xc4, 0xe9, 0x2e, 0x90, 0x45, 0x9c, 0x42, 0xab, 0x77, 0x16, 0x41, 0x27, 0x8a,
+	0xee, 0xf5, 0xb1, 0x32, 0xf8, 0x66, 0xf6, 0x41, 0x49, 0xa0, 0x6a, 0xcc, 0x09, 0x94, 0x4c, 0x08,
+	0xc5, 0x64, 0x75, 0x7a, 0x23, 0x23, 0x3e, 0x81, 0x53, 0xf5, 0xf9, 0x70, 0x43, 0x1a, 0xf5, 0xda,
+	0xf7, 0x61, 0xa9, 0x6f, 0x7d, 0xfa, 0xe4, 0x5c, 0x53, 0x9e, 0x9e, 0x6b, 0xca, 0x3f, 0xe7, 0x9a,
+	0xf2, 0xd3, 0x85, 0xb6, 0xf2, 0xf4, 0x42, 0x5b, 0xf9, 0xf3, 0x42, 0x5b, 0xf9, 0xca, 0x48, 0xf4,
+	0x0c, 0x76, 0xe2, 0xf6, 0x77, 0x3c, 0x1c, 0x24, 0x7e, 0x08, 0xdd, 0x4f, 0x7c, 0x47, 0x0d, 0xa4,
+	0x93, 0x8f, 0x7e, 0x94, 0xbc, 0xfb, 0xdf, 0x00, 0x15, 0x2b, 0x44, 0xcb, 0x71, 0x0d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Params defines a method for updating the feemarket module parameters.
+	Params(ctx context.Context, in *MsgParams, opts ...grpc.CallOption) (*MsgParamsResponse, error)
+	// UpdateParamsPartial defines a method for updating a subset of the
+	// feemarket module parameters.
+	UpdateParamsPartial(ctx context.Context, in *MsgUpdateParamsPartial, opts ...grpc.CallOption) (*MsgUpdateParamsPartialResponse, error)
+	// ScheduleParams defines a method for scheduling a change of the feemarket
+	// module parameters at a future block height.
+	ScheduleParams(ctx context.Context, in *MsgScheduleParams, opts ...grpc.CallOption) (*MsgScheduleParamsResponse, error)
+	// CancelScheduledParams defines a method for cancelling a change of the
+	// feemarket module parameters that is scheduled at a future block height.
+	CancelScheduledParams(ctx context.Context, in *MsgCancelScheduledParams, opts ...grpc.CallOption) (*MsgCancelScheduledParamsResponse, error)
+	// SetBaseGasPrice defines a method for overriding the current base gas
+	// price of the fee market.
+	SetBaseGasPrice(ctx context.Context, in *MsgSetBaseGasPrice, opts ...grpc.CallOption) (*MsgSetBaseGasPriceResponse, error)
+	// FreezeFeeMarket defines a method for freezing the learning rate and base
+	// gas price adjustments of the fee market.
+	FreezeFeeMarket(ctx context.Context, in *MsgFreezeFeeMarket, opts ...grpc.CallOption) (*MsgFreezeFeeMarketResponse, error)
+	// UnfreezeFeeMarket defines a method for resuming the learning rate and base
+	// gas price adjustments of a frozen fee market.
+	UnfreezeFeeMarket(ctx context.Context, in *MsgUnfreezeFeeMarket, opts ...grpc.CallOption) (*MsgUnfreezeFeeMarketResponse, error)
+	// SweepFeeCollector defines a method for burning the fees accumulated in the
+	// feemarket fee collector or sending them to the community pool or a module
+	// account.
+	SweepFeeCollector(ctx context.Context, in *MsgSweepFeeCollector, opts ...grpc.CallOption) (*MsgSweepFeeCollectorResponse, error)
+	// SetDenomRate defines a method for setting the static conversion rate of a
+	// denom that fees can be paid in.
+	SetDenomRate(ctx context.Context, in *MsgSetDenomRate, opts ...grpc.CallOption) (*MsgSetDenomRateResponse, error)
+	// RemoveDenom defines a method for removing a denom and its static
+	// conversion rate, so that fees can no longer be paid in it.
+	RemoveDenom(ctx context.Context, in *MsgRemoveDenom, opts ...grpc.CallOption) (*MsgRemoveDenomResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) Params(ctx context.Context, in *MsgParams, opts ...grpc.CallOption) (*MsgParamsResponse, error) {
+	out := new(MsgParamsResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Msg/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParamsPartial(ctx context.Context, in *MsgUpdateParamsPartial, opts ...grpc.CallOption) (*MsgUpdateParamsPartialResponse, error) {
+	out := new(MsgUpdateParamsPartialResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Msg/UpdateParamsPartial", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleParams(ctx context.Context, in *MsgScheduleParams, opts ...grpc.CallOption) (*MsgScheduleParamsResponse, error) {
+	out := new(MsgScheduleParamsResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Msg/ScheduleParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledParams(ctx context.Context, in *MsgCancelScheduledParams, opts ...grpc.CallOption) (*MsgCancelScheduledParamsResponse, error) {
+	out := new(MsgCancelScheduledParamsResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Msg/CancelScheduledParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetBaseGasPrice(ctx context.Context, in *MsgSetBaseGasPrice, opts ...grpc.CallOption) (*MsgSetBaseGasPriceResponse, error) {
+	out := new(MsgSetBaseGasPriceResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Msg/SetBaseGasPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FreezeFeeMarket(ctx context.Context, in *MsgFreezeFeeMarket, opts ...grpc.CallOption) (*MsgFreezeFeeMarketResponse, error) {
+	out := new(MsgFreezeFeeMarketResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Msg/FreezeFeeMarket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnfreezeFeeMarket(ctx context.Context, in *MsgUnfreezeFeeMarket, opts ...grpc.CallOption) (*MsgUnfreezeFeeMarketResponse, error) {
+	out := new(MsgUnfreezeFeeMarketResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Msg/UnfreezeFeeMarket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SweepFeeCollector(ctx context.Context, in *MsgSweepFeeCollector, opts ...grpc.CallOption) (*MsgSweepFeeCollectorResponse, error) {
+	out := new(MsgSweepFeeCollectorResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Msg/SweepFeeCollector", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomRate(ctx context.Context, in *MsgSetDenomRate, opts ...grpc.CallOption) (*MsgSetDenomRateResponse, error) {
+	out := new(MsgSetDenomRateResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Msg/SetDenomRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveDenom(ctx context.Context, in *MsgRemoveDenom, opts ...grpc.CallOption) (*MsgRemoveDenomResponse, error) {
+	out := new(MsgRemoveDenomResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Msg/RemoveDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Params defines a method for updating the feemarket module parameters.
+	Params(context.Context, *MsgParams) (*MsgParamsResponse, error)
+	// UpdateParamsPartial defines a method for updating a subset of the
+	// feemarket module parameters.
+	UpdateParamsPartial(context.Context, *MsgUpdateParamsPartial) (*MsgUpdateParamsPartialResponse, error)
+	// ScheduleParams defines a method for scheduling a change of the feemarket
+	// module parameters at a future block height.
+	ScheduleParams(context.Context, *MsgScheduleParams) (*MsgScheduleParamsResponse, error)
+	// CancelScheduledParams defines a method for cancelling a change of the
+	// feemarket module parameters that is scheduled at a future block height.
+	CancelScheduledParams(context.Context, *MsgCancelScheduledParams) (*MsgCancelScheduledParamsResponse, error)
+	// SetBaseGasPrice defines a method for overriding the current base gas
+	// price of the fee market.
+	SetBaseGasPrice(context.Context, *MsgSetBaseGasPrice) (*MsgSetBaseGasPriceResponse, error)
+	// FreezeFeeMarket defines a method for freezing the learning rate and base
+	// gas price adjustments of the fee market.
+	FreezeFeeMarket(context.Context, *MsgFreezeFeeMarket) (*MsgFreezeFeeMarketResponse, error)
+	// UnfreezeFeeMarket defines a method for resuming the learning rate and base
+	// gas price adjustments of a frozen fee market.
+	UnfreezeFeeMarket(context.Context, *MsgUnfreezeFeeMarket) (*MsgUnfreezeFeeMarketResponse, error)
+	// SweepFeeCollector defines a method for burning the fees accumulated in the
+	// feemarket fee collector or sending them to the community pool or a module
+	// account.
+	SweepFeeCollector(context.Context, *MsgSweepFeeCollector) (*MsgSweepFeeCollectorResponse, error)
+	// SetDenomRate defines a method for setting the static conversion rate of a
+	// denom that fees can be paid in.
+	SetDenomRate(context.Context, *MsgSetDenomRate) (*MsgSetDenomRateResponse, error)
+	// RemoveDenom defines a method for removing a denom and its static
+	// conversion rate, so that fees can no longer be paid in it.
+	RemoveDenom(context.Context, *MsgRemoveDenom) (*MsgRemoveDenomResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) Params(ctx context.Context, req *MsgParams) (*MsgParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParamsPartial(ctx context.Context, req *MsgUpdateParamsPartial) (*MsgUpdateParamsPartialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParamsPartial not implemented")
+}
+func (*UnimplementedMsgServer) ScheduleParams(ctx context.Context, req *MsgScheduleParams) (*MsgScheduleParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleParams not implemented")
+}
+func (*UnimplementedMsgServer) CancelScheduledParams(ctx context.Context, req *MsgCancelScheduledParams) (*MsgCancelScheduledParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledParams not implemented")
+}
+func (*UnimplementedMsgServer) SetBaseGasPrice(ctx context.Context, req *MsgSetBaseGasPrice) (*MsgSetBaseGasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBaseGasPrice not implemented")
+}
+func (*UnimplementedMsgServer) FreezeFeeMarket(ctx context.Context, req *MsgFreezeFeeMarket) (*MsgFreezeFeeMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeFeeMarket not implemented")
+}
+func (*UnimplementedMsgServer) UnfreezeFeeMarket(ctx context.Context, req *MsgUnfreezeFeeMarket) (*MsgUnfreezeFeeMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeFeeMarket not implemented")
+}
+func (*UnimplementedMsgServer) SweepFeeCollector(ctx context.Context, req *MsgSweepFeeCollector) (*MsgSweepFeeCollectorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SweepFeeCollector not implemented")
+}
+func (*UnimplementedMsgServer) SetDenomRate(ctx context.Context, req *MsgSetDenomRate) (*MsgSetDenomRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomRate not implemented")
+}
+func (*UnimplementedMsgServer) RemoveDenom(ctx context.Context, req *MsgRemoveDenom) (*MsgRemoveDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDenom not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Msg/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Params(ctx, req.(*MsgParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParamsPartial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParamsPartial)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParamsPartial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Msg/UpdateParamsPartial",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParamsPartial(ctx, req.(*MsgUpdateParamsPartial))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Msg/ScheduleParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleParams(ctx, req.(*MsgScheduleParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Msg/CancelScheduledParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledParams(ctx, req.(*MsgCancelScheduledParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetBaseGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBaseGasPrice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBaseGasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Msg/SetBaseGasPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBaseGasPrice(ctx, req.(*MsgSetBaseGasPrice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FreezeFeeMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezeFeeMarket)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FreezeFeeMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Msg/FreezeFeeMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezeFeeMarket(ctx, req.(*MsgFreezeFeeMarket))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnfreezeFeeMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnfreezeFeeMarket)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnfreezeFeeMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Msg/UnfreezeFeeMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnfreezeFeeMarket(ctx, req.(*MsgUnfreezeFeeMarket))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SweepFeeCollector_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSweepFeeCollector)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SweepFeeCollector(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Msg/SweepFeeCollector",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SweepFeeCollector(ctx, req.(*MsgSweepFeeCollector))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomRate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Msg/SetDenomRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomRate(ctx, req.(*MsgSetDenomRate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Msg/RemoveDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveDenom(ctx, req.(*MsgRemoveDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "feemarket.feemarket.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Msg_Params_Handler,
+		},
+		{
+			MethodName: "UpdateParamsPartial",
+			Handler:    _Msg_UpdateParamsPartial_Handler,
+		},
+		{
+			MethodName: "ScheduleParams",
+			Handler:    _Msg_ScheduleParams_Handler,
+		},
+		{
+			MethodName: "CancelScheduledParams",
+			Handler:    _Msg_CancelScheduledParams_Handler,
+		},
+		{
+			MethodName: "SetBaseGasPrice",
+			Handler:    _Msg_SetBaseGasPrice_Handler,
+		},
+		{
+			MethodName: "FreezeFeeMarket",
+			Handler:    _Msg_FreezeFeeMarket_Handler,
+		},
+		{
+			MethodName: "UnfreezeFeeMarket",
+			Handler:    _Msg_UnfreezeFeeMarket_Handler,
+		},
+		{
+			MethodName: "SweepFeeCollector",
+			Handler:    _Msg_SweepFeeCollector_Handler,
+		},
+		{
+			MethodName: "SetDenomRate",
+			Handler:    _Msg_SetDenomRate_Handler,
+		},
+		{
+			MethodName: "RemoveDenom",
+			Handler:    _Msg_RemoveDenom_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "feemarket/feemarket/v1/tx.proto",
+}
+
+func (m *MsgParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResetState {
+		i--
+		if m.ResetState {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsPartial) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsPartial) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsPartial) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Fields[iNdEx])
+			copy(dAtA[i:], m.Fields[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Fields[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsPartialResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsPartialResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsPartialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBaseGasPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBaseGasPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBaseGasPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BaseGasPrice.Size()
+		i -= size
+		if _, err := m.BaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBaseGasPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBaseGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBaseGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeFeeMarket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeFeeMarket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeFeeMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeFeeMarketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeFeeMarketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeFeeMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnfreezeFeeMarket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnfreezeFeeMarket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnfreezeFeeMarket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnfreezeFeeMarketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnfreezeFeeMarketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnfreezeFeeMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSweepFeeCollector) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSweepFeeCollector) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSweepFeeCollector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSweepFeeCollectorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSweepFeeCollectorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSweepFeeCollectorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ResetState {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParamsPartial) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateParamsPartialResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgScheduleParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgScheduleParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelScheduledParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetBaseGasPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.BaseGasPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetBaseGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFreezeFeeMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFreezeFeeMarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnfreezeFeeMarket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnfreezeFeeMarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSweepFeeCollector) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSweepFeeCollectorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetDenomRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetDenomRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetState", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ResetState = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsPartial) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsPartial: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsPartial: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsPartialResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsPartialResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsPartialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelScheduledParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelScheduledParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelScheduledParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelScheduledParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelScheduledParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelScheduledParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetBaseGasPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBaseGasPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBaseGasPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetBaseGasPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBaseGasPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBaseGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFreezeFeeMarket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFreezeFeeMarket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFreezeFeeMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFreezeFeeMarketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFreezeFeeMarketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFreezeFeeMarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnfreezeFeeMarket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnfreezeFeeMarket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnfreezeFeeMarket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnfreezeFeeMarketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnfreezeFeeMarketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnfreezeFeeMarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSweepFeeCollector) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSweepFeeCollector: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSweepFeeCollector: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSweepFeeCollectorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSweepFeeCollectorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSweepFeeCollectorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetDenomRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDenomRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDenomRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgSetDenomRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDenomRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDenomRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgParams) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveDenom) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -364,17 +4086,17 @@ func (m *MsgParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveDenom: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveDenom: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -384,28 +4106,27 @@ func (m *MsgParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -433,7 +4154,7 @@ func (m *MsgParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -456,7 +4177,7 @@ func (m *MsgParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -479,10 +4200,10 @@ func (m *MsgParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default: